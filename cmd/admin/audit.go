@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+)
+
+func runAudit(a *app, args []string) error {
+	fs := newFlagSet("audit")
+	actor := fs.String("actor", "", "only entries of this actor")
+	targetType := fs.String("type", "", "only entries of this target type: translation, book, user, key, verses or backup")
+	target := fs.String("target", "", "only entries of this target ID")
+	since := fs.String("since", "", "only entries at or after this RFC 3339 time")
+	limit := fs.Int64("limit", audit.DefaultLimit, "maximum number of entries")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	filter := audit.Filter{Actor: *actor, TargetType: *targetType, TargetID: *target, Limit: *limit}
+	if *since != "" {
+		t, err := time.Parse(time.RFC3339, *since)
+		if err != nil {
+			return errInvalid("since", *since)
+		}
+		filter.Since = t
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	entries, err := audit.NewStore(db).List(a.ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(entries))
+	for _, e := range entries {
+		details := "-"
+		if len(e.Details) > 0 {
+			details = fmt.Sprint(e.Details)
+		}
+		rows = append(rows, []string{e.CreatedAt.Format(time.RFC3339), e.Actor, e.Action, e.TargetID, details})
+	}
+	return a.out.table(entries, []string{"TIME", "ACTOR", "ACTION", "TARGET", "DETAILS"}, rows)
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// backupCollections are backed up and restored when none are named
+var backupCollections = []string{
+	"translations", "books", "verses", "comments",
+	"users", "api_keys", "schema_migrations", "corpus_chapters",
+	"verse_revisions", "audit_log", "daily_verses", "reading_plans", "plan_enrollments",
+	"bookmarks", "highlights", "notes", "cross_references", "concordance",
+}
+
+// restoreBatch is the number of documents written per bulk write
+const restoreBatch = 1000
+
+// A backup directory holds <collection>.jsonl with one canonical Extended
+// JSON document per line, and <collection>.indexes.json with its indexes.
+
+func runBackup(a *app, args []string) error {
+	fs := newFlagSet("backup")
+	dir := fs.String("dir", "backup", "directory to write to")
+	collections, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(collections) == 0 {
+		collections = backupCollections
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	var rows [][]string
+	for _, name := range collections {
+		count, err := backupCollection(a, db.Collection(name), *dir)
+		if err != nil {
+			return err
+		}
+		counts[name] = count
+		rows = append(rows, []string{name, strconv.Itoa(count)})
+	}
+	a.audit("backup.create", audit.TargetBackup, *dir, map[string]any{"documents": counts})
+	return a.out.table(counts, []string{"COLLECTION", "DOCUMENTS"}, rows)
+}
+
+func backupCollection(a *app, collection *mongo.Collection, dir string) (int, error) {
+	specs, err := listIndexes(a.ctx, collection)
+	if err != nil {
+		return 0, err
+	}
+	indexes, err := json.MarshalIndent(specs, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, collection.Name()+".indexes.json"), indexes, 0o644); err != nil {
+		return 0, err
+	}
+
+	file, err := os.Create(filepath.Join(dir, collection.Name()+".jsonl"))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+
+	cursor, err := collection.Find(a.ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", collection.Name(), err)
+	}
+	defer cursor.Close(a.ctx)
+
+	count := 0
+	for cursor.Next(a.ctx) {
+		line, err := bson.MarshalExtJSON(cursor.Current, true, false)
+		if err != nil {
+			return count, fmt.Errorf("failed to encode %s document: %w", collection.Name(), err)
+		}
+		w.Write(line)
+		w.WriteByte('\n')
+		count++
+	}
+	if err := cursor.Err(); err != nil {
+		return count, err
+	}
+	return count, w.Flush()
+}
+
+func runRestore(a *app, args []string) error {
+	fs := newFlagSet("restore")
+	dir := fs.String("dir", "backup", "directory to read from")
+	drop := fs.Bool("drop", false, "drop each collection before restoring it; otherwise documents are replaced by _id")
+	collections, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(collections) == 0 {
+		for _, name := range backupCollections {
+			if _, err := os.Stat(filepath.Join(*dir, name+".jsonl")); err == nil {
+				collections = append(collections, name)
+			}
+		}
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	var rows [][]string
+	for _, name := range collections {
+		count, err := restoreCollection(a, db.Collection(name), *dir, *drop)
+		if err != nil {
+			return err
+		}
+		counts[name] = count
+		rows = append(rows, []string{name, strconv.Itoa(count)})
+	}
+	a.audit("backup.restore", audit.TargetBackup, *dir, map[string]any{"documents": counts, "drop": *drop})
+	return a.out.table(counts, []string{"COLLECTION", "DOCUMENTS"}, rows)
+}
+
+func restoreCollection(a *app, collection *mongo.Collection, dir string, drop bool) (int, error) {
+	file, err := os.Open(filepath.Join(dir, collection.Name()+".jsonl"))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	if drop {
+		if err := collection.Drop(a.ctx); err != nil {
+			return 0, fmt.Errorf("failed to drop %s: %w", collection.Name(), err)
+		}
+	}
+
+	// Indexes first, so restored documents are checked against unique indexes
+	indexes, err := os.ReadFile(filepath.Join(dir, collection.Name()+".indexes.json"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+	if len(indexes) > 0 {
+		var specs []indexSpec
+		if err := json.Unmarshal(indexes, &specs); err != nil {
+			return 0, fmt.Errorf("failed to parse indexes of %s: %w", collection.Name(), err)
+		}
+		if err := createIndexes(a.ctx, collection, specs); err != nil {
+			return 0, err
+		}
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	count := 0
+	var batch []mongo.WriteModel
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := collection.BulkWrite(a.ctx, batch, options.BulkWrite().SetOrdered(false)); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", collection.Name(), err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for line := 1; scanner.Scan(); line++ {
+		var doc bson.D
+		if err := bson.UnmarshalExtJSON(scanner.Bytes(), true, &doc); err != nil {
+			return count, fmt.Errorf("%s line %d: %w", collection.Name(), line, err)
+		}
+
+		var id any
+		for _, elem := range doc {
+			if elem.Key == "_id" {
+				id = elem.Value
+				break
+			}
+		}
+		if drop || id == nil {
+			batch = append(batch, mongo.NewInsertOneModel().SetDocument(doc))
+		} else {
+			batch = append(batch, mongo.NewReplaceOneModel().SetFilter(bson.M{"_id": id}).SetReplacement(doc).SetUpsert(true))
+		}
+		count++
+
+		if len(batch) >= restoreBatch {
+			if err := flush(); err != nil {
+				return count, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return count, err
+	}
+	return count, flush()
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+)
+
+func runTranslations(a *app, args []string) error {
+	sub, args, err := subcommand("translations", args, "list", "add", "update", "remove")
+	if err != nil {
+		return err
+	}
+	fs := newFlagSet("translations " + sub)
+	fs.String("name", "", "display name (update)")
+	fs.String("note", "", "license or attribution note")
+	fs.String("language", "", "BCP 47 language tag, e.g. zh-Hant")
+	fs.String("script", "", "ISO 15924 script; defaults to the script of the language")
+	fs.String("direction", "", "ltr or rtl; defaults to the direction of the script")
+	fs.String("copyright", "", "copyright statement")
+	fs.String("license", "", "SPDX license identifier or public-domain")
+	fs.String("license-url", "", "license URL; known licenses have a default")
+	fs.String("license-file", "", "file with the full license text")
+	fs.Bool("attribution-required", false, "the license requires attribution; implied by CC-BY licenses")
+	fs.String("attribution", "", "attribution to show with the text")
+	fs.String("publisher", "", "publisher")
+	fs.Int("year", 0, "year of publication")
+	fs.String("versification", "", "versification scheme: "+strings.Join(versification.Names(), ", "))
+	fs.String("books", "", "comma-separated IDs of the books included")
+	withVerses := fs.Bool("verses", false, "also remove the verses of the translation")
+	if args, err = parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	if _, err := a.database(); err != nil {
+		return err
+	}
+	repo := database.NewRepository()
+
+	switch sub {
+	case "list":
+		translations, err := repo.GetAllTranslations(a.ctx)
+		if err != nil {
+			return err
+		}
+		if translations == nil {
+			translations = []database.Translation{}
+		}
+		rows := make([][]string, 0, len(translations))
+		for _, t := range translations {
+			rows = append(rows, []string{t.ID, t.Name, t.Language, t.License.ID, t.Versification, t.Note})
+		}
+		return a.out.table(translations, []string{"ID", "NAME", "LANGUAGE", "LICENSE", "VERSIFICATION", "NOTE"}, rows)
+	case "add":
+		if err := requireArgs(args, 2, "<id> <name>"); err != nil {
+			return err
+		}
+		translation := database.Translation{ID: args[0], Name: args[1]}
+		changed, err := setTranslationFlags(fs, &translation)
+		if err != nil {
+			return err
+		}
+		if err := repo.InsertTranslation(a.ctx, translation); err != nil {
+			return err
+		}
+		changed["name"] = translation.Name
+		a.audit("translation.add", audit.TargetTranslation, translation.ID, changed)
+		return a.out.result(translation, "added translation %s", translation.ID)
+	case "update":
+		if err := requireArgs(args, 1, "<id>"); err != nil {
+			return err
+		}
+		translation, err := repo.GetTranslation(a.ctx, args[0])
+		if err != nil {
+			return err
+		}
+		changed, err := setTranslationFlags(fs, translation)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			return fmt.Errorf("nothing to update; set at least one flag")
+		}
+		if err := repo.ReplaceTranslation(a.ctx, *translation); err != nil {
+			return err
+		}
+		a.audit("translation.update", audit.TargetTranslation, translation.ID, changed)
+		return a.out.result(translation, "updated translation %s", translation.ID)
+	case "remove":
+		if err := requireArgs(args, 1, "<id>"); err != nil {
+			return err
+		}
+		removed, err := repo.DeleteTranslation(a.ctx, args[0], *withVerses)
+		if err != nil {
+			return err
+		}
+		if *withVerses {
+			unindex(a, args[0], "")
+		}
+		a.audit("translation.remove", audit.TargetTranslation, args[0], map[string]any{"verses_removed": removed})
+		return a.out.result(map[string]any{"id": args[0], "verses_removed": removed},
+			"removed translation %s and %d verses", args[0], removed)
+	}
+	return nil
+}
+
+// setTranslationFlags copies the metadata flags given on the command line
+// into t and returns them by name. Flags not given leave t as it is.
+func setTranslationFlags(fs *flag.FlagSet, t *database.Translation) (map[string]any, error) {
+	changed := map[string]any{}
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		value := f.Value.String()
+		switch f.Name {
+		case "name":
+			t.Name = value
+		case "note":
+			t.Note = value
+		case "language":
+			t.Language = value
+		case "script":
+			t.Script = value
+		case "direction":
+			t.Direction = value
+		case "copyright":
+			t.Copyright = value
+		case "license":
+			// A new license starts from its own defaults
+			t.License = database.License{ID: value, Text: t.License.Text}
+		case "license-url":
+			t.License.URL = value
+		case "license-file":
+			data, readErr := os.ReadFile(value)
+			if readErr != nil {
+				err = readErr
+				return
+			}
+			t.License.Text = string(data)
+			value = fmt.Sprintf("%d bytes", len(data))
+		case "attribution-required":
+			// Applied below, as -license resets it and is visited later
+		case "attribution":
+			t.Attribution = value
+		case "publisher":
+			t.Publisher = value
+		case "year":
+			t.Year, _ = strconv.Atoi(value)
+		case "versification":
+			t.Versification = value
+		case "books":
+			t.Books = nil
+			for _, id := range strings.Split(value, ",") {
+				if id = strings.ToUpper(strings.TrimSpace(id)); id != "" {
+					t.Books = append(t.Books, id)
+				}
+			}
+		default:
+			return
+		}
+		changed[f.Name] = value
+	})
+	if required, ok := changed["attribution-required"]; ok {
+		t.License.AttributionRequired = required == "true"
+	}
+	return changed, err
+}
+
+func runBooks(a *app, args []string) error {
+	sub, args, err := subcommand("books", args, "list", "add", "remove")
+	if err != nil {
+		return err
+	}
+	fs := newFlagSet("books " + sub)
+	withVerses := fs.Bool("verses", false, "also remove the verses of the book")
+	if args, err = parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	if _, err := a.database(); err != nil {
+		return err
+	}
+	repo := database.NewRepository()
+
+	switch sub {
+	case "list":
+		books, err := repo.GetAllBooks(a.ctx)
+		if err != nil {
+			return err
+		}
+		if books == nil {
+			books = []database.Book{}
+		}
+		rows := make([][]string, 0, len(books))
+		for _, b := range books {
+			rows = append(rows, []string{b.ID, b.Name, strconv.Itoa(b.Chapters)})
+		}
+		return a.out.table(books, []string{"ID", "NAME", "CHAPTERS"}, rows)
+	case "add":
+		if err := requireArgs(args, 3, "<id> <name> <chapters>"); err != nil {
+			return err
+		}
+		chapters, err := strconv.Atoi(args[2])
+		if err != nil || chapters < 1 {
+			return errInvalid("chapters", args[2])
+		}
+		book := database.Book{ID: args[0], Name: args[1], Chapters: chapters}
+		if err := repo.InsertBook(a.ctx, book); err != nil {
+			return err
+		}
+		a.audit("book.add", audit.TargetBook, book.ID, map[string]any{"name": book.Name, "chapters": book.Chapters})
+		return a.out.result(book, "added book %s", book.ID)
+	case "remove":
+		if err := requireArgs(args, 1, "<id>"); err != nil {
+			return err
+		}
+		removed, err := repo.DeleteBook(a.ctx, args[0], *withVerses)
+		if err != nil {
+			return err
+		}
+		if *withVerses {
+			unindex(a, "", args[0])
+		}
+		a.audit("book.remove", audit.TargetBook, args[0], map[string]any{"verses_removed": removed})
+		return a.out.result(map[string]any{"id": args[0], "verses_removed": removed},
+			"removed book %s and %d verses", args[0], removed)
+	}
+	return nil
+}
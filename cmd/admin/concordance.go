@@ -0,0 +1,47 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+)
+
+func runConcordance(a *app, args []string) error {
+	sub, args, err := subcommand("concordance", args, "rebuild")
+	if err != nil {
+		return err
+	}
+	fs := newFlagSet("concordance " + sub)
+	translationID := fs.String("translation", "", "translation to rebuild (default all)")
+	if _, err = parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	indexed, err := concordance.NewStore(db).Rebuild(a.ctx, *translationID)
+	if err != nil {
+		return err
+	}
+	target := *translationID
+	if target == "" {
+		target = "all"
+	}
+	a.audit("concordance.rebuild", audit.TargetConcordance, target, map[string]any{"verses": indexed})
+	return a.out.result(map[string]any{"translation": target, "verses": indexed}, "indexed %d verses", indexed)
+}
+
+// unindex removes the concordance entries of removed verses. The verses are
+// already gone, so a failure is reported as a warning only.
+func unindex(a *app, translationID, bookID string) {
+	db, err := a.database()
+	if err == nil {
+		_, err = concordance.NewStore(db).Remove(a.ctx, translationID, bookID)
+	}
+	if err != nil {
+		slog.Warn("failed to update the concordance; run concordance rebuild", "error", err)
+	}
+}
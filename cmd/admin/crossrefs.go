@@ -0,0 +1,99 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/crossrefs"
+)
+
+func runCrossRefs(a *app, args []string) error {
+	sub, args, err := subcommand("crossrefs", args, "list", "import", "remove")
+	if err != nil {
+		return err
+	}
+	fs := newFlagSet("crossrefs " + sub)
+	dataset := fs.String("dataset", "", "dataset name (required for import)")
+	format := fs.String("format", "", "input format: tsv or csv (default from the file extension, tsv for stdin)")
+	refType := fs.String("type", crossrefs.TypeRelated, "type of records without one: "+strings.Join(crossrefs.Types, ", "))
+	if args, err = parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	store := crossrefs.NewStore(db)
+
+	switch sub {
+	case "list":
+		datasets, err := store.Datasets(a.ctx)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(datasets))
+		for _, d := range datasets {
+			rows = append(rows, []string{d.Name, strconv.Itoa(d.Count)})
+		}
+		return a.out.table(datasets, []string{"DATASET", "CROSS-REFERENCES"}, rows)
+	case "import":
+		if err := requireArgs(args, 1, "<file|->"); err != nil {
+			return err
+		}
+		if *dataset == "" {
+			return errInvalid("dataset", *dataset)
+		}
+		if !crossrefs.ValidType(*refType) {
+			return errInvalid("type", *refType)
+		}
+		if *format == "" {
+			*format = formatTSV
+			if strings.HasSuffix(strings.ToLower(args[0]), ".csv") {
+				*format = "csv"
+			}
+		}
+		comma := '\t'
+		switch *format {
+		case formatTSV:
+		case "csv":
+			comma = ','
+		default:
+			return errInvalid("format", *format)
+		}
+
+		in := io.Reader(os.Stdin)
+		if args[0] != "-" {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			in = file
+		}
+		refs, err := crossrefs.Read(in, comma, *dataset, *refType)
+		if err != nil {
+			return err
+		}
+		removed, err := store.ReplaceDataset(a.ctx, *dataset, refs)
+		if err != nil {
+			return err
+		}
+		a.audit("crossrefs.import", audit.TargetCrossRefs, *dataset, map[string]any{"file": args[0], "imported": len(refs), "replaced": removed})
+		summary := map[string]any{"dataset": *dataset, "imported": len(refs), "replaced": removed}
+		return a.out.result(summary, "imported %d cross-references into %s, replacing %d", len(refs), *dataset, removed)
+	default: // remove
+		if err := requireArgs(args, 1, "<dataset>"); err != nil {
+			return err
+		}
+		removed, err := store.DeleteDataset(a.ctx, args[0])
+		if err != nil {
+			return err
+		}
+		a.audit("crossrefs.remove", audit.TargetCrossRefs, args[0], map[string]any{"removed": removed})
+		return a.out.result(map[string]any{"dataset": args[0], "removed": removed}, "removed %d cross-references of %s", removed, args[0])
+	}
+}
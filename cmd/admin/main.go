@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// command is one admin subcommand; args excludes the command name
+type command struct {
+	usage string
+	run   func(a *app, args []string) error
+}
+
+// commands is filled in init because the commands refer back to it for usage
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"translations": {"translations list | add <id> <name> [metadata flags] | update <id> [-name] [metadata flags] | remove <id> [-verses]", runTranslations},
+		"books":        {"books list | add <id> <name> <chapters> | remove <id> [-verses]", runBooks},
+		"import":       {"import -book <id> -translation <id> [-format jsonl|tsv] [-reason text] <file|->", runImport},
+		"export":       {"export [-book <id>] [-translation <id>] [-format jsonl|tsv] [-o file]", runExport},
+		"reindex":      {"reindex [collection...]", runReindex},
+		"migrate":      {"migrate up [version] | down [steps] | status | redo", runMigrate},
+		"verify":       {"verify [-embedded] [-book <id>]", runVerify},
+		"users":        {"users list | add <id> [-name] [-email] [-role admin|editor|reader] | remove <id>", runUsers},
+		"keys":         {"keys list [-user <id>] | create <user> [-name] | revoke <key id>", runKeys},
+		"backup":       {"backup [-dir backup] [collection...]", runBackup},
+		"restore":      {"restore [-dir backup] [-drop] [collection...]", runRestore},
+		"crossrefs":    {"crossrefs list | import -dataset <name> [-format tsv|csv] [-type related] <file|-> | remove <dataset>", runCrossRefs},
+		"concordance":  {"concordance rebuild [-translation <id>]", runConcordance},
+		"audit":        {"audit [-actor <name>] [-type <target type>] [-target <id>] [-since <RFC 3339>] [-limit n]", runAudit},
+	}
+}
+
+// app carries what every command needs
+type app struct {
+	ctx   context.Context
+	cfg   *config.Config
+	out   printer
+	actor string
+}
+
+// database connects on first use, so commands that don't need MongoDB work without it
+func (a *app) database() (*mongo.Database, error) {
+	if a.cfg.Database.URI == "" {
+		return nil, fmt.Errorf("MONGO_CONNECTION not set in environment")
+	}
+	if err := database.InitMongoDB(a.ctx, a.cfg.Database); err != nil {
+		return nil, err
+	}
+	return database.GetDatabase(), nil
+}
+
+// audit records an administrative action. The action itself already
+// happened, so a failure is reported as a warning only.
+func (a *app) audit(action, targetType, targetID string, details map[string]any) {
+	db, err := a.database()
+	if err == nil {
+		err = audit.NewStore(db).Record(a.ctx, a.actor, action, targetType, targetID, details)
+	}
+	if err != nil {
+		slog.Warn("failed to record audit entry", "action", action, "target", targetID, "error", err)
+	}
+}
+
+func main() {
+	configFile := flag.String("config", "", "path to the YAML config file")
+	jsonOutput := flag.Bool("json", false, "print results as JSON")
+	actor := flag.String("actor", defaultActor(), "name recorded in the audit log and verse history")
+	flag.Usage = usage
+	flag.Parse()
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		slog.Error("failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	// Logs go to stderr so stdout stays parseable
+	slog.SetDefault(logging.New(os.Stderr, logging.ParseLevel(cfg.Log.Level)))
+
+	a := &app{ctx: context.Background(), cfg: cfg, out: printer{json: *jsonOutput, w: os.Stdout}, actor: *actor}
+	err = cmd.run(a, flag.Args()[1:])
+	database.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "admin %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+// defaultActor names the operating system user running the CLI
+func defaultActor() string {
+	if name := os.Getenv("USER"); name != "" {
+		return "cli:" + name
+	}
+	return "cli"
+}
+
+func usage() {
+	w := flag.CommandLine.Output()
+	fmt.Fprintln(w, "Usage: admin [-config file] [-json] [-actor name] <command> [args]")
+	fmt.Fprintln(w, "\nCommands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s\n", commands[name].usage)
+	}
+	fmt.Fprintln(w, "\nFlags:")
+	flag.PrintDefaults()
+}
+
+// parseArgs parses fs from args, allowing flags after positional arguments,
+// and returns the positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// subcommand splits "<sub> args..." and checks that sub is one of known
+func subcommand(name string, args []string, known ...string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("missing subcommand; usage: %s", commands[name].usage)
+	}
+	if !slices.Contains(known, args[0]) {
+		return "", nil, fmt.Errorf("unknown subcommand %q; usage: %s", args[0], commands[name].usage)
+	}
+	return args[0], args[1:], nil
+}
+
+func errInvalid(what, value string) error {
+	return fmt.Errorf("invalid %s %q", what, value)
+}
+
+func requireArgs(args []string, n int, what string) error {
+	if len(args) < n {
+		return fmt.Errorf("expected %s", what)
+	}
+	return nil
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: admin %s\n", strings.TrimSpace(commands[strings.Fields(name)[0]].usage))
+		fs.PrintDefaults()
+	}
+	return fs
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/corpus"
+	"github.com/tkdnbb/bookofben-api/internal/migrate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// indexSpec is an index definition as listed by MongoDB
+type indexSpec struct {
+	Name                    string `bson:"name" json:"name"`
+	Key                     bson.D `bson:"key" json:"key"`
+	Unique                  bool   `bson:"unique,omitempty" json:"unique,omitempty"`
+	Sparse                  bool   `bson:"sparse,omitempty" json:"sparse,omitempty"`
+	PartialFilterExpression bson.D `bson:"partialFilterExpression,omitempty" json:"partial_filter_expression,omitempty"`
+	ExpireAfterSeconds      *int32 `bson:"expireAfterSeconds,omitempty" json:"expire_after_seconds,omitempty"`
+}
+
+func (s indexSpec) model() mongo.IndexModel {
+	opts := options.Index().SetName(s.Name)
+	if s.Unique {
+		opts.SetUnique(true)
+	}
+	if s.Sparse {
+		opts.SetSparse(true)
+	}
+	if s.PartialFilterExpression != nil {
+		opts.SetPartialFilterExpression(s.PartialFilterExpression)
+	}
+	if s.ExpireAfterSeconds != nil {
+		opts.SetExpireAfterSeconds(*s.ExpireAfterSeconds)
+	}
+	return mongo.IndexModel{Keys: s.Key, Options: opts}
+}
+
+// listIndexes returns the secondary indexes of a collection
+func listIndexes(ctx context.Context, collection *mongo.Collection) ([]indexSpec, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes of %s: %w", collection.Name(), err)
+	}
+	var all []indexSpec
+	if err := cursor.All(ctx, &all); err != nil {
+		return nil, fmt.Errorf("failed to decode indexes of %s: %w", collection.Name(), err)
+	}
+
+	specs := []indexSpec{}
+	for _, spec := range all {
+		if spec.Name != "_id_" {
+			specs = append(specs, spec)
+		}
+	}
+	return specs, nil
+}
+
+func createIndexes(ctx context.Context, collection *mongo.Collection, specs []indexSpec) error {
+	if len(specs) == 0 {
+		return nil
+	}
+	models := make([]mongo.IndexModel, 0, len(specs))
+	for _, spec := range specs {
+		models = append(models, spec.model())
+	}
+	if _, err := collection.Indexes().CreateMany(ctx, models); err != nil {
+		return fmt.Errorf("failed to create indexes of %s: %w", collection.Name(), err)
+	}
+	return nil
+}
+
+// runReindex drops and rebuilds the secondary indexes of the given collections
+func runReindex(a *app, args []string) error {
+	fs := newFlagSet("reindex")
+	collections, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	if len(collections) == 0 {
+		collections = []string{"verses"}
+	}
+
+	type rebuilt struct {
+		Collection string   `json:"collection"`
+		Indexes    []string `json:"indexes"`
+		Duration   string   `json:"duration"`
+	}
+	var results []rebuilt
+	var rows [][]string
+	for _, name := range collections {
+		collection := db.Collection(name)
+		specs, err := listIndexes(a.ctx, collection)
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		if len(specs) > 0 {
+			if err := collection.Indexes().DropAll(a.ctx); err != nil {
+				return fmt.Errorf("failed to drop indexes of %s: %w", name, err)
+			}
+			if err := createIndexes(a.ctx, collection, specs); err != nil {
+				return err
+			}
+		}
+
+		result := rebuilt{Collection: name, Indexes: []string{}, Duration: time.Since(start).Round(time.Millisecond).String()}
+		for _, spec := range specs {
+			result.Indexes = append(result.Indexes, spec.Name)
+		}
+		results = append(results, result)
+		rows = append(rows, []string{name, strconv.Itoa(len(specs)), result.Duration})
+	}
+	return a.out.table(results, []string{"COLLECTION", "INDEXES", "DURATION"}, rows)
+}
+
+func runMigrate(a *app, args []string) error {
+	sub, args, err := subcommand("migrate", args, "up", "down", "status", "redo")
+	if err != nil {
+		return err
+	}
+	arg := ""
+	if len(args) > 0 {
+		arg = args[0]
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	runner := migrate.New(db)
+
+	switch sub {
+	case "up":
+		target, err := countArg(arg, 0)
+		if err != nil {
+			return err
+		}
+		applied, err := runner.Up(a.ctx, target)
+		if err != nil {
+			return err
+		}
+		return a.out.result(map[string]any{"applied": applied}, "applied migrations %v", applied)
+	case "down":
+		steps, err := countArg(arg, 1)
+		if err != nil {
+			return err
+		}
+		reverted, err := runner.Down(a.ctx, steps)
+		if err != nil {
+			return err
+		}
+		return a.out.result(map[string]any{"rolled_back": reverted}, "rolled back migrations %v", reverted)
+	case "redo":
+		version, err := runner.Redo(a.ctx)
+		if err != nil {
+			return err
+		}
+		return a.out.result(map[string]any{"redone": version}, "redid migration %d", version)
+	}
+
+	statuses, err := runner.Status(a.ctx)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(statuses))
+	for _, s := range statuses {
+		applied := "pending"
+		if s.AppliedAt != nil {
+			applied = s.AppliedAt.Format(time.RFC3339)
+		}
+		rows = append(rows, []string{strconv.Itoa(s.Version), s.Name, applied})
+	}
+	return a.out.table(statuses, []string{"VERSION", "NAME", "APPLIED AT"}, rows)
+}
+
+func countArg(arg string, fallback int) (int, error) {
+	if arg == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(arg)
+	if err != nil || n < 0 {
+		return 0, errInvalid("number", arg)
+	}
+	return n, nil
+}
+
+// runVerify checks the stored verses, or the embedded corpus, for gaps,
+// duplicate verse numbers and empty text. It fails when issues are found.
+func runVerify(a *app, args []string) error {
+	fs := newFlagSet("verify")
+	embedded := fs.Bool("embedded", false, "check the corpus compiled into the binary instead of MongoDB")
+	bookID := fs.String("book", "", "only check this book")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	var issues []corpus.Issue
+	if *embedded {
+		issues = corpus.CheckEmbedded()
+	} else {
+		db, err := a.database()
+		if err != nil {
+			return err
+		}
+		if issues, err = corpus.CheckDatabase(a.ctx, db, *bookID); err != nil {
+			return err
+		}
+	}
+
+	rows := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		location := fmt.Sprintf("%s %d", issue.BookID, issue.Chapter)
+		if issue.Verse > 0 {
+			location += ":" + strconv.Itoa(issue.Verse)
+		}
+		rows = append(rows, []string{issue.Kind, issue.TranslationID, location, issue.Detail})
+	}
+	if err := a.out.table(issues, []string{"ISSUE", "TRANSLATION", "LOCATION", "DETAIL"}, rows); err != nil {
+		return err
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%d integrity issues found", len(issues))
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// printer writes results as an aligned table or as JSON
+type printer struct {
+	json bool
+	w    io.Writer
+}
+
+// table prints v as JSON, or header and rows as a table
+func (p printer) table(v any, header []string, rows [][]string) error {
+	if p.json {
+		return p.value(v)
+	}
+
+	w := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// result prints v as JSON, or message as a line
+func (p printer) result(v any, format string, args ...any) error {
+	if p.json {
+		return p.value(v)
+	}
+	_, err := fmt.Fprintf(p.w, format+"\n", args...)
+	return err
+}
+
+func (p printer) value(v any) error {
+	encoder := json.NewEncoder(p.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/auth"
+)
+
+func runUsers(a *app, args []string) error {
+	sub, args, err := subcommand("users", args, "list", "add", "remove")
+	if err != nil {
+		return err
+	}
+	fs := newFlagSet("users " + sub)
+	name := fs.String("name", "", "display name")
+	email := fs.String("email", "", "email address")
+	role := fs.String("role", auth.RoleReader, "role: admin, editor or reader")
+	if args, err = parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	store := auth.NewStore(db)
+
+	switch sub {
+	case "list":
+		users, err := store.ListUsers(a.ctx)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(users))
+		for _, u := range users {
+			rows = append(rows, []string{u.ID, u.Name, u.Email, u.Role, u.CreatedAt.Format(time.RFC3339)})
+		}
+		return a.out.table(users, []string{"ID", "NAME", "EMAIL", "ROLE", "CREATED"}, rows)
+	case "add":
+		if err := requireArgs(args, 1, "<id>"); err != nil {
+			return err
+		}
+		user, err := store.CreateUser(a.ctx, args[0], *name, *email, *role)
+		if err != nil {
+			return err
+		}
+		a.audit("user.add", audit.TargetUser, user.ID, map[string]any{"role": user.Role})
+		return a.out.result(user, "added user %s with role %s", user.ID, user.Role)
+	case "remove":
+		if err := requireArgs(args, 1, "<id>"); err != nil {
+			return err
+		}
+		if err := store.DeleteUser(a.ctx, args[0]); err != nil {
+			return err
+		}
+		a.audit("user.remove", audit.TargetUser, args[0], nil)
+		return a.out.result(map[string]string{"id": args[0]}, "removed user %s and revoked their keys", args[0])
+	}
+	return nil
+}
+
+func runKeys(a *app, args []string) error {
+	sub, args, err := subcommand("keys", args, "list", "create", "revoke")
+	if err != nil {
+		return err
+	}
+	fs := newFlagSet("keys " + sub)
+	userID := fs.String("user", "", "only list the keys of this user")
+	name := fs.String("name", "", "label of the key")
+	if args, err = parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+	store := auth.NewStore(db)
+
+	switch sub {
+	case "list":
+		keys, err := store.ListAPIKeys(a.ctx, *userID)
+		if err != nil {
+			return err
+		}
+		rows := make([][]string, 0, len(keys))
+		for _, k := range keys {
+			rows = append(rows, []string{k.ID, k.UserID, k.Name, k.CreatedAt.Format(time.RFC3339), formatTime(k.LastUsedAt), formatTime(k.RevokedAt)})
+		}
+		return a.out.table(keys, []string{"ID", "USER", "NAME", "CREATED", "LAST USED", "REVOKED"}, rows)
+	case "create":
+		if err := requireArgs(args, 1, "<user>"); err != nil {
+			return err
+		}
+		key, secret, err := store.CreateAPIKey(a.ctx, args[0], *name)
+		if err != nil {
+			return err
+		}
+		a.audit("key.create", audit.TargetKey, key.ID, map[string]any{"user_id": key.UserID, "name": key.Name})
+		result := struct {
+			*auth.APIKey
+			Key string `json:"key"`
+		}{key, secret}
+		return a.out.result(result, "created key %s for %s\n%s\nStore it now; it cannot be shown again.", key.ID, key.UserID, secret)
+	case "revoke":
+		if err := requireArgs(args, 1, "<key id>"); err != nil {
+			return err
+		}
+		if err := store.RevokeAPIKey(a.ctx, args[0]); err != nil {
+			return err
+		}
+		a.audit("key.revoke", audit.TargetKey, args[0], nil)
+		return a.out.result(map[string]string{"id": args[0]}, "revoked key %s", args[0])
+	}
+	return nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Import and export formats:
+//
+//	jsonl: one verse object per line, as returned by the API
+//	tsv:   chapter<TAB>verse<TAB>text per line, for one book and translation
+const (
+	formatJSONL = "jsonl"
+	formatTSV   = "tsv"
+)
+
+func runImport(a *app, args []string) error {
+	fs := newFlagSet("import")
+	bookID := fs.String("book", "", "book ID (required for tsv, overrides jsonl)")
+	translationID := fs.String("translation", "", "translation ID (required for tsv, overrides jsonl)")
+	format := fs.String("format", formatJSONL, "input format: jsonl or tsv")
+	reason := fs.String("reason", "", "reason recorded in the verse history")
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := requireArgs(args, 1, "<file|->"); err != nil {
+		return err
+	}
+	if *format != formatJSONL && *format != formatTSV {
+		return errInvalid("format", *format)
+	}
+	if *format == formatTSV && (*bookID == "" || *translationID == "") {
+		return fmt.Errorf("-book and -translation are required for tsv")
+	}
+
+	in := io.Reader(os.Stdin)
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	verses, err := readVerses(in, *format, *bookID, *translationID)
+	if err != nil {
+		return err
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	// Fill in book names from the books collection
+	repo := database.NewRepository()
+	names := map[string]string{}
+	for i := range verses {
+		v := &verses[i]
+		if v.BookName != "" {
+			continue
+		}
+		if _, ok := names[v.BookID]; !ok {
+			book, err := repo.GetBook(a.ctx, v.BookID)
+			if err != nil {
+				return fmt.Errorf("unknown book %q: %w", v.BookID, err)
+			}
+			names[v.BookID] = book.Name
+		}
+		v.BookName = names[v.BookID]
+	}
+
+	// The current verses are needed to record what the import changes
+	previous, err := storedVerses(a, db, verses)
+	if err != nil {
+		return err
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(verses))
+	revisions := []database.Revision{}
+	for _, v := range verses {
+		dbVerse := database.Verse{BookID: v.BookID, TranslationID: v.TranslationID, BookName: v.BookName, Chapter: v.Chapter, Verse: v.Verse, Text: v.Text}
+		for _, f := range v.Footnotes {
+			dbVerse.Footnotes = append(dbVerse.Footnotes, database.Footnote(f))
+		}
+		if revision, changed := database.NewRevision(previous[verseID(dbVerse)], &dbVerse, a.actor, *reason); changed {
+			revisions = append(revisions, revision)
+		}
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"book_id": v.BookID, "translation_id": v.TranslationID, "chapter": v.Chapter, "verse": v.Verse}).
+			SetReplacement(v).
+			SetUpsert(true))
+	}
+	result := &mongo.BulkWriteResult{}
+	if len(writes) > 0 {
+		result, err = db.Collection("verses").BulkWrite(a.ctx, writes, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return fmt.Errorf("failed to write verses: %w", err)
+		}
+	}
+
+	if err := repo.InsertRevisions(a.ctx, revisions); err != nil {
+		slog.Warn("failed to record verse revisions", "count", len(revisions), "error", err)
+	}
+	indexed := make([]database.Verse, 0, len(verses))
+	for _, v := range verses {
+		indexed = append(indexed, database.Verse{BookID: v.BookID, TranslationID: v.TranslationID, Chapter: v.Chapter, Verse: v.Verse, Text: v.Text})
+	}
+	if err := concordance.NewStore(db).IndexVerses(a.ctx, indexed); err != nil {
+		slog.Warn("failed to update the concordance; run concordance rebuild", "error", err)
+	}
+
+	summary := map[string]int64{"read": int64(len(verses)), "inserted": result.UpsertedCount, "updated": result.ModifiedCount}
+	a.audit("verses.import", audit.TargetVerses, args[0], map[string]any{"read": len(verses), "inserted": result.UpsertedCount, "updated": result.ModifiedCount, "reason": *reason})
+	return a.out.result(summary, "read %d verses: %d inserted, %d updated", len(verses), result.UpsertedCount, result.ModifiedCount)
+}
+
+// verseID identifies a verse across books and translations
+func verseID(v database.Verse) string {
+	return fmt.Sprintf("%s|%s.%d:%d", v.TranslationID, v.BookID, v.Chapter, v.Verse)
+}
+
+// storedVerses returns the stored verses that verses would replace
+func storedVerses(a *app, db *mongo.Database, verses []models.Verse) (map[string]*database.Verse, error) {
+	type scope struct{ bookID, translationID string }
+	scopes := map[scope]bool{}
+	for _, v := range verses {
+		scopes[scope{v.BookID, v.TranslationID}] = true
+	}
+
+	previous := map[string]*database.Verse{}
+	for sc := range scopes {
+		cursor, err := db.Collection("verses").Find(a.ctx, bson.M{"book_id": sc.bookID, "translation_id": sc.translationID},
+			options.Find().SetProjection(bson.M{"book_id": 1, "translation_id": 1, "chapter": 1, "verse": 1, "text": 1, "footnotes": 1}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load stored verses: %w", err)
+		}
+		var stored []database.Verse
+		if err := cursor.All(a.ctx, &stored); err != nil {
+			return nil, fmt.Errorf("failed to decode stored verses: %w", err)
+		}
+		for _, v := range stored {
+			previous[verseID(v)] = &v
+		}
+	}
+	return previous, nil
+}
+
+func readVerses(in io.Reader, format, bookID, translationID string) ([]models.Verse, error) {
+	var verses []models.Verse
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		var v models.Verse
+		switch format {
+		case formatJSONL:
+			if err := json.Unmarshal([]byte(text), &v); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		case formatTSV:
+			fields := strings.SplitN(text, "\t", 3)
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("line %d: expected chapter, verse and text separated by tabs", line)
+			}
+			var err error
+			if v.Chapter, err = strconv.Atoi(fields[0]); err != nil {
+				return nil, fmt.Errorf("line %d: invalid chapter %q", line, fields[0])
+			}
+			if v.Verse, err = strconv.Atoi(fields[1]); err != nil {
+				return nil, fmt.Errorf("line %d: invalid verse %q", line, fields[1])
+			}
+			v.Text = fields[2]
+		}
+
+		if bookID != "" {
+			v.BookID = bookID
+		}
+		if translationID != "" {
+			v.TranslationID = translationID
+		}
+		if v.BookID == "" || v.TranslationID == "" || v.Chapter < 1 || v.Verse < 1 || strings.TrimSpace(v.Text) == "" {
+			return nil, fmt.Errorf("line %d: book, translation, chapter, verse and text are required", line)
+		}
+		verses = append(verses, v)
+	}
+	return verses, scanner.Err()
+}
+
+func runExport(a *app, args []string) error {
+	fs := newFlagSet("export")
+	bookID := fs.String("book", "", "only export this book")
+	translationID := fs.String("translation", "", "only export this translation")
+	format := fs.String("format", formatJSONL, "output format: jsonl or tsv")
+	output := fs.String("o", "-", "output file, - for stdout")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+	if *format != formatJSONL && *format != formatTSV {
+		return errInvalid("format", *format)
+	}
+	if *format == formatTSV && (*bookID == "" || *translationID == "") {
+		return fmt.Errorf("-book and -translation are required for tsv")
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{}
+	if *bookID != "" {
+		filter["book_id"] = *bookID
+	}
+	if *translationID != "" {
+		filter["translation_id"] = *translationID
+	}
+	sort := bson.D{{Key: "book_id", Value: 1}, {Key: "translation_id", Value: 1}, {Key: "chapter", Value: 1}, {Key: "verse", Value: 1}}
+	cursor, err := db.Collection("verses").Find(a.ctx, filter, options.Find().SetSort(sort))
+	if err != nil {
+		return fmt.Errorf("failed to fetch verses: %w", err)
+	}
+	defer cursor.Close(a.ctx)
+
+	out := io.Writer(os.Stdout)
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	w := bufio.NewWriter(out)
+	encoder := json.NewEncoder(w)
+
+	count := 0
+	for cursor.Next(a.ctx) {
+		var v models.Verse
+		if err := cursor.Decode(&v); err != nil {
+			return fmt.Errorf("failed to decode verse: %w", err)
+		}
+		if *format == formatTSV {
+			fmt.Fprintf(w, "%d\t%d\t%s\n", v.Chapter, v.Verse, strings.ReplaceAll(v.Text, "\n", " "))
+		} else if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "exported %d verses\n", count)
+	return nil
+}
@@ -1,38 +1,107 @@
 package main
 
 import (
-	"fmt"
-	"log"
-	"net/http"
+	"context"
+	"flag"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
 	"github.com/tkdnbb/bookofben-api/internal/routes"
+	"github.com/tkdnbb/bookofben-api/internal/server"
+	"github.com/tkdnbb/bookofben-api/internal/startup"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
 )
 
 func main() {
-	r := routes.SetupRoutes()
+	configFile := flag.String("config", "", "path to the YAML config file")
+	flag.Parse()
 
-	// 设置优雅关闭
+	ctx := context.Background()
+	startup.Begin()
+
+	// 加载配置
+	done := startup.Track("config")
+	cfg, err := config.Load(*configFile)
+	done()
+	if err != nil {
+		slog.Error("failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	logging.Setup(cfg.Log.Level)
+	slog.Info("effective configuration", "config", cfg.Redacted())
+
+	// 初始化链路追踪
+	done = startup.Track("tracing")
+	shutdownTracing, err := tracing.Setup(ctx, "bookofben-api", cfg.Tracing.Exporter)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	done()
+
+	done = startup.Track("routes")
+	r := routes.SetupRoutes(ctx, cfg)
+	done()
+	startup.Finish(slog.Default())
+
+	srv, err := server.New(cfg.Server, r)
+	if err != nil {
+		slog.Error("failed to create server", "error", err)
+		os.Exit(1)
+	}
+
+	// 启动服务器
+	serverErr := make(chan error, 1)
 	go func() {
-		fmt.Println("Bible API Server starting on :8080")
-		if err := http.ListenAndServe(":8080", r); err != nil {
-			log.Fatal("Server failed to start:", err)
-		}
+		serverErr <- srv.ListenAndServe()
 	}()
 
-	// 等待中断信号来优雅地关闭服务器
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	// 等待中断信号来优雅地关闭服务器；SIGHUP 重新加载证书
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 
-	fmt.Println("Shutting down server...")
+	exitCode := 0
+wait:
+	for {
+		select {
+		case err := <-serverErr:
+			if err != nil {
+				slog.Error("server failed", "error", err)
+				exitCode = 1
+			}
+			break wait
+		case sig := <-signals:
+			if sig == syscall.SIGHUP {
+				if err := srv.ReloadCertificate(); err != nil {
+					slog.Error("failed to reload TLS certificate", "error", err)
+				}
+				continue
+			}
+
+			slog.Info("shutting down server", "signal", sig.String(), "timeout", cfg.Server.ShutdownTimeout.String())
+			// 先停止接收新请求并等待进行中的请求完成，再关闭数据库
+			if err := srv.Shutdown(ctx); err != nil {
+				slog.Error("error draining requests", "error", err)
+				exitCode = 1
+			}
+			break wait
+		}
+	}
 
 	// 关闭数据库连接
 	if err := routes.CloseDatabase(); err != nil {
-		log.Printf("Error closing database: %v", err)
+		slog.Error("error closing database", "error", err)
+	}
+
+	// 导出剩余的 span
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("error flushing traces", "error", err)
 	}
 
-	fmt.Println("Server exited")
+	slog.Info("server exited")
+	os.Exit(exitCode)
 }
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/corpus"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/migrate"
+)
+
+const usage = `Usage: corpus [flags] sync
+
+Synchronizes the embedded Book of Ben corpus into the verses collection and
+prints which verses changed.
+
+Flags:
+`
+
+func main() {
+	configFile := flag.String("config", "", "path to the YAML config file")
+	dryRun := flag.Bool("dry-run", false, "report the changes without writing")
+	force := flag.Bool("force", false, "compare every chapter, even when its hash is unchanged")
+	jsonOutput := flag.Bool("json", false, "print the report as JSON")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.Arg(0) != "sync" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		slog.Error("failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	logging.Setup(cfg.Log.Level)
+
+	if cfg.Database.URI == "" {
+		slog.Error("MONGO_CONNECTION not set in environment")
+		os.Exit(1)
+	}
+	if err := database.InitMongoDB(ctx, cfg.Database); err != nil {
+		slog.Error("failed to initialize database", "error", err)
+		os.Exit(1)
+	}
+	db := database.GetDatabase()
+
+	// Hold the migration lock so a sync never races a migration or another sync
+	var report corpus.Report
+	err = migrate.New(db).WithLock(ctx, func(ctx context.Context) error {
+		var err error
+		report, err = corpus.Sync(ctx, db, corpus.Options{DryRun: *dryRun, Force: *force})
+		return err
+	})
+	database.Close()
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(report)
+	} else {
+		printReport(os.Stdout, report)
+	}
+	if err != nil {
+		slog.Error("corpus sync failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// printReport writes the report as a readable diff
+func printReport(w io.Writer, report corpus.Report) {
+	chapter := 0
+	for _, change := range report.Changes {
+		if change.Chapter != chapter {
+			chapter = change.Chapter
+			fmt.Fprintf(w, "%s %d\n", corpus.BookID, chapter)
+		}
+		switch change.Op {
+		case corpus.OpInsert:
+			fmt.Fprintf(w, "  + %d:%d %s\n", change.Chapter, change.Verse, change.New)
+		case corpus.OpDelete:
+			fmt.Fprintf(w, "  - %d:%d %s\n", change.Chapter, change.Verse, change.Old)
+		case corpus.OpUpdate:
+			fmt.Fprintf(w, "  ~ %d:%d\n", change.Chapter, change.Verse)
+			fmt.Fprintf(w, "    - %s\n", change.Old)
+			fmt.Fprintf(w, "    + %s\n", change.New)
+		}
+	}
+
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run, nothing written)"
+	}
+	fmt.Fprintf(w, "revision %s: %d chapters, %d unchanged, %d changed; %d inserted, %d updated, %d deleted%s\n",
+		report.Revision, report.Chapters, report.Skipped, len(report.Changed),
+		report.Inserted, report.Updated, report.Deleted, mode)
+}
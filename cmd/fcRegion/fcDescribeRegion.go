@@ -10,7 +10,7 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
 	credential "github.com/aliyun/credentials-go/credentials"
-	"github.com/joho/godotenv"
+	"github.com/tkdnbb/bookofben-api/internal/config"
 )
 
 // Description:
@@ -21,9 +21,10 @@ import (
 //
 // @throws Exception
 func CreateClient() (_result *openapi.Client, _err error) {
-	// Load .env file
-	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: No .env file found or failed to load")
+	// Load configuration (.env, config.yaml and environment)
+	cfg, _err := config.Load("")
+	if _err != nil {
+		return _result, _err
 	}
 	// It is recommended to use the default credential. For more credentials, please refer to: https://help.aliyun.com/document_detail/378661.html.
 	credential, _err := credential.NewCredential(nil)
@@ -31,18 +32,18 @@ func CreateClient() (_result *openapi.Client, _err error) {
 		return _result, _err
 	}
 
-	config := &openapi.Config{
+	openapiConfig := &openapi.Config{
 		Credential: credential,
 	}
-	// Get MongoDB connection string from environment
-	aliCloudID := os.Getenv("ALIBABA_CLOUD_ACCOUNT_ID")
+	// Get Alibaba Cloud account from configuration
+	aliCloudID := cfg.Aliyun.AccountID
 	if aliCloudID == "" {
 		log.Fatal("ALIBABA_CLOUD_ACCOUNT_ID not set in environment")
 	}
 	// See https://api.alibabacloud.com/product/FC.
-	config.Endpoint = tea.String(aliCloudID + ".ap-southeast-1.fc.aliyuncs.com")
+	openapiConfig.Endpoint = tea.String(aliCloudID + "." + cfg.Aliyun.Region + ".fc.aliyuncs.com")
 	_result = &openapi.Client{}
-	_result, _err = openapi.NewClient(config)
+	_result, _err = openapi.NewClient(openapiConfig)
 	return _result, _err
 }
 
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/aliyun/fc-runtime-go-sdk/fccontext"
+	"github.com/tkdnbb/bookofben-api/internal/fcadapter"
+	"github.com/tkdnbb/bookofben-api/internal/fcapp"
+)
+
+// emulator runs the Function Compute handlers of cmd/fc in-process
+type emulator struct {
+	function  fccontext.Function
+	region    string
+	timeout   time.Duration
+	cold      bool
+	recordDir string
+
+	// 函数实例默认一次只处理一个请求
+	mu          sync.Mutex
+	initialized bool
+	seq         atomic.Int64
+}
+
+// invocationResult is printed for every replayed event
+type invocationResult struct {
+	File       string              `json:"file"`
+	RequestID  string              `json:"request_id"`
+	ColdStart  bool                `json:"cold_start"`
+	InitMillis float64             `json:"init_ms,omitempty"`
+	Millis     float64             `json:"duration_ms"`
+	Response   *fcadapter.Response `json:"response,omitempty"`
+	Error      string              `json:"error,omitempty"`
+}
+
+func main() {
+	addr := flag.String("addr", ":9000", "address to listen on")
+	replay := flag.String("replay", "", "replay recorded event files (a file or a directory of *.json) instead of listening")
+	record := flag.String("record", "", "directory to record incoming events into")
+	cold := flag.Bool("cold", false, "run initialize and preStop around every invocation to reproduce cold starts")
+	name := flag.String("function", "bookofben-api", "function name reported in the fake context")
+	memory := flag.String("memory", "512", "memory size in MB reported in the fake context")
+	timeout := flag.Duration("timeout", 60*time.Second, "invocation timeout")
+	region := flag.String("region", "ap-southeast-1", "region reported in the fake context")
+	flag.Parse()
+
+	e := &emulator{
+		function: fccontext.Function{
+			Name:    *name,
+			Handler: "main",
+			Memory:  *memory,
+			Timeout: int(timeout.Seconds()),
+		},
+		region:    *region,
+		timeout:   *timeout,
+		cold:      *cold,
+		recordDir: *record,
+	}
+
+	if *replay != "" {
+		err := e.replay(*replay)
+		e.stop()
+		if err != nil {
+			slog.Error("replay failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if e.recordDir != "" {
+		if err := os.MkdirAll(e.recordDir, 0o755); err != nil {
+			slog.Error("failed to create record directory", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	server := &http.Server{Addr: *addr, Handler: e}
+	go func() {
+		slog.Info("Function Compute emulator listening", "addr", *addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("emulator failed to start", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	server.Shutdown(ctx)
+	e.stop()
+}
+
+// ServeHTTP converts the request into an HTTP trigger event and invokes the handler
+func (e *emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestID := newRequestID()
+
+	event, err := fcadapter.FromHTTPRequest(r, requestID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if e.recordDir != "" {
+		if err := e.recordEvent(event); err != nil {
+			slog.Warn("failed to record event", "error", err)
+		}
+	}
+
+	result := e.invoke(requestID, payload)
+	if result.Error != "" {
+		http.Error(w, result.Error, http.StatusBadGateway)
+		return
+	}
+	if err := fcadapter.WriteResponse(w, result.Response); err != nil {
+		slog.Warn("failed to write response", "error", err)
+	}
+}
+
+// invoke runs one invocation, initializing the instance first when needed
+func (e *emulator) invoke(requestID string, payload []byte) invocationResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	result := invocationResult{RequestID: requestID}
+
+	if !e.initialized {
+		ctx, cancel := e.context(newRequestID())
+		start := time.Now()
+		fcapp.Initialize(ctx)
+		cancel()
+		result.ColdStart = true
+		result.InitMillis = millis(time.Since(start))
+		e.initialized = true
+	}
+
+	ctx, cancel := e.context(requestID)
+	start := time.Now()
+	response, err := fcapp.HandleRequest(ctx, payload)
+	cancel()
+	result.Millis = millis(time.Since(start))
+	result.Response = response
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	if e.cold {
+		e.stopLocked()
+	}
+	return result
+}
+
+// replay invokes the handler with every recorded event under path
+func (e *emulator) replay(path string) error {
+	files, err := eventFiles(path)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for _, file := range files {
+		payload, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		result := e.invoke(newRequestID(), payload)
+		result.File = file
+		if err := encoder.Encode(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stop runs the pre-stop hook if the instance is running
+func (e *emulator) stop() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.stopLocked()
+}
+
+func (e *emulator) stopLocked() {
+	if !e.initialized {
+		return
+	}
+	ctx, cancel := e.context(newRequestID())
+	defer cancel()
+	fcapp.PreStop(ctx)
+	e.initialized = false
+}
+
+// context returns a context carrying a fake fccontext, like the FC runtime does
+func (e *emulator) context(requestID string) (context.Context, context.CancelFunc) {
+	fctx := &fccontext.FcContext{
+		RequestID: requestID,
+		Function:  e.function,
+		Service: fccontext.Service{
+			Name:      "local",
+			Qualifier: "LATEST",
+		},
+		Region:    e.region,
+		AccountId: "local",
+	}
+	ctx := fccontext.NewContext(context.Background(), fctx)
+	return context.WithTimeout(ctx, e.timeout)
+}
+
+func (e *emulator) recordEvent(event *fcadapter.Event) error {
+	content, err := json.MarshalIndent(event, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%04d-%s.json",
+		time.Now().Format("20060102T150405"),
+		e.seq.Add(1),
+		strings.ToLower(event.RequestContext.HTTP.Method),
+	)
+	return os.WriteFile(filepath.Join(e.recordDir, name), content, 0o644)
+}
+
+// eventFiles returns path itself or the sorted *.json files of a directory
+func eventFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no event files in %s", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// newRequestID mimics the format of Function Compute request IDs
+func newRequestID() string {
+	random := make([]byte, 12)
+	rand.Read(random)
+	return fmt.Sprintf("1-%08x-%s", time.Now().Unix(), hex.EncodeToString(random))
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/migrate"
+)
+
+const usage = `Usage: migrate [flags] <command> [arg]
+
+Commands:
+  up [version]   apply pending migrations, up to version if given
+  down [steps]   roll back the latest applied migrations (default 1)
+  status         list migrations and whether they are applied
+  redo           roll back and re-apply the latest applied migration
+
+Flags:
+`
+
+func main() {
+	configFile := flag.String("config", "", "path to the YAML config file")
+	jsonOutput := flag.Bool("json", false, "print status as JSON")
+	lockWait := flag.Duration("lock-wait", 5*time.Minute, "how long to wait for another instance holding the migration lock")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	cfg, err := config.Load(*configFile)
+	if err != nil {
+		slog.Error("failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	logging.Setup(cfg.Log.Level)
+
+	if cfg.Database.URI == "" {
+		slog.Error("MONGO_CONNECTION not set in environment")
+		os.Exit(1)
+	}
+	if err := database.InitMongoDB(ctx, cfg.Database); err != nil {
+		slog.Error("failed to initialize database", "error", err)
+		os.Exit(1)
+	}
+
+	runner := migrate.New(database.GetDatabase())
+	runner.LockWait = *lockWait
+
+	err = run(ctx, runner, flag.Arg(0), flag.Arg(1), *jsonOutput)
+	database.Close()
+	if err != nil {
+		slog.Error("migration command failed", "command", flag.Arg(0), "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, runner *migrate.Runner, command, arg string, jsonOutput bool) error {
+	switch command {
+	case "up":
+		target, err := intArg(arg, 0)
+		if err != nil {
+			return err
+		}
+		applied, err := runner.Up(ctx, target)
+		slog.Info("migrations applied", "versions", applied)
+		return err
+	case "down":
+		steps, err := intArg(arg, 1)
+		if err != nil {
+			return err
+		}
+		reverted, err := runner.Down(ctx, steps)
+		slog.Info("migrations rolled back", "versions", reverted)
+		return err
+	case "redo":
+		version, err := runner.Redo(ctx)
+		if err == nil {
+			slog.Info("migration redone", "version", version)
+		}
+		return err
+	case "status":
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return printStatus(statuses, jsonOutput)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+func intArg(arg string, fallback int) (int, error) {
+	if arg == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(arg)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid number %q", arg)
+	}
+	return n, nil
+}
+
+func printStatus(statuses []migrate.Status, jsonOutput bool) error {
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(statuses)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, s := range statuses {
+		applied := "pending"
+		if s.AppliedAt != nil {
+			applied = s.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,247 @@
+// Package annotations stores the private bookmarks, highlights and notes of
+// users. They are kept apart from the public comments collection.
+package annotations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Collections of the store
+const (
+	BookmarksCollection  = "bookmarks"
+	HighlightsCollection = "highlights"
+	NotesCollection      = "notes"
+)
+
+// ErrNotFound is returned for unknown annotations and annotations of other users
+var ErrNotFound = errors.New("annotation not found")
+
+// Range is a span of verses in one chapter as parsed from Reference.
+// StartVerse and EndVerse are 0 when it covers the whole chapter.
+type Range struct {
+	Reference  string `json:"reference" bson:"reference"`
+	BookID     string `json:"book_id" bson:"book_id"`
+	Chapter    int    `json:"chapter" bson:"chapter"`
+	StartVerse int    `json:"start_verse" bson:"start_verse"`
+	EndVerse   int    `json:"end_verse" bson:"end_verse"`
+}
+
+// Bookmark saves a passage in a folder with tags
+type Bookmark struct {
+	ID        bson.ObjectID `json:"id" bson:"_id"`
+	UserID    string        `json:"-" bson:"user_id"`
+	Range     `bson:",inline"`
+	Folder    string    `json:"folder,omitempty" bson:"folder,omitempty"`
+	Tags      []string  `json:"tags" bson:"tags"`
+	Label     string    `json:"label,omitempty" bson:"label,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// Highlight colors a range of verses
+type Highlight struct {
+	ID        bson.ObjectID `json:"id" bson:"_id"`
+	UserID    string        `json:"-" bson:"user_id"`
+	Range     `bson:",inline"`
+	Color     string    `json:"color" bson:"color"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// Note is private text attached to a reference
+type Note struct {
+	ID        bson.ObjectID `json:"id" bson:"_id"`
+	UserID    string        `json:"-" bson:"user_id"`
+	Range     `bson:",inline"`
+	Text      string    `json:"text" bson:"text"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// Overlay is everything a user has attached to a passage
+type Overlay struct {
+	Bookmarks  []Bookmark  `json:"bookmarks"`
+	Highlights []Highlight `json:"highlights"`
+	Notes      []Note      `json:"notes"`
+}
+
+// Filter selects a user's annotations; zero fields match everything
+type Filter struct {
+	BookID  string
+	Chapter int
+	// StartVerse and EndVerse select annotations overlapping the range;
+	// 0 leaves that end open
+	StartVerse int
+	EndVerse   int
+	Folder     string
+	Tag        string
+}
+
+func (f Filter) query(userID string) bson.M {
+	query := bson.M{"user_id": userID}
+	if f.BookID != "" {
+		query["book_id"] = f.BookID
+	}
+	if f.Chapter > 0 {
+		query["chapter"] = f.Chapter
+	}
+	if f.EndVerse > 0 {
+		query["start_verse"] = bson.M{"$lte": f.EndVerse}
+	}
+	if f.StartVerse > 0 {
+		query["$or"] = bson.A{bson.M{"end_verse": 0}, bson.M{"end_verse": bson.M{"$gte": f.StartVerse}}}
+	}
+	if f.Folder != "" {
+		query["folder"] = f.Folder
+	}
+	if f.Tag != "" {
+		query["tags"] = f.Tag
+	}
+	return query
+}
+
+// Store keeps annotations in MongoDB. Every method is scoped to one user.
+type Store struct {
+	bookmarks  *mongo.Collection
+	highlights *mongo.Collection
+	notes      *mongo.Collection
+}
+
+// NewStore creates a store on db
+func NewStore(db *mongo.Database) *Store {
+	return &Store{
+		bookmarks:  db.Collection(BookmarksCollection),
+		highlights: db.Collection(HighlightsCollection),
+		notes:      db.Collection(NotesCollection),
+	}
+}
+
+// CreateBookmark adds a bookmark
+func (s *Store) CreateBookmark(ctx context.Context, b *Bookmark) error {
+	b.ID, b.CreatedAt, b.UpdatedAt = bson.NewObjectID(), time.Now().UTC(), time.Now().UTC()
+	if b.Tags == nil {
+		b.Tags = []string{}
+	}
+	return insert(ctx, s.bookmarks, b)
+}
+
+// ListBookmarks returns a user's bookmarks in reading order
+func (s *Store) ListBookmarks(ctx context.Context, userID string, f Filter) ([]Bookmark, error) {
+	return list[Bookmark](ctx, s.bookmarks, f.query(userID))
+}
+
+// UpdateBookmark sets fields of a user's bookmark and returns it
+func (s *Store) UpdateBookmark(ctx context.Context, userID string, id bson.ObjectID, fields bson.M) (*Bookmark, error) {
+	return update[Bookmark](ctx, s.bookmarks, userID, id, fields)
+}
+
+// DeleteBookmark removes a user's bookmark
+func (s *Store) DeleteBookmark(ctx context.Context, userID string, id bson.ObjectID) error {
+	return remove(ctx, s.bookmarks, userID, id)
+}
+
+// Folders returns the folder names a user has bookmarks in
+func (s *Store) Folders(ctx context.Context, userID string) ([]string, error) {
+	result := s.bookmarks.Distinct(ctx, "folder", bson.M{"user_id": userID, "folder": bson.M{"$gt": ""}})
+	folders := []string{}
+	if err := result.Decode(&folders); err != nil {
+		return nil, fmt.Errorf("failed to fetch folders: %w", err)
+	}
+	return folders, nil
+}
+
+// CreateHighlight adds a highlight
+func (s *Store) CreateHighlight(ctx context.Context, h *Highlight) error {
+	h.ID, h.CreatedAt, h.UpdatedAt = bson.NewObjectID(), time.Now().UTC(), time.Now().UTC()
+	return insert(ctx, s.highlights, h)
+}
+
+// ListHighlights returns a user's highlights in reading order
+func (s *Store) ListHighlights(ctx context.Context, userID string, f Filter) ([]Highlight, error) {
+	return list[Highlight](ctx, s.highlights, f.query(userID))
+}
+
+// UpdateHighlight sets fields of a user's highlight and returns it
+func (s *Store) UpdateHighlight(ctx context.Context, userID string, id bson.ObjectID, fields bson.M) (*Highlight, error) {
+	return update[Highlight](ctx, s.highlights, userID, id, fields)
+}
+
+// DeleteHighlight removes a user's highlight
+func (s *Store) DeleteHighlight(ctx context.Context, userID string, id bson.ObjectID) error {
+	return remove(ctx, s.highlights, userID, id)
+}
+
+// CreateNote adds a note
+func (s *Store) CreateNote(ctx context.Context, n *Note) error {
+	n.ID, n.CreatedAt, n.UpdatedAt = bson.NewObjectID(), time.Now().UTC(), time.Now().UTC()
+	return insert(ctx, s.notes, n)
+}
+
+// ListNotes returns a user's notes in reading order
+func (s *Store) ListNotes(ctx context.Context, userID string, f Filter) ([]Note, error) {
+	return list[Note](ctx, s.notes, f.query(userID))
+}
+
+// UpdateNote sets fields of a user's note and returns it
+func (s *Store) UpdateNote(ctx context.Context, userID string, id bson.ObjectID, fields bson.M) (*Note, error) {
+	return update[Note](ctx, s.notes, userID, id, fields)
+}
+
+// DeleteNote removes a user's note
+func (s *Store) DeleteNote(ctx context.Context, userID string, id bson.ObjectID) error {
+	return remove(ctx, s.notes, userID, id)
+}
+
+func insert(ctx context.Context, collection *mongo.Collection, doc any) error {
+	if _, err := collection.InsertOne(ctx, doc); err != nil {
+		return fmt.Errorf("failed to save %s: %w", collection.Name(), err)
+	}
+	return nil
+}
+
+func list[T any](ctx context.Context, collection *mongo.Collection, query bson.M) ([]T, error) {
+	cursor, err := collection.Find(ctx, query, options.Find().SetSort(bson.D{
+		{Key: "book_id", Value: 1}, {Key: "chapter", Value: 1}, {Key: "start_verse", Value: 1}, {Key: "created_at", Value: 1},
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", collection.Name(), err)
+	}
+	docs := []T{}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", collection.Name(), err)
+	}
+	return docs, nil
+}
+
+func update[T any](ctx context.Context, collection *mongo.Collection, userID string, id bson.ObjectID, fields bson.M) (*T, error) {
+	fields["updated_at"] = time.Now().UTC()
+	var doc T
+	err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "user_id": userID}, bson.M{"$set": fields},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update %s: %w", collection.Name(), err)
+	}
+	return &doc, nil
+}
+
+func remove(ctx context.Context, collection *mongo.Collection, userID string, id bson.ObjectID) error {
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete from %s: %w", collection.Name(), err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Package audit records administrative actions on translations, books,
+// users, API keys and bulk verse data.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Collection stores the audit log
+const Collection = "audit_log"
+
+// Target types of an Entry
+const (
+	TargetTranslation = "translation"
+	TargetBook        = "book"
+	TargetUser        = "user"
+	TargetKey         = "key"
+	TargetVerses      = "verses"
+	TargetBackup      = "backup"
+	TargetCrossRefs   = "cross_references"
+	TargetConcordance = "concordance"
+)
+
+// DefaultLimit is the number of entries List returns when no limit is given
+const DefaultLimit = 100
+
+// Entry is one administrative action
+type Entry struct {
+	ID         bson.ObjectID  `json:"id" bson:"_id"`
+	Actor      string         `json:"actor" bson:"actor"`
+	Action     string         `json:"action" bson:"action"`
+	TargetType string         `json:"target_type" bson:"target_type"`
+	TargetID   string         `json:"target_id" bson:"target_id"`
+	Details    map[string]any `json:"details,omitempty" bson:"details,omitempty"`
+	CreatedAt  time.Time      `json:"created_at" bson:"created_at"`
+}
+
+// Filter selects entries; empty fields match everything
+type Filter struct {
+	Actor      string
+	TargetType string
+	TargetID   string
+	Since      time.Time
+	Limit      int64
+}
+
+// Store keeps the audit log in MongoDB
+type Store struct {
+	entries *mongo.Collection
+}
+
+// NewStore creates a store on db
+func NewStore(db *mongo.Database) *Store {
+	return &Store{entries: db.Collection(Collection)}
+}
+
+// Record appends an entry. Action is "<target type>.<verb>", e.g. "book.add".
+func (s *Store) Record(ctx context.Context, actor, action, targetType, targetID string, details map[string]any) error {
+	entry := Entry{
+		ID:         bson.NewObjectID(),
+		Actor:      actor,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Details:    details,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if _, err := s.entries.InsertOne(ctx, entry); err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+	return nil
+}
+
+// List returns matching entries, newest first
+func (s *Store) List(ctx context.Context, f Filter) ([]Entry, error) {
+	filter := bson.M{}
+	if f.Actor != "" {
+		filter["actor"] = f.Actor
+	}
+	if f.TargetType != "" {
+		filter["target_type"] = f.TargetType
+	}
+	if f.TargetID != "" {
+		filter["target_id"] = f.TargetID
+	}
+	if !f.Since.IsZero() {
+		filter["created_at"] = bson.M{"$gte": f.Since}
+	}
+	limit := f.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	cursor, err := s.entries.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audit entries: %w", err)
+	}
+	entries := []Entry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode audit entries: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+)
+
+// Anonymous is the caller recorded when authentication is disabled
+const Anonymous = "anonymous"
+
+// APIKeyHeader carries an API key; "Authorization: Bearer <key>" works as well
+const APIKeyHeader = "X-API-Key"
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+	KeyID  string `json:"key_id,omitempty"`
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying p
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the caller set by RequireRole
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// RequireRole lets requests through that carry the admin token, or an API key
+// of a user with one of roles. Admins are always allowed.
+// Requests without credentials are rejected unless disabled is set, which lets
+// them through as an Anonymous admin. Routes that keep data per caller add
+// RequireUser.
+func RequireRole(store *Store, adminToken string, disabled bool, roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			bearer, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			key := r.Header.Get(APIKeyHeader)
+			if key == "" && strings.HasPrefix(bearer, keyPrefix) {
+				key = bearer
+			}
+
+			var principal Principal
+			switch {
+			case adminToken != "" && bearer != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(adminToken)) == 1:
+				principal = Principal{UserID: "admin", Role: RoleAdmin}
+			case key != "" && store != nil:
+				apiKey, user, err := store.Authenticate(ctx, key)
+				if err != nil {
+					if !errors.Is(err, ErrInvalidKey) {
+						logging.FromContext(ctx).Error("failed to authenticate API key", "error", err)
+					}
+					http.Error(w, "Unauthorized", http.StatusUnauthorized)
+					return
+				}
+				principal = Principal{UserID: user.ID, Role: user.Role, KeyID: apiKey.ID}
+			case disabled && bearer == "" && key == "":
+				principal = Principal{UserID: Anonymous, Role: RoleAdmin}
+			default:
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			logging.SetUser(ctx, principal.UserID)
+			if principal.KeyID != "" {
+				logging.SetAPIKey(ctx, principal.KeyID)
+			}
+			if principal.Role != RoleAdmin && !slices.Contains(roles, principal.Role) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(ctx, principal)))
+		})
+	}
+}
+
+// RequireUser rejects requests without an authenticated caller, including the
+// Anonymous caller RequireRole lets through when authentication is disabled.
+// It goes after RequireRole, on routes that keep data per caller.
+func RequireUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := PrincipalFromContext(r.Context()); !ok || p.UserID == Anonymous {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Roles of a user
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleReader = "reader"
+)
+
+// keyPrefix starts every API key so leaked keys are easy to recognize
+const keyPrefix = "bob_"
+
+var (
+	// ErrNotFound is returned for unknown users and keys
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidKey is returned for unknown, malformed or revoked API keys
+	ErrInvalidKey = errors.New("invalid API key")
+)
+
+// User is an account that can own API keys
+type User struct {
+	ID        string    `json:"id" bson:"_id"`
+	Name      string    `json:"name" bson:"name"`
+	Email     string    `json:"email,omitempty" bson:"email,omitempty"`
+	Role      string    `json:"role" bson:"role"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// APIKey is a stored API key. Only the SHA-256 of the secret is kept.
+type APIKey struct {
+	ID         string     `json:"id" bson:"_id"`
+	UserID     string     `json:"user_id" bson:"user_id"`
+	Name       string     `json:"name" bson:"name"`
+	Hash       string     `json:"-" bson:"hash"`
+	CreatedAt  time.Time  `json:"created_at" bson:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" bson:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" bson:"revoked_at,omitempty"`
+}
+
+// Store keeps users and API keys in MongoDB
+type Store struct {
+	users *mongo.Collection
+	keys  *mongo.Collection
+}
+
+// NewStore creates a store on db
+func NewStore(db *mongo.Database) *Store {
+	return &Store{
+		users: db.Collection("users"),
+		keys:  db.Collection("api_keys"),
+	}
+}
+
+// ValidRole reports whether role is known
+func ValidRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleEditor, RoleReader:
+		return true
+	}
+	return false
+}
+
+// CreateUser adds a user
+func (s *Store) CreateUser(ctx context.Context, id, name, email, role string) (*User, error) {
+	if id == "" {
+		return nil, errors.New("user id is required")
+	}
+	if !ValidRole(role) {
+		return nil, fmt.Errorf("unknown role %q", role)
+	}
+
+	user := &User{ID: id, Name: name, Email: email, Role: role, CreatedAt: time.Now().UTC()}
+	if _, err := s.users.InsertOne(ctx, user); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("user %q already exists", id)
+		}
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return user, nil
+}
+
+// GetUser returns a user by ID
+func (s *Store) GetUser(ctx context.Context, id string) (*User, error) {
+	var user User
+	if err := s.users.FindOne(ctx, bson.M{"_id": id}).Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("user %q: %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	return &user, nil
+}
+
+// ListUsers returns every user
+func (s *Store) ListUsers(ctx context.Context) ([]User, error) {
+	cursor, err := s.users.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	users := []User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, fmt.Errorf("failed to decode users: %w", err)
+	}
+	return users, nil
+}
+
+// DeleteUser removes a user and revokes all of their keys
+func (s *Store) DeleteUser(ctx context.Context, id string) error {
+	result, err := s.users.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("user %q: %w", id, ErrNotFound)
+	}
+
+	_, err = s.keys.UpdateMany(ctx,
+		bson.M{"user_id": id, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": time.Now().UTC()}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke keys of user %q: %w", id, err)
+	}
+	return nil
+}
+
+// CreateAPIKey issues a key for a user. The returned secret is shown once
+// and cannot be recovered later.
+func (s *Store) CreateAPIKey(ctx context.Context, userID, name string) (*APIKey, string, error) {
+	if _, err := s.GetUser(ctx, userID); err != nil {
+		return nil, "", err
+	}
+
+	idBytes, err := randomBytes(4)
+	if err != nil {
+		return nil, "", err
+	}
+	secretBytes, err := randomBytes(24)
+	if err != nil {
+		return nil, "", err
+	}
+	// The ID is hex, so the first "_" after the prefix ends it
+	id := hex.EncodeToString(idBytes)
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	plaintext := keyPrefix + id + "_" + secret
+
+	key := &APIKey{
+		ID:        id,
+		UserID:    userID,
+		Name:      name,
+		Hash:      hashKey(plaintext),
+		CreatedAt: time.Now().UTC(),
+	}
+	if _, err := s.keys.InsertOne(ctx, key); err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+	return key, plaintext, nil
+}
+
+// ListAPIKeys returns the keys of a user, or of everyone if userID is empty
+func (s *Store) ListAPIKeys(ctx context.Context, userID string) ([]APIKey, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	cursor, err := s.keys.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch API keys: %w", err)
+	}
+	keys := []APIKey{}
+	if err := cursor.All(ctx, &keys); err != nil {
+		return nil, fmt.Errorf("failed to decode API keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey disables a key
+func (s *Store) RevokeAPIKey(ctx context.Context, id string) error {
+	result, err := s.keys.UpdateOne(ctx,
+		bson.M{"_id": id, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": time.Now().UTC()}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("active API key %q: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// Authenticate resolves a plaintext key to its key record and user
+func (s *Store) Authenticate(ctx context.Context, plaintext string) (*APIKey, *User, error) {
+	rest, ok := strings.CutPrefix(plaintext, keyPrefix)
+	if !ok {
+		return nil, nil, ErrInvalidKey
+	}
+	id, _, ok := strings.Cut(rest, "_")
+	if !ok {
+		return nil, nil, ErrInvalidKey
+	}
+
+	var key APIKey
+	if err := s.keys.FindOne(ctx, bson.M{"_id": id}).Decode(&key); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil, ErrInvalidKey
+		}
+		return nil, nil, fmt.Errorf("failed to fetch API key: %w", err)
+	}
+	if key.RevokedAt != nil || subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hashKey(plaintext))) != 1 {
+		return nil, nil, ErrInvalidKey
+	}
+
+	user, err := s.GetUser(ctx, key.UserID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil, ErrInvalidKey
+		}
+		return nil, nil, err
+	}
+
+	now := time.Now().UTC()
+	s.keys.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"last_used_at": now}})
+	key.LastUsedAt = &now
+	return &key, user, nil
+}
+
+func hashKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate random key: %w", err)
+	}
+	return buf, nil
+}
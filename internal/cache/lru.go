@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Stats reports cache usage counters
+type Stats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	Size      int    `json:"size"`
+	Capacity  int    `json:"capacity"`
+}
+
+// HitRatio returns hits / (hits + misses), or 0 when the cache has not been used
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type entry[V any] struct {
+	key     string
+	value   V
+	tags    []string
+	expires time.Time
+}
+
+// LRU is a size and TTL bounded least-recently-used cache.
+// It is safe for concurrent use by multiple goroutines.
+type LRU[V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	ll       *list.List
+	items    map[string]*list.Element
+	tags     map[string]map[string]struct{} // tag -> keys
+
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+// New creates a cache holding at most capacity entries, each living for ttl.
+// A ttl of 0 disables expiry.
+func New[V any](capacity int, ttl time.Duration) *LRU[V] {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRU[V]{
+		capacity: capacity,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+		tags:     make(map[string]map[string]struct{}),
+	}
+}
+
+// Get returns the value stored under key if present and not expired
+func (c *LRU[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	elem, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return zero, false
+	}
+
+	e := elem.Value.(*entry[V])
+	if c.ttl > 0 && time.Now().After(e.expires) {
+		c.removeElement(elem)
+		c.misses++
+		return zero, false
+	}
+
+	c.ll.MoveToFront(elem)
+	c.hits++
+	return e.value, true
+}
+
+// Set stores value under key. Tags group entries so they can be invalidated together.
+func (c *LRU[V]) Set(key string, value V, tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+
+	e := &entry[V]{key: key, value: value, tags: tags}
+	if c.ttl > 0 {
+		e.expires = time.Now().Add(c.ttl)
+	}
+	c.items[key] = c.ll.PushFront(e)
+	for _, tag := range tags {
+		keys, ok := c.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tags[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	for c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+		c.evictions++
+	}
+}
+
+// Delete removes key from the cache
+func (c *LRU[V]) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// InvalidateTag removes every entry stored with tag and returns how many were removed
+func (c *LRU[V]) InvalidateTag(tag string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := c.tags[tag]
+	removed := 0
+	for key := range keys {
+		if elem, ok := c.items[key]; ok {
+			c.removeElement(elem)
+			removed++
+		}
+	}
+	delete(c.tags, tag)
+	return removed
+}
+
+// Purge removes all entries. Counters are kept.
+func (c *LRU[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+	c.tags = make(map[string]map[string]struct{})
+}
+
+// Stats returns a snapshot of the cache counters
+func (c *LRU[V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.ll.Len(),
+		Capacity:  c.capacity,
+	}
+}
+
+func (c *LRU[V]) removeElement(elem *list.Element) {
+	e := c.ll.Remove(elem).(*entry[V])
+	delete(c.items, e.key)
+	for _, tag := range e.tags {
+		if keys, ok := c.tags[tag]; ok {
+			delete(keys, e.key)
+			if len(keys) == 0 {
+				delete(c.tags, tag)
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package concordance
+
+import "strings"
+
+// stopwords are left out of frequency lists: English function words,
+// including the archaic forms of the KJV, and the commonest Chinese particles
+// and pronouns
+var stopwords = strings.Fields(`
+	a about after all also am an and any are as at be because been but by
+	can could did do does doth for from had has hath have he her hers him
+	his how i if in into is it its let may me my no nor not now o of on
+	one or our out shall she should so than that the thee their them then
+	there these they thine this those thou thus thy to unto up upon us was
+	we were what when where which while who whom why will with would ye yea
+	you your
+	的 了 之 是 在 和 與 也 就 都 而 又 並 這 那 我 你 他 她 它 們 有 不 為 以 於 其 所 著 要 說
+`)
+
+// Stopwords lists the words left out of frequency lists
+func Stopwords() []string {
+	return stopwords
+}
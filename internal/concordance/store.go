@@ -0,0 +1,310 @@
+// Package concordance indexes the words of the verses collection to look up
+// their occurrences and frequencies.
+package concordance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Collection of the index
+const Collection = "concordance"
+
+// insertBatch is the number of entries written per InsertMany when rebuilding
+const insertBatch = 1000
+
+// Entry records that a word occurs Count times in a verse
+type Entry struct {
+	TranslationID string `json:"translation_id" bson:"translation_id"`
+	BookID        string `json:"book_id" bson:"book_id"`
+	// BookOrder is database.BookOrder, to list occurrences in canonical order
+	BookOrder int    `json:"-" bson:"book_order"`
+	Chapter   int    `json:"chapter" bson:"chapter"`
+	Verse     int    `json:"verse" bson:"verse"`
+	Word      string `json:"word" bson:"word"`
+	Lemma     string `json:"lemma" bson:"lemma"`
+	Count     int    `json:"count" bson:"count"`
+}
+
+// Entries indexes the text of a verse, one entry per word in order of first
+// occurrence. Footnotes are not indexed.
+func Entries(v database.Verse) []Entry {
+	var entries []Entry
+	seen := map[string]int{}
+	for _, word := range Words(v.Text) {
+		if i, ok := seen[word]; ok {
+			entries[i].Count++
+			continue
+		}
+		seen[word] = len(entries)
+		entries = append(entries, Entry{
+			TranslationID: v.TranslationID,
+			BookID:        v.BookID,
+			BookOrder:     database.BookOrder(v.BookID),
+			Chapter:       v.Chapter,
+			Verse:         v.Verse,
+			Word:          word,
+			Lemma:         Lemma(word),
+			Count:         1,
+		})
+	}
+	return entries
+}
+
+// Query selects the occurrences of a word, or of a lemma when Lemma is set
+type Query struct {
+	TranslationID string
+	Word          string
+	Lemma         string
+	BookID        string // all books if empty
+	Offset        int64
+	Limit         int64
+}
+
+func (q Query) filter() bson.M {
+	filter := bson.M{"translation_id": q.TranslationID}
+	if q.Lemma != "" {
+		filter["lemma"] = q.Lemma
+	} else {
+		filter["word"] = q.Word
+	}
+	if q.BookID != "" {
+		filter["book_id"] = q.BookID
+	}
+	return filter
+}
+
+// ChapterCount counts the occurrences of a word in a chapter
+type ChapterCount struct {
+	BookID      string `bson:"book_id"`
+	Chapter     int    `bson:"chapter"`
+	Verses      int    `bson:"verses"`
+	Occurrences int    `bson:"occurrences"`
+}
+
+// FrequencyQuery selects the most frequent words of a translation or book
+type FrequencyQuery struct {
+	TranslationID string
+	BookID        string // all books if empty
+	Lemma         bool   // count lemmas rather than words
+	Exclude       []string
+	Limit         int64
+}
+
+// Frequency is how often a word or lemma occurs, and in how many verses
+type Frequency struct {
+	Word        string `bson:"_id"`
+	Occurrences int    `bson:"occurrences"`
+	Verses      int    `bson:"verses"`
+}
+
+// Store keeps the index in MongoDB
+type Store struct {
+	entries *mongo.Collection
+	verses  *mongo.Collection
+}
+
+// NewStore creates a store on db
+func NewStore(db *mongo.Database) *Store {
+	return &Store{entries: db.Collection(Collection), verses: db.Collection("verses")}
+}
+
+// verseFilter selects the entries of one verse
+func verseFilter(v database.Verse) bson.M {
+	return bson.M{"translation_id": v.TranslationID, "book_id": v.BookID, "chapter": v.Chapter, "verse": v.Verse}
+}
+
+// IndexVerses replaces the entries of verses with those of their text
+func (s *Store) IndexVerses(ctx context.Context, verses []database.Verse) error {
+	var writes []mongo.WriteModel
+	for _, v := range verses {
+		writes = append(writes, mongo.NewDeleteManyModel().SetFilter(verseFilter(v)))
+		for _, entry := range Entries(v) {
+			writes = append(writes, mongo.NewInsertOneModel().SetDocument(entry))
+		}
+	}
+	return s.write(ctx, writes)
+}
+
+// RemoveVerses removes the entries of verses
+func (s *Store) RemoveVerses(ctx context.Context, verses []database.Verse) error {
+	var writes []mongo.WriteModel
+	for _, v := range verses {
+		writes = append(writes, mongo.NewDeleteManyModel().SetFilter(verseFilter(v)))
+	}
+	return s.write(ctx, writes)
+}
+
+func (s *Store) write(ctx context.Context, writes []mongo.WriteModel) error {
+	if len(writes) == 0 {
+		return nil
+	}
+	// Ordered, so each verse is cleared before its entries are inserted
+	if _, err := s.entries.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(true)); err != nil {
+		return fmt.Errorf("failed to update concordance: %w", err)
+	}
+	return nil
+}
+
+// Remove removes the entries of a translation, of a book, or of a book in
+// a translation. It returns the number removed.
+func (s *Store) Remove(ctx context.Context, translationID, bookID string) (int64, error) {
+	filter := bson.M{}
+	if translationID != "" {
+		filter["translation_id"] = translationID
+	}
+	if bookID != "" {
+		filter["book_id"] = bookID
+	}
+	result, err := s.entries.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove concordance entries: %w", err)
+	}
+	return result.DeletedCount, nil
+}
+
+// Rebuild indexes every verse of a translation, or of every translation if
+// translationID is empty, from scratch. It returns the number of verses indexed.
+func (s *Store) Rebuild(ctx context.Context, translationID string) (int, error) {
+	if _, err := s.Remove(ctx, translationID, ""); err != nil {
+		return 0, err
+	}
+	filter := bson.M{}
+	if translationID != "" {
+		filter["translation_id"] = translationID
+	}
+	cursor, err := s.verses.Find(ctx, filter, options.Find().SetProjection(bson.M{"footnotes": 0}))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read verses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	indexed := 0
+	var batch []Entry
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := s.entries.InsertMany(ctx, batch, options.InsertMany().SetOrdered(false)); err != nil {
+			return fmt.Errorf("failed to insert concordance entries: %w", err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+	for cursor.Next(ctx) {
+		var v database.Verse
+		if err := cursor.Decode(&v); err != nil {
+			return indexed, fmt.Errorf("failed to decode verse: %w", err)
+		}
+		batch = append(batch, Entries(v)...)
+		indexed++
+		if len(batch) >= insertBatch {
+			if err := flush(); err != nil {
+				return indexed, err
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return indexed, fmt.Errorf("failed to read verses: %w", err)
+	}
+	return indexed, flush()
+}
+
+// Occurrences returns a page of the verses a word occurs in, in canonical
+// order, and the number of such verses
+func (s *Store) Occurrences(ctx context.Context, q Query) ([]Entry, int64, error) {
+	filter := q.filter()
+	total, err := s.entries.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count occurrences: %w", err)
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "book_order", Value: 1}, {Key: "book_id", Value: 1}, {Key: "chapter", Value: 1}, {Key: "verse", Value: 1}, {Key: "word", Value: 1}}).
+		SetSkip(q.Offset).
+		SetLimit(q.Limit)
+	cursor, err := s.entries.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch occurrences: %w", err)
+	}
+	entries := []Entry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode occurrences: %w", err)
+	}
+	return entries, total, nil
+}
+
+// Counts returns the occurrences of a word per chapter, in canonical order.
+// Offset and Limit are ignored.
+func (s *Store) Counts(ctx context.Context, q Query) ([]ChapterCount, error) {
+	cursor, err := s.entries.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: q.filter()}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "book_id", Value: "$book_id"}, {Key: "chapter", Value: "$chapter"}}},
+			{Key: "book_order", Value: bson.D{{Key: "$first", Value: "$book_order"}}},
+			// A lemma can occur as several words of one verse
+			{Key: "verses", Value: bson.D{{Key: "$addToSet", Value: "$verse"}}},
+			{Key: "occurrences", Value: bson.D{{Key: "$sum", Value: "$count"}}},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "book_id", Value: "$_id.book_id"},
+			{Key: "chapter", Value: "$_id.chapter"},
+			{Key: "book_order", Value: 1},
+			{Key: "verses", Value: bson.D{{Key: "$size", Value: "$verses"}}},
+			{Key: "occurrences", Value: 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "book_order", Value: 1}, {Key: "book_id", Value: 1}, {Key: "chapter", Value: 1}}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count occurrences: %w", err)
+	}
+	counts := []ChapterCount{}
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, fmt.Errorf("failed to decode counts: %w", err)
+	}
+	return counts, nil
+}
+
+// Frequencies returns the most frequent words or lemmas, most frequent first
+func (s *Store) Frequencies(ctx context.Context, q FrequencyQuery) ([]Frequency, error) {
+	field := "word"
+	if q.Lemma {
+		field = "lemma"
+	}
+	match := bson.M{"translation_id": q.TranslationID}
+	if q.BookID != "" {
+		match["book_id"] = q.BookID
+	}
+	if len(q.Exclude) > 0 {
+		match[field] = bson.M{"$nin": slices.Compact(slices.Sorted(slices.Values(q.Exclude)))}
+	}
+
+	cursor, err := s.entries.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "word", Value: "$" + field}, {Key: "book_id", Value: "$book_id"}, {Key: "chapter", Value: "$chapter"}, {Key: "verse", Value: "$verse"}}},
+			{Key: "occurrences", Value: bson.D{{Key: "$sum", Value: "$count"}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$_id.word"},
+			{Key: "occurrences", Value: bson.D{{Key: "$sum", Value: "$occurrences"}}},
+			{Key: "verses", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "occurrences", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: q.Limit}},
+	}, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count words: %w", err)
+	}
+	frequencies := []Frequency{}
+	if err := cursor.All(ctx, &frequencies); err != nil {
+		return nil, fmt.Errorf("failed to decode word counts: %w", err)
+	}
+	return frequencies, nil
+}
@@ -0,0 +1,133 @@
+package concordance
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words splits verse text into lowercase words. Letters and digits form
+// words, joined across inner apostrophes ("lord's"). Han characters are
+// words on their own, as Chinese text has no spaces.
+func Words(text string) []string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			words = append(words, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) && word.Len() > 0:
+			word.WriteRune(unicode.ToLower(r))
+		case (r == '\'' || r == '’') && word.Len() > 0 && i+1 < len(runes) && unicode.IsLetter(runes[i+1]):
+			word.WriteRune('\'')
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words
+}
+
+// Lemma reduces an English word to its stem so that inflections share it:
+// "loved", "loveth" and "loves" are all "love". It follows step 1 of the
+// Porter stemmer, with the archaic -eth ending treated like -ed.
+// Stems need not be words ("pony" is "poni"). Other words are their own lemma.
+func Lemma(word string) string {
+	word = strings.TrimSuffix(word, "'s")
+	if len(word) < 3 || strings.IndexFunc(word, func(r rune) bool { return r < 'a' || r > 'z' }) >= 0 {
+		return word
+	}
+
+	// Step 1a: plurals
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ies"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ss"):
+	case strings.HasSuffix(word, "s"):
+		word = word[:len(word)-1]
+	}
+
+	// Step 1b: past tenses and participles
+	if strings.HasSuffix(word, "eed") {
+		if measure(word[:len(word)-3]) > 0 {
+			word = word[:len(word)-1]
+		}
+	} else {
+		for _, suffix := range []string{"ed", "ing", "eth"} {
+			stem, ok := strings.CutSuffix(word, suffix)
+			if !ok || !hasVowel(stem) || len(stem) < 2 {
+				continue
+			}
+			word = stem
+			switch {
+			case strings.HasSuffix(word, "at"), strings.HasSuffix(word, "bl"), strings.HasSuffix(word, "iz"):
+				word += "e"
+			case doubleConsonant(word) && !strings.ContainsAny(word[len(word)-1:], "lsz"):
+				word = word[:len(word)-1]
+			case measure(word) == 1 && cvc(word):
+				word += "e"
+			}
+			break
+		}
+	}
+
+	// Step 1c: a final y after a vowel-bearing stem
+	if stem, ok := strings.CutSuffix(word, "y"); ok && hasVowel(stem) {
+		word = stem + "i"
+	}
+	return word
+}
+
+// consonant reports whether word[i] is a consonant in the Porter sense: y
+// after a consonant is a vowel
+func consonant(word string, i int) bool {
+	switch word[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !consonant(word, i-1)
+	}
+	return true
+}
+
+// measure counts the vowel-consonant sequences of a stem
+func measure(stem string) int {
+	m := 0
+	for i := 1; i < len(stem); i++ {
+		if consonant(stem, i) && !consonant(stem, i-1) {
+			m++
+		}
+	}
+	return m
+}
+
+func hasVowel(stem string) bool {
+	for i := range len(stem) {
+		if !consonant(stem, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func doubleConsonant(word string) bool {
+	n := len(word)
+	return n >= 2 && word[n-1] == word[n-2] && consonant(word, n-1)
+}
+
+// cvc reports whether word ends consonant-vowel-consonant, the last not w, x or y
+func cvc(word string) bool {
+	n := len(word)
+	return n >= 3 && consonant(word, n-3) && !consonant(word, n-2) && consonant(word, n-1) &&
+		!strings.ContainsAny(word[n-1:], "wxy")
+}
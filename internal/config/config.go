@@ -0,0 +1,425 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	// Time zones must resolve on hosts without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is read when no config file is given and it exists
+const DefaultFile = "config.yaml"
+
+const redacted = "***"
+
+// Config is the typed configuration of every command
+type Config struct {
+	Server     ServerConfig     `yaml:"server" json:"server"`
+	Database   DatabaseConfig   `yaml:"database" json:"database"`
+	CORS       CORSConfig       `yaml:"cors" json:"cors"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit" json:"rate_limit"`
+	Cache      CacheConfig      `yaml:"cache" json:"cache"`
+	Auth       AuthConfig       `yaml:"auth" json:"auth"`
+	Seed       SeedConfig       `yaml:"seed" json:"seed"`
+	VerseOfDay VerseOfDayConfig `yaml:"verse_of_day" json:"verse_of_day"`
+	Log        LogConfig        `yaml:"log" json:"log"`
+	Tracing    TracingConfig    `yaml:"tracing" json:"tracing"`
+	Aliyun     AliyunConfig     `yaml:"aliyun" json:"aliyun"`
+}
+
+// ServerConfig configures the HTTP listener of cmd/api
+type ServerConfig struct {
+	Addr              string        `yaml:"addr" json:"addr"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" json:"read_timeout"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" json:"read_header_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" json:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" json:"idle_timeout"`
+	// ShutdownTimeout bounds how long in-flight requests may drain on shutdown
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" json:"shutdown_timeout"`
+	// DrainDelay keeps serving after readiness turns false so load balancers can react
+	DrainDelay time.Duration `yaml:"drain_delay" json:"drain_delay"`
+	TLS        TLSConfig     `yaml:"tls" json:"tls"`
+	// TrustedProxies are the IPs or CIDR ranges whose X-Forwarded-For and
+	// X-Real-IP headers name the client
+	TrustedProxies []string `yaml:"trusted_proxies" json:"trusted_proxies"`
+}
+
+// TLSConfig enables HTTPS when both files are set.
+// The certificate is reloaded on SIGHUP and when the files change.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file" json:"cert_file"`
+	KeyFile  string `yaml:"key_file" json:"key_file"`
+}
+
+// Enabled reports whether TLS is configured
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != "" && t.KeyFile != ""
+}
+
+// DatabaseConfig configures the MongoDB connection
+type DatabaseConfig struct {
+	URI            string        `yaml:"uri" json:"uri"`
+	Name           string        `yaml:"name" json:"name"`
+	ConnectTimeout time.Duration `yaml:"connect_timeout" json:"connect_timeout"`
+}
+
+// CORSConfig configures cross-origin requests
+type CORSConfig struct {
+	AllowedOrigins   []string `yaml:"allowed_origins" json:"allowed_origins"`
+	AllowCredentials bool     `yaml:"allow_credentials" json:"allow_credentials"`
+	MaxAge           int      `yaml:"max_age" json:"max_age"`
+}
+
+// RateLimitConfig configures the per client IP request limiter
+type RateLimitConfig struct {
+	Enabled           bool    `yaml:"enabled" json:"enabled"`
+	RequestsPerSecond float64 `yaml:"requests_per_second" json:"requests_per_second"`
+	Burst             int     `yaml:"burst" json:"burst"`
+}
+
+// CacheConfig configures the passage and search caches of BibleService. The
+// caches live in the API process, so writes made elsewhere are served only
+// after the TTLs expire.
+type CacheConfig struct {
+	PassageSize int           `yaml:"passage_size" json:"passage_size"`
+	PassageTTL  time.Duration `yaml:"passage_ttl" json:"passage_ttl"`
+	SearchSize  int           `yaml:"search_size" json:"search_size"`
+	SearchTTL   time.Duration `yaml:"search_ttl" json:"search_ttl"`
+}
+
+// AuthConfig holds authentication secrets
+type AuthConfig struct {
+	// AdminToken grants admin access to write endpoints when set
+	AdminToken string `yaml:"admin_token" json:"admin_token"`
+	// Disabled opens write endpoints to requests without credentials, for local development
+	Disabled bool `yaml:"disabled" json:"disabled"`
+}
+
+// SeedConfig controls database seeding
+type SeedConfig struct {
+	// OnStartup applies pending migrations, which include the seed data, at startup
+	OnStartup bool `yaml:"on_startup" json:"on_startup"`
+}
+
+// VerseOfDayConfig configures the verse of the day
+type VerseOfDayConfig struct {
+	// Seed varies the verses picked for days without a curated verse
+	Seed string `yaml:"seed" json:"seed"`
+	// TimeZone decides when a day starts unless a request names its own
+	TimeZone string `yaml:"time_zone" json:"time_zone"`
+}
+
+// LogConfig configures the structured logger
+type LogConfig struct {
+	Level string `yaml:"level" json:"level"`
+}
+
+// TracingConfig configures OpenTelemetry export
+type TracingConfig struct {
+	Exporter string `yaml:"exporter" json:"exporter"`
+}
+
+// AliyunConfig configures the Alibaba Cloud tooling
+type AliyunConfig struct {
+	Region    string `yaml:"region" json:"region"`
+	AccountID string `yaml:"account_id" json:"account_id"`
+}
+
+// Default returns the configuration used when nothing is set
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Addr:              ":8080",
+			ReadTimeout:       15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       120 * time.Second,
+			ShutdownTimeout:   20 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Name:           "bible_api",
+			ConnectTimeout: 10 * time.Second,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   []string{"*"},
+			AllowCredentials: true,
+			MaxAge:           300,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:           false,
+			RequestsPerSecond: 10,
+			Burst:             20,
+		},
+		Cache: CacheConfig{
+			PassageSize: 1024,
+			PassageTTL:  10 * time.Minute,
+			SearchSize:  256,
+			SearchTTL:   5 * time.Minute,
+		},
+		Seed:       SeedConfig{OnStartup: false},
+		VerseOfDay: VerseOfDayConfig{Seed: "bookofben", TimeZone: "UTC"},
+		Log:        LogConfig{Level: "info"},
+		Tracing:    TracingConfig{Exporter: "none"},
+		Aliyun:     AliyunConfig{Region: "ap-southeast-1"},
+	}
+}
+
+// Load builds the configuration from defaults, an optional YAML file, .env and
+// the environment, in increasing order of precedence.
+// An empty path falls back to $CONFIG_FILE and then to config.yaml if present.
+func Load(path string) (*Config, error) {
+	// Load .env file; existing environment variables win
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.Warn("failed to load .env file", "error", err)
+	}
+
+	cfg := Default()
+
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
+	}
+	if path == "" {
+		if _, err := os.Stat(DefaultFile); err == nil {
+			path = DefaultFile
+		}
+	}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(content, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+func (c *Config) applyEnv() error {
+	var errs []error
+
+	envString("LISTEN_ADDR", &c.Server.Addr)
+	errs = append(errs, envDuration("SERVER_READ_TIMEOUT", &c.Server.ReadTimeout))
+	errs = append(errs, envDuration("SERVER_READ_HEADER_TIMEOUT", &c.Server.ReadHeaderTimeout))
+	errs = append(errs, envDuration("SERVER_WRITE_TIMEOUT", &c.Server.WriteTimeout))
+	errs = append(errs, envDuration("SERVER_IDLE_TIMEOUT", &c.Server.IdleTimeout))
+	errs = append(errs, envDuration("SERVER_SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout))
+	errs = append(errs, envDuration("SERVER_DRAIN_DELAY", &c.Server.DrainDelay))
+	envList("SERVER_TRUSTED_PROXIES", &c.Server.TrustedProxies)
+	envString("TLS_CERT_FILE", &c.Server.TLS.CertFile)
+	envString("TLS_KEY_FILE", &c.Server.TLS.KeyFile)
+	envString("MONGO_CONNECTION", &c.Database.URI)
+	envString("MONGO_DATABASE", &c.Database.Name)
+	errs = append(errs, envDuration("MONGO_CONNECT_TIMEOUT", &c.Database.ConnectTimeout))
+	envList("CORS_ALLOWED_ORIGINS", &c.CORS.AllowedOrigins)
+	errs = append(errs, envBool("CORS_ALLOW_CREDENTIALS", &c.CORS.AllowCredentials))
+	errs = append(errs, envInt("CORS_MAX_AGE", &c.CORS.MaxAge))
+	errs = append(errs, envBool("RATE_LIMIT_ENABLED", &c.RateLimit.Enabled))
+	errs = append(errs, envFloat("RATE_LIMIT_RPS", &c.RateLimit.RequestsPerSecond))
+	errs = append(errs, envInt("RATE_LIMIT_BURST", &c.RateLimit.Burst))
+	errs = append(errs, envInt("CACHE_PASSAGE_SIZE", &c.Cache.PassageSize))
+	errs = append(errs, envDuration("CACHE_PASSAGE_TTL", &c.Cache.PassageTTL))
+	errs = append(errs, envInt("CACHE_SEARCH_SIZE", &c.Cache.SearchSize))
+	errs = append(errs, envDuration("CACHE_SEARCH_TTL", &c.Cache.SearchTTL))
+	envString("ADMIN_TOKEN", &c.Auth.AdminToken)
+	errs = append(errs, envBool("AUTH_DISABLED", &c.Auth.Disabled))
+	errs = append(errs, envBool("SEED_ON_STARTUP", &c.Seed.OnStartup))
+	envString("VOTD_SEED", &c.VerseOfDay.Seed)
+	envString("VOTD_TIME_ZONE", &c.VerseOfDay.TimeZone)
+	envString("LOG_LEVEL", &c.Log.Level)
+	envString("OTEL_TRACES_EXPORTER", &c.Tracing.Exporter)
+	envString("ALIBABA_CLOUD_REGION", &c.Aliyun.Region)
+	envString("ALIBABA_CLOUD_ACCOUNT_ID", &c.Aliyun.AccountID)
+
+	return errors.Join(errs...)
+}
+
+// Validate checks that every value is usable
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Server.Addr == "" {
+		errs = append(errs, errors.New("server.addr must not be empty"))
+	}
+	if c.Server.ReadTimeout < 0 || c.Server.ReadHeaderTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.IdleTimeout < 0 || c.Server.DrainDelay < 0 {
+		errs = append(errs, errors.New("server timeouts must not be negative"))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+	if (c.Server.TLS.CertFile == "") != (c.Server.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("server.tls.cert_file and server.tls.key_file must be set together"))
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, err := netip.ParsePrefix(proxy); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(proxy); err != nil {
+			errs = append(errs, fmt.Errorf("server.trusted_proxies: %q is not an IP or CIDR range", proxy))
+		}
+	}
+	if c.Database.Name == "" {
+		errs = append(errs, errors.New("database.name must not be empty"))
+	}
+	if c.Database.ConnectTimeout <= 0 {
+		errs = append(errs, errors.New("database.connect_timeout must be positive"))
+	}
+	if c.Database.URI != "" {
+		if u, err := url.Parse(c.Database.URI); err != nil || (u.Scheme != "mongodb" && u.Scheme != "mongodb+srv") {
+			errs = append(errs, errors.New("database.uri must be a mongodb:// or mongodb+srv:// URI"))
+		}
+	}
+	if len(c.CORS.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("cors.allowed_origins must not be empty"))
+	}
+	if c.CORS.MaxAge < 0 {
+		errs = append(errs, errors.New("cors.max_age must not be negative"))
+	}
+	if c.RateLimit.Enabled && (c.RateLimit.RequestsPerSecond <= 0 || c.RateLimit.Burst <= 0) {
+		errs = append(errs, errors.New("rate_limit.requests_per_second and rate_limit.burst must be positive"))
+	}
+	if c.Cache.PassageSize <= 0 || c.Cache.SearchSize <= 0 {
+		errs = append(errs, errors.New("cache sizes must be positive"))
+	}
+	if c.Cache.PassageTTL < 0 || c.Cache.SearchTTL < 0 {
+		errs = append(errs, errors.New("cache TTLs must not be negative"))
+	}
+	if _, err := time.LoadLocation(c.VerseOfDay.TimeZone); err != nil {
+		errs = append(errs, fmt.Errorf("verse_of_day.time_zone: %w", err))
+	}
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "warning", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level %q is not one of debug, info, warn, error", c.Log.Level))
+	}
+	switch strings.ToLower(c.Tracing.Exporter) {
+	case "", "none", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter %q is not one of none, otlp", c.Tracing.Exporter))
+	}
+	if c.Aliyun.Region == "" {
+		errs = append(errs, errors.New("aliyun.region must not be empty"))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	return nil
+}
+
+// Redacted returns a copy that is safe to print, with secrets masked
+func (c Config) Redacted() Config {
+	c.Database.URI = redactURI(c.Database.URI)
+	if c.Auth.AdminToken != "" {
+		c.Auth.AdminToken = redacted
+	}
+	c.CORS.AllowedOrigins = append([]string(nil), c.CORS.AllowedOrigins...)
+	c.Server.TrustedProxies = append([]string(nil), c.Server.TrustedProxies...)
+	return c
+}
+
+// redactURI masks the password of a connection string
+func redactURI(uri string) string {
+	if uri == "" {
+		return ""
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return redacted
+	}
+	if _, hasPassword := u.User.Password(); hasPassword {
+		// "***" would be percent-encoded, so use a plain word here
+		u.User = url.UserPassword(u.User.Username(), "redacted")
+	}
+	return u.String()
+}
+
+func envString(name string, target *string) {
+	if value, ok := os.LookupEnv(name); ok {
+		*target = value
+	}
+}
+
+func envList(name string, target *[]string) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*target = items
+}
+
+func envBool(name string, target *bool) error {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	*target = parsed
+	return nil
+}
+
+func envInt(name string, target *int) error {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	*target = parsed
+	return nil
+}
+
+func envFloat(name string, target *float64) error {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	*target = parsed
+	return nil
+}
+
+func envDuration(name string, target *time.Duration) error {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	*target = parsed
+	return nil
+}
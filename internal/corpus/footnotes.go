@@ -0,0 +1,85 @@
+package corpus
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+)
+
+var (
+	// aside matches a bracketed editorial aside such as "[1995/6]"
+	aside = regexp.MustCompile(`\[([^\[\]]+)\]`)
+	// yearAside is a Gregorian year or year range: "[1995]", "[1995/6]"
+	yearAside = regexp.MustCompile(`^\d{4}(/\d{1,4})?$`)
+	// sectionAside is a source section marker: "[45c]", "[61a]"
+	sectionAside = regexp.MustCompile(`^\d+[a-z]$`)
+)
+
+// footnoteType classifies an aside: years are editorial, section markers
+// are textual and anything else is a translator's gloss
+func footnoteType(note string) string {
+	switch {
+	case yearAside.MatchString(note):
+		return database.FootnoteEditorial
+	case sectionAside.MatchString(note):
+		return database.FootnoteTextual
+	default:
+		return database.FootnoteTranslator
+	}
+}
+
+// ExtractFootnotes moves the bracketed asides of a corpus verse into
+// footnotes anchored where they stood. The space before an aside goes with
+// it unless a word follows directly: "about 30 [1995/6] years" becomes
+// "about 30 years" with a footnote after "30".
+func ExtractFootnotes(text string) (string, []models.Footnote) {
+	matches := aside.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return text, nil
+	}
+
+	var b strings.Builder
+	var footnotes []models.Footnote
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if start > last && text[start-1] == ' ' && (end == len(text) || !unicode.IsLetter(next) && !unicode.IsDigit(next)) {
+			start--
+		}
+		b.WriteString(text[last:start])
+		note := text[m[2]:m[3]]
+		footnotes = append(footnotes, models.Footnote{
+			Offset: utf8.RuneCountInString(b.String()),
+			Type:   footnoteType(note),
+			Text:   note,
+		})
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String(), footnotes
+}
+
+// InlineFootnotes reverses ExtractFootnotes, putting each footnote back in
+// brackets at its offset
+func InlineFootnotes(text string, footnotes []models.Footnote) string {
+	runes := []rune(text)
+	var b strings.Builder
+	last := 0
+	for _, f := range footnotes {
+		offset := min(max(f.Offset, last), len(runes))
+		b.WriteString(string(runes[last:offset]))
+		if offset > 0 && (offset == len(runes) || !unicode.IsLetter(runes[offset]) && !unicode.IsDigit(runes[offset])) {
+			b.WriteString(" [" + f.Text + "]")
+		} else {
+			b.WriteString("[" + f.Text + "]")
+		}
+		last = offset
+	}
+	b.WriteString(string(runes[last:]))
+	return b.String()
+}
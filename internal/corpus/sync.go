@@ -0,0 +1,409 @@
+package corpus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+	"github.com/tkdnbb/bookofben-api/internal/data"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// The corpus is the English text of the Book of Ben
+const (
+	BookID        = "BEN"
+	BookName      = "The Book of Jachanan Ben Kathryn"
+	TranslationID = "en"
+)
+
+// chaptersCollection stores the content hash of every synced chapter
+const chaptersCollection = "corpus_chapters"
+
+// Operations of a Change
+const (
+	OpInsert = "insert"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// Options control a sync
+type Options struct {
+	// DryRun computes the report without writing
+	DryRun bool
+	// Force compares chapters even when their hash is unchanged
+	Force bool
+	// Editor is recorded in the verse history; defaults to "corpus-sync"
+	Editor string
+}
+
+// Change is one verse that differs between the corpus and the database
+type Change struct {
+	Chapter int    `json:"chapter"`
+	Verse   int    `json:"verse"`
+	Op      string `json:"op"`
+	Old     string `json:"old,omitempty"`
+	New     string `json:"new,omitempty"`
+	// The footnotes before and after the change
+	OldFootnotes []models.Footnote `json:"old_footnotes,omitempty"`
+	NewFootnotes []models.Footnote `json:"new_footnotes,omitempty"`
+}
+
+// Report summarizes a sync
+type Report struct {
+	Revision  string   `json:"revision"`
+	DryRun    bool     `json:"dry_run"`
+	Chapters  int      `json:"chapters"`
+	Skipped   int      `json:"skipped"`
+	Changed   []int    `json:"changed_chapters"`
+	Inserted  int      `json:"inserted"`
+	Updated   int      `json:"updated"`
+	Deleted   int      `json:"deleted"`
+	Changes   []Change `json:"changes"`
+	Duration  float64  `json:"duration_ms"`
+	Completed bool     `json:"completed"`
+}
+
+// chapterState is the corpus_chapters document of a chapter
+type chapterState struct {
+	ID            string    `bson:"_id"`
+	BookID        string    `bson:"book_id"`
+	TranslationID string    `bson:"translation_id"`
+	Chapter       int       `bson:"chapter"`
+	Hash          string    `bson:"hash"`
+	Verses        int       `bson:"verses"`
+	SyncedAt      time.Time `bson:"synced_at"`
+}
+
+// storedVerse is a verse document as needed for diffing
+type storedVerse struct {
+	ID        bson.ObjectID     `bson:"_id"`
+	Verse     int               `bson:"verse"`
+	Text      string            `bson:"text"`
+	Footnotes []models.Footnote `bson:"footnotes"`
+}
+
+// ChapterHash returns the content hash of a chapter's verses as stored,
+// with their asides moved into footnotes
+func ChapterHash(verses []string) string {
+	hash := sha256.New()
+	for i, raw := range verses {
+		text, footnotes := ExtractFootnotes(raw)
+		fmt.Fprintf(hash, "%d\t%s\n", i+1, text)
+		for _, f := range footnotes {
+			fmt.Fprintf(hash, "\t%d\t%s\t%s\n", f.Offset, f.Type, f.Text)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Sync makes the verses collection match the embedded corpus, with the
+// bracketed asides of verses stored as footnotes. Unchanged
+// chapters are skipped by hash, changed chapters are written with one bulk
+// write each, and running it again is a no-op.
+func Sync(ctx context.Context, db *mongo.Database, opts Options) (Report, error) {
+	ctx, span := tracing.Start(ctx, "corpus.Sync")
+	defer span.End()
+
+	start := time.Now()
+	report := Report{Revision: data.Revision(), DryRun: opts.DryRun, Changed: []int{}, Changes: []Change{}}
+
+	states, err := loadStates(ctx, db)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return report, err
+	}
+
+	total := data.GetTotalChapters()
+	for chapter := 1; chapter <= total; chapter++ {
+		verses := data.GetChapterVerses(chapter)
+		hash := ChapterHash(verses)
+		report.Chapters++
+
+		if state, ok := states[chapter]; ok && state.Hash == hash && !opts.Force {
+			report.Skipped++
+			continue
+		}
+
+		changes, err := syncChapter(ctx, db, chapter, verses, opts.DryRun)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return report, fmt.Errorf("chapter %d: %w", chapter, err)
+		}
+		report.add(chapter, changes)
+
+		if !opts.DryRun {
+			if err := recordRevisions(ctx, db, changes, opts.Editor, report.Revision); err != nil {
+				tracing.RecordError(span, err)
+				return report, fmt.Errorf("chapter %d: %w", chapter, err)
+			}
+			if err := indexChanges(ctx, db, changes); err != nil {
+				tracing.RecordError(span, err)
+				return report, fmt.Errorf("chapter %d: %w", chapter, err)
+			}
+			if err := saveState(ctx, db, chapter, hash, len(verses)); err != nil {
+				tracing.RecordError(span, err)
+				return report, fmt.Errorf("chapter %d: %w", chapter, err)
+			}
+		}
+	}
+
+	// Chapters that are no longer part of the corpus
+	changes, err := removeExtraChapters(ctx, db, total, opts.DryRun)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return report, err
+	}
+	for _, change := range changes {
+		report.add(change.Chapter, []Change{change})
+	}
+	if !opts.DryRun {
+		if err := recordRevisions(ctx, db, changes, opts.Editor, report.Revision); err != nil {
+			tracing.RecordError(span, err)
+			return report, err
+		}
+		if err := indexChanges(ctx, db, changes); err != nil {
+			tracing.RecordError(span, err)
+			return report, err
+		}
+	}
+
+	report.Duration = float64(time.Since(start).Microseconds()) / 1000
+	report.Completed = true
+	span.SetAttributes(
+		attribute.Int("corpus.inserted", report.Inserted),
+		attribute.Int("corpus.updated", report.Updated),
+		attribute.Int("corpus.deleted", report.Deleted),
+	)
+	slog.InfoContext(ctx, "corpus sync finished",
+		"revision", report.Revision,
+		"dry_run", report.DryRun,
+		"skipped", report.Skipped,
+		"changed_chapters", len(report.Changed),
+		"inserted", report.Inserted,
+		"updated", report.Updated,
+		"deleted", report.Deleted,
+	)
+	return report, nil
+}
+
+func (r *Report) add(chapter int, changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+	if n := len(r.Changed); n == 0 || r.Changed[n-1] != chapter {
+		r.Changed = append(r.Changed, chapter)
+	}
+	for _, change := range changes {
+		switch change.Op {
+		case OpInsert:
+			r.Inserted++
+		case OpUpdate:
+			r.Updated++
+		case OpDelete:
+			r.Deleted++
+		}
+	}
+	r.Changes = append(r.Changes, changes...)
+}
+
+// syncChapter diffs one chapter and applies the changes
+func syncChapter(ctx context.Context, db *mongo.Database, chapter int, verses []string, dryRun bool) ([]Change, error) {
+	collection := db.Collection("verses")
+
+	cursor, err := collection.Find(ctx, bson.M{"book_id": BookID, "translation_id": TranslationID, "chapter": chapter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load verses: %w", err)
+	}
+	var stored []storedVerse
+	if err := cursor.All(ctx, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode verses: %w", err)
+	}
+
+	existing := make(map[int]storedVerse, len(stored))
+	var changes []Change
+	var writes []mongo.WriteModel
+	for _, v := range stored {
+		if _, dup := existing[v.Verse]; dup || v.Verse < 1 || v.Verse > len(verses) {
+			// Duplicate verse numbers and verses beyond the chapter are removed
+			changes = append(changes, Change{Chapter: chapter, Verse: v.Verse, Op: OpDelete, Old: v.Text, OldFootnotes: v.Footnotes})
+			writes = append(writes, mongo.NewDeleteOneModel().SetFilter(bson.M{"_id": v.ID}))
+			continue
+		}
+		existing[v.Verse] = v
+	}
+
+	for i, raw := range verses {
+		number := i + 1
+		text, footnotes := ExtractFootnotes(raw)
+		current, ok := existing[number]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Chapter: chapter, Verse: number, Op: OpInsert, New: text, NewFootnotes: footnotes})
+			writes = append(writes, mongo.NewInsertOneModel().SetDocument(models.Verse{
+				BookID:        BookID,
+				BookName:      BookName,
+				TranslationID: TranslationID,
+				Chapter:       chapter,
+				Verse:         number,
+				Text:          text,
+				Footnotes:     footnotes,
+			}))
+		case current.Text != text || !slices.EqualFunc(current.Footnotes, footnotes, footnoteEqual):
+			changes = append(changes, Change{
+				Chapter: chapter, Verse: number, Op: OpUpdate,
+				Old: current.Text, New: text, OldFootnotes: current.Footnotes, NewFootnotes: footnotes,
+			})
+			update := bson.M{"$set": bson.M{"text": text, "footnotes": footnotes}}
+			if footnotes == nil {
+				update = bson.M{"$set": bson.M{"text": text}, "$unset": bson.M{"footnotes": ""}}
+			}
+			writes = append(writes, mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": current.ID}).SetUpdate(update))
+		}
+	}
+
+	if dryRun || len(writes) == 0 {
+		return changes, nil
+	}
+	if _, err := collection.BulkWrite(ctx, writes); err != nil {
+		return nil, fmt.Errorf("failed to write verses: %w", err)
+	}
+	return changes, nil
+}
+
+func footnoteEqual(a, b models.Footnote) bool {
+	return a.Offset == b.Offset && a.Type == b.Type && a.Text == b.Text && slices.Equal(a.CrossReferences, b.CrossReferences)
+}
+
+// removeExtraChapters deletes stored chapters beyond the end of the corpus
+func removeExtraChapters(ctx context.Context, db *mongo.Database, total int, dryRun bool) ([]Change, error) {
+	filter := bson.M{"book_id": BookID, "translation_id": TranslationID, "chapter": bson.M{"$gt": total}}
+
+	cursor, err := db.Collection("verses").Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load extra chapters: %w", err)
+	}
+	var extra []struct {
+		Chapter   int               `bson:"chapter"`
+		Verse     int               `bson:"verse"`
+		Text      string            `bson:"text"`
+		Footnotes []models.Footnote `bson:"footnotes"`
+	}
+	if err := cursor.All(ctx, &extra); err != nil {
+		return nil, fmt.Errorf("failed to decode extra chapters: %w", err)
+	}
+
+	changes := make([]Change, 0, len(extra))
+	for _, v := range extra {
+		changes = append(changes, Change{Chapter: v.Chapter, Verse: v.Verse, Op: OpDelete, Old: v.Text, OldFootnotes: v.Footnotes})
+	}
+	if dryRun || len(extra) == 0 {
+		return changes, nil
+	}
+
+	if _, err := db.Collection("verses").DeleteMany(ctx, filter); err != nil {
+		return nil, fmt.Errorf("failed to delete extra chapters: %w", err)
+	}
+	if _, err := db.Collection(chaptersCollection).DeleteMany(ctx, bson.M{"book_id": BookID, "translation_id": TranslationID, "chapter": bson.M{"$gt": total}}); err != nil {
+		return nil, fmt.Errorf("failed to delete extra chapter hashes: %w", err)
+	}
+	return changes, nil
+}
+
+// recordRevisions adds the applied changes to the verse history
+func recordRevisions(ctx context.Context, db *mongo.Database, changes []Change, editor, revision string) error {
+	if editor == "" {
+		editor = "corpus-sync"
+	}
+	var revisions []database.Revision
+	for _, change := range changes {
+		var before, after *database.Verse
+		if change.Op != OpInsert {
+			before = changedVerse(change, change.Old, change.OldFootnotes)
+		}
+		if change.Op != OpDelete {
+			after = changedVerse(change, change.New, change.NewFootnotes)
+		}
+		if r, changed := database.NewRevision(before, after, editor, "corpus revision "+revision); changed {
+			revisions = append(revisions, r)
+		}
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+	if _, err := db.Collection(database.RevisionsCollection).InsertMany(ctx, revisions); err != nil {
+		return fmt.Errorf("failed to record revisions: %w", err)
+	}
+	return nil
+}
+
+// indexChanges brings the concordance up to date with the applied changes
+func indexChanges(ctx context.Context, db *mongo.Database, changes []Change) error {
+	var indexed, removed []database.Verse
+	for _, change := range changes {
+		verse := database.Verse{BookID: BookID, TranslationID: TranslationID, Chapter: change.Chapter, Verse: change.Verse, Text: change.New}
+		if change.Op == OpDelete {
+			removed = append(removed, verse)
+		} else {
+			indexed = append(indexed, verse)
+		}
+	}
+	store := concordance.NewStore(db)
+	if err := store.IndexVerses(ctx, indexed); err != nil {
+		return err
+	}
+	return store.RemoveVerses(ctx, removed)
+}
+
+// changedVerse is the Book of Ben verse of change with text and footnotes
+func changedVerse(change Change, text string, footnotes []models.Footnote) *database.Verse {
+	verse := &database.Verse{BookID: BookID, TranslationID: TranslationID, Chapter: change.Chapter, Verse: change.Verse, Text: text}
+	for _, f := range footnotes {
+		verse.Footnotes = append(verse.Footnotes, database.Footnote(f))
+	}
+	return verse
+}
+
+func loadStates(ctx context.Context, db *mongo.Database) (map[int]chapterState, error) {
+	cursor, err := db.Collection(chaptersCollection).Find(ctx, bson.M{"book_id": BookID, "translation_id": TranslationID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chapter hashes: %w", err)
+	}
+	var list []chapterState
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode chapter hashes: %w", err)
+	}
+
+	states := make(map[int]chapterState, len(list))
+	for _, state := range list {
+		states[state.Chapter] = state
+	}
+	return states, nil
+}
+
+func saveState(ctx context.Context, db *mongo.Database, chapter int, hash string, verses int) error {
+	state := chapterState{
+		ID:            fmt.Sprintf("%s:%s:%d", BookID, TranslationID, chapter),
+		BookID:        BookID,
+		TranslationID: TranslationID,
+		Chapter:       chapter,
+		Hash:          hash,
+		Verses:        verses,
+		SyncedAt:      time.Now().UTC(),
+	}
+	_, err := db.Collection(chaptersCollection).ReplaceOne(ctx, bson.M{"_id": state.ID}, state, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save chapter hash: %w", err)
+	}
+	return nil
+}
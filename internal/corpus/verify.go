@@ -0,0 +1,140 @@
+package corpus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/data"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Kinds of an Issue
+const (
+	IssueMissingChapter = "missing_chapter"
+	IssueGap            = "gap"
+	IssueDuplicate      = "duplicate"
+	IssueEmpty          = "empty_text"
+)
+
+// VerseRef is the part of a verse needed for integrity checks
+type VerseRef struct {
+	BookID        string `bson:"book_id"`
+	TranslationID string `bson:"translation_id"`
+	Chapter       int    `bson:"chapter"`
+	Verse         int    `bson:"verse"`
+	Text          string `bson:"text"`
+}
+
+// Issue is one integrity problem
+type Issue struct {
+	Kind          string `json:"kind"`
+	BookID        string `json:"book_id"`
+	TranslationID string `json:"translation_id"`
+	Chapter       int    `json:"chapter"`
+	Verse         int    `json:"verse,omitempty"`
+	Detail        string `json:"detail"`
+}
+
+// Check finds missing chapters, verse gaps, duplicate verse numbers and empty
+// text, per book and translation
+func Check(verses []VerseRef) []Issue {
+	sorted := append([]VerseRef(nil), verses...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.BookID != b.BookID {
+			return a.BookID < b.BookID
+		}
+		if a.TranslationID != b.TranslationID {
+			return a.TranslationID < b.TranslationID
+		}
+		if a.Chapter != b.Chapter {
+			return a.Chapter < b.Chapter
+		}
+		return a.Verse < b.Verse
+	})
+
+	issues := []Issue{}
+	for i, v := range sorted {
+		issue := Issue{BookID: v.BookID, TranslationID: v.TranslationID, Chapter: v.Chapter}
+
+		var prev *VerseRef
+		if i > 0 && sorted[i-1].BookID == v.BookID && sorted[i-1].TranslationID == v.TranslationID {
+			prev = &sorted[i-1]
+		}
+
+		switch {
+		case prev == nil || prev.Chapter != v.Chapter:
+			// First verse of a chapter
+			last := 0
+			if prev != nil {
+				last = prev.Chapter
+			}
+			for missing := last + 1; missing < v.Chapter; missing++ {
+				issue.Chapter = missing
+				issue.Kind, issue.Detail = IssueMissingChapter, "no verses"
+				issues = append(issues, issue)
+			}
+			issue.Chapter = v.Chapter
+			if v.Verse > 1 {
+				issue.Kind, issue.Detail = IssueGap, fmt.Sprintf("verses 1-%d missing", v.Verse-1)
+				issues = append(issues, issue)
+			}
+		case prev.Verse == v.Verse:
+			issue.Kind, issue.Verse, issue.Detail = IssueDuplicate, v.Verse, "verse number stored more than once"
+			issues = append(issues, issue)
+		case v.Verse > prev.Verse+1:
+			issue.Kind, issue.Detail = IssueGap, fmt.Sprintf("verses %d-%d missing", prev.Verse+1, v.Verse-1)
+			issues = append(issues, issue)
+		}
+
+		if strings.TrimSpace(v.Text) == "" {
+			issue.Kind, issue.Chapter, issue.Verse, issue.Detail = IssueEmpty, v.Chapter, v.Verse, "text is empty"
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// CheckEmbedded checks the corpus compiled into the binary
+func CheckEmbedded() []Issue {
+	var verses []VerseRef
+	for chapter := 1; chapter <= data.GetTotalChapters(); chapter++ {
+		for i, text := range data.GetChapterVerses(chapter) {
+			verses = append(verses, VerseRef{BookID: BookID, TranslationID: TranslationID, Chapter: chapter, Verse: i + 1, Text: text})
+		}
+	}
+
+	// Check sees empty chapters in the middle; trailing ones are only visible here
+	issues := Check(verses)
+	for chapter := data.GetTotalChapters(); chapter >= 1; chapter-- {
+		if len(data.GetChapterVerses(chapter)) == 0 {
+			issues = append(issues, Issue{Kind: IssueMissingChapter, BookID: BookID, TranslationID: TranslationID, Chapter: chapter, Detail: "no verses"})
+			continue
+		}
+		break
+	}
+	return issues
+}
+
+// CheckDatabase checks the stored verses, optionally of one book
+func CheckDatabase(ctx context.Context, db *mongo.Database, bookID string) ([]Issue, error) {
+	filter := bson.M{}
+	if bookID != "" {
+		filter["book_id"] = bookID
+	}
+	projection := bson.M{"_id": 0, "book_id": 1, "translation_id": 1, "chapter": 1, "verse": 1, "text": 1}
+
+	cursor, err := db.Collection("verses").Find(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load verses: %w", err)
+	}
+	var verses []VerseRef
+	if err := cursor.All(ctx, &verses); err != nil {
+		return nil, fmt.Errorf("failed to decode verses: %w", err)
+	}
+	return Check(verses), nil
+}
@@ -0,0 +1,157 @@
+package crossrefs
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidReference is returned for dataset references that cannot be parsed
+var ErrInvalidReference = errors.New("invalid cross-reference")
+
+// osisBooks maps OSIS book abbreviations, as used by the OpenBible.info
+// dataset, to USFM book IDs, plus BEN for the Book of Ben. USFM IDs
+// themselves are accepted as well.
+var osisBooks = map[string]string{
+	"gen": "GEN", "exod": "EXO", "lev": "LEV", "num": "NUM", "deut": "DEU",
+	"josh": "JOS", "judg": "JDG", "ruth": "RUT", "1sam": "1SA", "2sam": "2SA",
+	"1kgs": "1KI", "2kgs": "2KI", "1chr": "1CH", "2chr": "2CH", "ezra": "EZR",
+	"neh": "NEH", "esth": "EST", "job": "JOB", "ps": "PSA", "prov": "PRO",
+	"eccl": "ECC", "song": "SNG", "isa": "ISA", "jer": "JER", "lam": "LAM",
+	"ezek": "EZK", "dan": "DAN", "hos": "HOS", "joel": "JOL", "amos": "AMO",
+	"obad": "OBA", "jonah": "JON", "mic": "MIC", "nah": "NAM", "hab": "HAB",
+	"zeph": "ZEP", "hag": "HAG", "zech": "ZEC", "mal": "MAL",
+	"matt": "MAT", "mark": "MRK", "luke": "LUK", "john": "JHN", "acts": "ACT",
+	"rom": "ROM", "1cor": "1CO", "2cor": "2CO", "gal": "GAL", "eph": "EPH",
+	"phil": "PHP", "col": "COL", "1thess": "1TH", "2thess": "2TH", "1tim": "1TI",
+	"2tim": "2TI", "titus": "TIT", "phlm": "PHM", "heb": "HEB", "jas": "JAS",
+	"1pet": "1PE", "2pet": "2PE", "1john": "1JN", "2john": "2JN", "3john": "3JN",
+	"jude": "JUD", "rev": "REV",
+	"ben": "BEN",
+}
+
+// bookID resolves an OSIS abbreviation or a USFM ID
+func bookID(name string) (string, bool) {
+	if id, ok := osisBooks[strings.ToLower(name)]; ok {
+		return id, true
+	}
+	upper := strings.ToUpper(name)
+	for _, id := range osisBooks {
+		if id == upper {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// verseRef is one verse of a dataset reference
+type verseRef struct {
+	book    string
+	chapter int
+	verse   int
+}
+
+// parseVerse parses "Gen.1.1"
+func parseVerse(s string) (verseRef, error) {
+	parts := strings.Split(strings.TrimSpace(s), ".")
+	if len(parts) != 3 {
+		return verseRef{}, fmt.Errorf("%w: %q", ErrInvalidReference, s)
+	}
+	book, ok := bookID(parts[0])
+	if !ok {
+		return verseRef{}, fmt.Errorf("%w: unknown book %q", ErrInvalidReference, parts[0])
+	}
+	chapter, err1 := strconv.Atoi(parts[1])
+	verse, err2 := strconv.Atoi(parts[2])
+	if err1 != nil || err2 != nil || chapter < 1 || verse < 1 {
+		return verseRef{}, fmt.Errorf("%w: %q", ErrInvalidReference, s)
+	}
+	return verseRef{book, chapter, verse}, nil
+}
+
+// parseTarget parses "Gen.1.1" or a range "Prov.8.22-Prov.8.30". The end of
+// a range may also be a bare verse number of the same chapter.
+func parseTarget(s string) (Target, error) {
+	from, to, isRange := strings.Cut(s, "-")
+	start, err := parseVerse(from)
+	if err != nil {
+		return Target{}, err
+	}
+	end := start
+	if isRange {
+		if verse, err := strconv.Atoi(strings.TrimSpace(to)); err == nil {
+			end.verse = verse
+		} else if end, err = parseVerse(to); err != nil {
+			return Target{}, err
+		}
+	}
+	if end.book != start.book || end.chapter < start.chapter || (end.chapter == start.chapter && end.verse < start.verse) {
+		return Target{}, fmt.Errorf("%w: %q is not a range within one book", ErrInvalidReference, s)
+	}
+	return Target{BookID: start.book, Chapter: start.chapter, Verse: start.verse, EndChapter: end.chapter, EndVerse: end.verse}, nil
+}
+
+// Read parses a cross-reference dataset. Each record is
+//
+//	from<SEP>to[<SEP>weight[<SEP>type]]
+//
+// with OSIS references such as "Gen.1.1" and "Prov.8.22-Prov.8.30", as in
+// the OpenBible.info cross_references.txt. A header row and lines starting
+// with # are skipped. Records without a type get defaultType.
+func Read(r io.Reader, comma rune, dataset, defaultType string) ([]CrossReference, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var refs []CrossReference
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return refs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected at least 2 fields, got %d", line, len(record))
+		}
+
+		source, err := parseVerse(record[0])
+		if err != nil {
+			if line == 1 {
+				continue // header
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		target, err := parseTarget(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		ref := CrossReference{
+			SourceBookID:  source.book,
+			SourceChapter: source.chapter,
+			SourceVerse:   source.verse,
+			Target:        target,
+			Type:          defaultType,
+			Dataset:       dataset,
+		}
+		if len(record) > 2 && strings.TrimSpace(record[2]) != "" {
+			if ref.Weight, err = strconv.Atoi(strings.TrimSpace(record[2])); err != nil {
+				return nil, fmt.Errorf("line %d: invalid weight %q", line, record[2])
+			}
+		}
+		if len(record) > 3 && strings.TrimSpace(record[3]) != "" {
+			ref.Type = strings.ToLower(strings.TrimSpace(record[3]))
+		}
+		if !ValidType(ref.Type) {
+			return nil, fmt.Errorf("line %d: unknown type %q", line, ref.Type)
+		}
+		refs = append(refs, ref)
+	}
+}
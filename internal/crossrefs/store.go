@@ -0,0 +1,165 @@
+// Package crossrefs stores links from verses to related verse ranges and
+// imports them from cross-reference datasets.
+package crossrefs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Collection of the store
+const Collection = "cross_references"
+
+// Types of cross-references
+const (
+	TypeRelated   = "related"
+	TypeParallel  = "parallel"
+	TypeQuotation = "quotation"
+	TypeAllusion  = "allusion"
+)
+
+// Types lists every cross-reference type
+var Types = []string{TypeRelated, TypeParallel, TypeQuotation, TypeAllusion}
+
+// ValidType reports whether t is a known type
+func ValidType(t string) bool {
+	return slices.Contains(Types, t)
+}
+
+// insertBatch is the number of cross-references written per InsertMany
+const insertBatch = 1000
+
+// Target is the verse range a cross-reference points to
+type Target struct {
+	BookID     string `json:"book_id" bson:"target_book_id"`
+	Chapter    int    `json:"chapter" bson:"target_chapter"`
+	Verse      int    `json:"verse" bson:"target_verse"`
+	EndChapter int    `json:"end_chapter" bson:"target_end_chapter"`
+	EndVerse   int    `json:"end_verse" bson:"target_end_verse"`
+}
+
+// CrossReference links a source verse to a target range. Weight ranks the
+// links of a verse; for the OpenBible.info dataset it is the vote count.
+type CrossReference struct {
+	ID            bson.ObjectID `json:"-" bson:"_id,omitempty"`
+	SourceBookID  string        `json:"source_book_id" bson:"source_book_id"`
+	SourceChapter int           `json:"source_chapter" bson:"source_chapter"`
+	SourceVerse   int           `json:"source_verse" bson:"source_verse"`
+	Target        `bson:",inline"`
+	Type          string `json:"type" bson:"type"`
+	Weight        int    `json:"weight" bson:"weight"`
+	Dataset       string `json:"dataset" bson:"dataset"`
+}
+
+// Query selects the cross-references of a chapter or verse range
+type Query struct {
+	BookID  string
+	Chapter int
+	// StartVerse and EndVerse are 0 for the whole chapter
+	StartVerse int
+	EndVerse   int
+	Type       string
+	Dataset    string
+	MinWeight  *int
+	Limit      int64
+}
+
+// Dataset is an imported dataset and its size
+type Dataset struct {
+	Name  string `json:"name" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
+// Store keeps cross-references in MongoDB
+type Store struct {
+	collection *mongo.Collection
+}
+
+// NewStore creates a store on db
+func NewStore(db *mongo.Database) *Store {
+	return &Store{collection: db.Collection(Collection)}
+}
+
+// ReplaceDataset removes the cross-references of dataset and inserts refs.
+// It returns the number removed.
+func (s *Store) ReplaceDataset(ctx context.Context, dataset string, refs []CrossReference) (int64, error) {
+	removed, err := s.DeleteDataset(ctx, dataset)
+	if err != nil {
+		return 0, err
+	}
+	for batch := range slices.Chunk(refs, insertBatch) {
+		for i := range batch {
+			batch[i].ID = bson.NewObjectID()
+			batch[i].Dataset = dataset
+		}
+		if _, err := s.collection.InsertMany(ctx, batch, options.InsertMany().SetOrdered(false)); err != nil {
+			return removed, fmt.Errorf("failed to insert cross-references: %w", err)
+		}
+	}
+	return removed, nil
+}
+
+// DeleteDataset removes every cross-reference of dataset
+func (s *Store) DeleteDataset(ctx context.Context, dataset string) (int64, error) {
+	result, err := s.collection.DeleteMany(ctx, bson.M{"dataset": dataset})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete dataset %q: %w", dataset, err)
+	}
+	return result.DeletedCount, nil
+}
+
+// Datasets lists the imported datasets with their sizes
+func (s *Store) Datasets(ctx context.Context) ([]Dataset, error) {
+	cursor, err := s.collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$dataset"}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list datasets: %w", err)
+	}
+	datasets := []Dataset{}
+	if err := cursor.All(ctx, &datasets); err != nil {
+		return nil, fmt.Errorf("failed to decode datasets: %w", err)
+	}
+	return datasets, nil
+}
+
+// List returns the cross-references from the verses selected by q, by
+// source verse and then by descending weight
+func (s *Store) List(ctx context.Context, q Query) ([]CrossReference, error) {
+	filter := bson.M{"source_book_id": q.BookID, "source_chapter": q.Chapter}
+	if q.StartVerse > 0 {
+		filter["source_verse"] = bson.M{"$gte": q.StartVerse, "$lte": q.EndVerse}
+	}
+	if q.Type != "" {
+		filter["type"] = q.Type
+	}
+	if q.Dataset != "" {
+		filter["dataset"] = q.Dataset
+	}
+	if q.MinWeight != nil {
+		filter["weight"] = bson.M{"$gte": *q.MinWeight}
+	}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "source_verse", Value: 1}, {Key: "weight", Value: -1},
+		{Key: "target_book_id", Value: 1}, {Key: "target_chapter", Value: 1}, {Key: "target_verse", Value: 1},
+	})
+	if q.Limit > 0 {
+		opts.SetLimit(q.Limit)
+	}
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cross-references: %w", err)
+	}
+	refs := []CrossReference{}
+	if err := cursor.All(ctx, &refs); err != nil {
+		return nil, fmt.Errorf("failed to decode cross-references: %w", err)
+	}
+	return refs, nil
+}
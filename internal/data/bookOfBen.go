@@ -1,53 +1,43 @@
 package data
 
-import (
-	"encoding/json"
-	"fmt"
-	"log"
-	"os"
-	"path/filepath"
-)
+//go:generate go run ./gen
 
 const totalChapters = 73
 
-var data = make([][]string, totalChapters)
-
-func init() {
-	for chapterNum := 1; chapterNum <= totalChapters; chapterNum++ {
-		loadChapter(chapterNum)
-	}
-}
-
-// loadChapter 加载指定章节
-func loadChapter(chapterNum int) {
-	chapterPath := filepath.Join("internal", "data", "chapters", fmt.Sprintf("chapter%d.json", chapterNum))
-	content, err := os.ReadFile(chapterPath)
-	if err != nil {
-		log.Fatalf("Failed to read chapter%d.json: %v", chapterNum, err)
-	}
-
-	var verses []string
-	if err := json.Unmarshal(content, &verses); err != nil {
-		log.Fatalf("Failed to unmarshal chapter%d.json: %v", chapterNum, err)
-	}
-
-	data[chapterNum-1] = verses
-	log.Printf("Loaded %d verses for chapter %d", len(verses), chapterNum)
-}
-
 // GetChapterVerses 返回指定章节的经文
+// 经文由 chapters/*.json 预先生成到 corpus_gen.go，启动时无需读取文件
 func GetChapterVerses(chapter int) []string {
-	if chapter < 1 || chapter > 73 {
+	if chapter < 1 || chapter > totalChapters {
 		return nil
 	}
-	return data[chapter-1]
+	return corpus[chapter-1]
 }
 
 // GetAllData 返回所有数据（用于调试）
 func GetAllData() [][]string {
-	return data
+	return corpus[:]
 }
 
 func GetTotalChapters() int {
 	return totalChapters
 }
+
+// TotalVerses 返回语料中的经文总数
+func TotalVerses() int {
+	return corpusVerses
+}
+
+// Revision 返回语料内容的哈希，语料变更时随之改变
+func Revision() string {
+	return corpusRevision
+}
+
+// Loaded 检查每一章都有经文
+func Loaded() bool {
+	for _, verses := range corpus {
+		if len(verses) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,966 @@
+// Code generated by internal/data/gen; DO NOT EDIT.
+// Source: internal/data/chapters/chapter*.json
+
+package data
+
+var corpus = [totalChapters][]string{
+	// Chapter 1
+	{
+		"THE burden of the word of the LORD which came unto John the son of Kathryn, the daughter of Jacob and Messiah’s Light, the son of Karl Hirsch, the son of Abraham, the son of Hillel, when the LORD first drew him out from the nations and inclined his spirit to seek after the LORD. It first came when he was about 30 [1995/6] years of age, saying expressly: “Thou shalt surely be my witness to Israel.”",
+		"But the prophet wrestled at the word of the LORD, and the LORD would not have it inscribed, For it is not yet time that I cry out, that I rend and that I devour; that I heal and that I say Restore. So it was laid to heart.",
+		"It came again in his 37th year. See, I have molded thee. I have given thee pain, and I have given thee fear. I have given thee loss and death, and a burning heart. None can quench what the LORD causeth to burn. Lay it to heart.",
+		"And in his 38th year, which was 5,764, it came as a flood. Inscribe it now, what I have laid in thine heart, and what I shall put from henceforth into thy heart, for it is time that I cry out. And publish thou it abroad at the time of thy turning 40 [2005/2006], for thou shalt serve me in thine old age. For it is time that I plow up and that I plant, that I cause to wax young that which hath waxed old; that which is buried. I reveal unto light, and that which is I pull up and bury.",
+		"Cry out, cry out in the name of the LORD: Destruction and upheaval! Plowing and planting, turning over and deep furrows, the banks thereof without footing. Heavy rains and mud, and the furrows shall be filled, and the line shall grow. I shall fence it in, and I shall reset the hinges of my gate.",
+		"And the word of the LORD came unto me, saying, Behold now in the dusk vision, a great caldron set upon broken desert ground. What doeth it? And I said, “It pitcheth round, as on a whirligig, and doth not steady, but the water slosheth at every point of the brim.”",
+		"So bring I upon the Earth at every quarter, convulsion and upheaval, dismay, a setting on edge, fumes and smoke and a steamy vapour. As the water feedeth the desert from tumult, so shall old seed sprout from upheaval. Publish it abroad. Fear not, for I shall put the fear of thee on mine enemies, and at the time appointed I shall give thee the heart not of a rabbit but of a lion. At my word they shall have confusion of face, for lo, I have not spoken by prophet since days of old.",
+		"(But the prophet wrestled again) And I said: Why should they, O my Lord, hearken unto me, seeing I was not raised after the manner of my fathers, nor in the ways of my mothers? —Seeing also that thou hast not raised up a prophet unto this thy people for so long. Why should it be me?",
+		"And the LORD said: Away, get thee to thy task, for I shall be with thee; and who can prevail against thee? seeing I am a hawk upon thy shoulder and a falcon unhooded against its prey. Whom I call, I shall call, and whose feet I shall place upon the path before me, I shall send before me. Away, son of Isaac, get thee to thy people and declare thou mine heart, what I have placed in thine heart.",
+	},
+	// Chapter 2
+	{
+		"THE word of the LORD which first came unto Jachanan ben Kathryn in the year 5,755 [1995] concerning the Holy Place (Temple).",
+		"Thus saith the LORD, Do I not see Israel gather and wail at the wall? My spirit goeth forth within the land and every man’s heart is turned toward Jerusalem in lament. Is it not the heart of Israel?",
+		"Look upon this stone wall, if thou canst bear it. Listen to them bleat. Look at them bow themselves again and again and with their parted books recite vain words.",
+		"See the concourse of stone as I see it, if thou canst bear the sight. Behold, I, even I, saith the LORD, do see the heart of Israel. The concourse of stone is potted and etched and doth not have the appearance of vigour and hath no similitude unto what it was when it was cut true and polished smooth.",
+		"Beyond it are the promenades of free living cats, and the birds of centuries have cast their dung upon it, and beyond this there is the house of a god which is no god. Yea, have I seen the heart of Israel. Well do they call this place their heart.",
+		"Therefore tread upon this people’s heart, and say unto them:",
+		"Thus saith the LORD God, Thine heart is even as this stone, and beareth no resemblance to what it was. Within it is filth and brambles, unclean things and a place for birds to waste, and in its center are thine own imaginations. Thine idols are loathsome to me; thine imaginations are crudely formed and have no beauty.",
+		"My spirit hath perceived, yea, mine eyes have beheld; therefore my right hand is stretched forth to make the days, even the days that shall come, saith the LORD Almighty, that I shall build again the wasteland of David and restore unto thee thine heart. Even I shall do this.",
+		"Such days as this thou hast not seen in all thy generations since, nor hath ear heard the gentle comfort of God from the far places of thy dispersion. These days have been the days of thine enslavement, and within these days the multitude of thy woundings have wedged thee into the cleft of the rock and sunk thine head between thy knees in weeping. The days that come shall be the days of thine astonishment, the days of thy setting free from thy yokes, from thy sore travail and thy caged heart. For thou art in bondage without me. No matter where thou shouldest dwell, affliction is thy neighbour; desolation is thy mother.",
+		"Thou couldest see only flesh, O daughter of Zion. Goodly flesh and skin like alabaster thou beheldst with pleasure, but mine honour thou couldest not touch. Blood issued forth glory, but thou only hissedst.",
+		"Thine eyes were cast to thy handsome men. Their comely features led thee to destruction, and thou wentest cheerfully enough.",
+		"There they left thee, in the wilderness of thy desolation they caused thee to dwell. In the shadow of darkness thou foundest solitude, and thou soughtest to thyself no light.",
+		"Thou hidst thy shame in the clefts of the rocks, and amongst the scorpions thou learnst a new thing: a coarse temperament was thy way; and thy feet walked upon the hot and sharp rocks. Thy soft skin became calloused; thine heart became old.",
+		"There I would not approach thee. What doth desolation have to do with me, saith the LORD? Let thy lovers console thee. Thou art a hissing to me.",
+	},
+	// Chapter 3
+	{
+		"AND in the 38th year the word of the LORD moreover came unto me, saying, Behold, it is time to cry out, to lift up my voice as a slash of lightning teareth the sky with its thunder. I am a flood upon thee. My word shall be a tempest upon the Earth. I cry out. I proclaim from on high:",
+		"Hear ye one and all the word of the LORD, O ye nations and thou house of backsliding: I withhold not anymore. As a hand hitting the forehead I cause the whole Earth to stop astonied, to gape, and to stutter.",
+		"Shall I make mankind to multiply on the Earth, only for firebrands for the afurnace? Behold, saith the LORD, it shall never be. I shall never let that it should be shut up. Nor shall I bring forth man as the fowls, only to stamp my feet to cause them to fly into the fowler’s net.",
+		"Behold, saith the LORD, the nations are my kingdom and Israel is mine house. I shall return from setting my borders and from building me far cities. I shall asweep out mine house of the dust and the webs that grow only upon quiescence; and I prune the brambles about my garden. I shall remove the shutters, and let the light in. Yea, the very fine scent of jasmine and mandrakes shall fill mine house.",
+		"I shall reset my table and replace my candlestick, and I shall light the way by day and by night to all those of my kingdom, near and far, who shall come to do obeisance before the LORD of hosts.",
+		"I see thee now, O daughter of Zion. Dost thou come also in the way? Ah, thou art old and no longer comely. Thy whoredoms have aged thee; and when thou didst set thy mind to knowledge, behold, it was vanity: strange ideas and evil eyes.",
+		"I see now the report. The truth of it is before me. The LORD hath heard the rumor and confirmeth it. In the markets thy reproach causeth even thy money to be scorned.",
+		"See the merchants magnify themselves against thee, but thou holdest thy peace. Thy skin is too calloused, thine eyes too heavy to care anymore. Thou art tired of thy wounds bound with dirty linen, and tears dried upon thy dirty face.",
+		"Are the cries of merchants so loud, saith the LORD, that my shout of pity cannot they hear? My words are deeds indeed, and my deeds are mercy. What is it to thee if thou must be merciful for a season if thou shalt come to me forever and dwell in my garden of delight?",
+		"Therefore stretch I out my voice, and my voice is salvation and my words dissolve brine. Behold a plain man. I stand at the door. Without thy sight thou shalt not see my garden hinter.",
+		"Thy beauty is gone, O daughter Zion; thou hast long lost thy maiden innocence. Truly, thy widowhood hath been bitter. Now thou wilt hear my call. Above the din of the market thou shalt hear, and my plain flesh thou wilt not abhor.",
+		"The whoredoms of thy youth I sought to redeem. The whoredoms of thy middle-age overflowed. Thy body was young and light and thine ear heavy. Now thou art heavy and slow, and thine ear is light. Now thou shalt hear. Thy youth shall not return for a season, but thou shalt live with what thou hast done.",
+		"Thine eyebrow archeth with curiosity. What stirreth at that desolate house? Come and see, come and see, saith the LORD.",
+		"There is no shame upon me. I have cleansed this place, and I will build it: to turn thy heart to me in purity. Thou shalt build these concourses and I shall sweep thine heart. Thou shalt gild with gold and I shall burnish thy soul and make it gleam brighter than gold, yea greater than very fine gold.",
+		"Then shall thy worship be sweet to me; then shalt thou have this place and call it my house, though I dwell not in buildings, though even the Universe is nought but a bowl that cannot contain a finger of my soul.",
+	},
+	// Chapter 4
+	{
+		"WEEP, O Zion, that thou ever didst bear a son; who can shut the floodgates of our weeping, for the hand of the LORD hath revealed it?",
+		"The mouth of the LORD doth direct mine ears to the sound: aKidron in the sunset doth moan with the weeping of women, weeping as if over their firstborn. Louder is the weep thereof then the cheer when they see Zion formed in marble and gold, for we have seen the hand of the LORD, and a guiltless wound is etched in our pupil. Hear ye the beating of breasts, women beating their breasts in anguish at their firstborn, and saying, Truly my name is Bitterness.",
+		"Astonied looks give place, I say ye, give place to anguish. How is a harden brow become melted! At the sight of the bwounds we without wounds feel pain. All we have become guilty, yet He suffereth no voice to accuse; it is we who with shamed face ask unto him, “Wherefore didst thou do this in the company of thy children?”",
+		"We esteemed Thee not, nor in Thy affliction did pity take us companion. Our hearts spit forth murder, and yet Thou bledst; our imaginations imagined vain things, yet Thy soul was afflicted; even our sins overflowed, but Thou wast whipped. Thou afflictedst thyself, but they Thy bruises have become whiter than snow, but our bright spots are ugly and putrid. Our flesh is rotted, yet we have no wounds; our bodies stink, yet we wash. Thou hast scars, but art more brilliant than the sun. Is this the weeping of triumph, saith the LORD? Is this the sound to greet a Hero? Weep not, O Zion; remove the pit from thy stomach. Rather, shout ye aloud! I say ye, “Blessed is he who cometh in the name of the LORD.” Be thou refreshed in thy God, and be no more ignorant of his way. See thou now, and declare: “I see.”",
+		"Behold, behold, I have declared and my word is good. I live forever and my feet can tread down mountains as tender grapes in the harvest. I bury with mercy, yea, mercy is the only earth I know for them that love me; and my breath can blow the dust off the deepest of graves, and my voice can call and bring forth the bones, even dried bones. I shall bury thee under this earth, and I shall call thee forth with a mighty shout.",
+		"And it shall come to pass in that time, and at that moment, that ye shall call upon the name of the LORD, even upon his goodness, and the LORD shall hear you, and he shall give goodness by an ephah worth an homer, a shekel shall be worth a talent, and it shall cause your hearts to burn, even as ye pull out your hair in anguish over your souls and the souls of your fathers. As a young woman waileth who hath just lost her firstborn at the time of his weaning, so shall ye wail. Lift up thy wounds with thy cry, O Zion, and I shall stretch forth my hafnd, and I shall bring forgiveness.",
+		"As I live, saith the LORD, I will not forebear. Thou shalt look up and feel the alatter rain as before. As a wineskin that is sliced open shall I pour out my spirit upon Israel. Even as thou art overwhelmed at my graciousness, even more shall I overwhelm thee with my mercy, and thy sons and thy daughters shall come to me, and I shall polish their pockmarked hearts.",
+		"Then thou shalt acknowledge thy guilt; just that: acknowledge thy guilt; and I shall silence thy accusers. Thou shalt then worship at Zion with a joyful sound. Thy atimbrals shall be merry, thy horn a rejoicing; and I shall hear thee. Then shalt thou tread my courts with joy and thou shalt dance the fine song of the maiden for the groom in the house of the LORD.",
+		"There thou shalt dwell and be happy, O Israel, and at its ramparts thou shalt look upon these stones of people and thou shalt say, “Look at what the LORD hath built; even our God had mercy, for he hath forgiven us all our sins, even blotted out our unbelief, our great sin, and hath purged our souls. He bloweth our transgression from upon the book as dust that shall no more return. He hath gathered us to the fullest intent of his desire.”",
+		"Desolation shall yield to fatness. It shall give fruit to abundance. Your withered souls shall swell and burst forth like a sweet grape. Kings and angels have desired to see that moment, saith the LORD, and such a shout shall go forth in heaven when the LORD bringeth it to pass.",
+	},
+	// Chapter 5
+	{
+		"GIVE ear, O heavens, cast off your sullen eyelids, O stars. Canst thou imagine greater glory to shake the Earth? Canst thou see a greater wonder? Hast thou seen a virgin restored, or an old woman leap as unto a young Roe again?",
+		"If I awaken the nations from their vain ways would not the Earth still slumber? Did they awound Me that I should bear grudge? But I shall restore thee as a marvel, as a widow to the husband of her youth, that the heavens may sing at the mercy of God.",
+		"The LORD shall approach and the train of his majesty shall flow out from his holy house. As a cascading stream shall it flow over Moriah, and in it shall be his children of all nations. No more shall they be called strangers who come to the LORD.",
+		"Nations shall come by tens and by twenties. Ten thousands times ten thousands speak: Come, cast mercy as the sand. Cast it to the wind, and it shall come back to thee as sand doth in a gale. Come, cast gold of no value, for the treasures of the LORD are as abundant as the wind, and who can measure it upon a scale to determine its portion? As a mighty wind breathe upon us again, O LORD, and give us again thy spirit. Unlock the floodgates of thy soul.",
+		"Gather ’round me, saith the LORD. I gather thee as a chick is gathered under the wing, and nestle thee to my warm breast. If any harm shall come to thee, mine hand beareth the awound, for my right hand— it protecteth thee; and my left hand, it comforteth thee. This shall be the piercing of thine heart, and thy scars shall be healed. Mine hand shall be a shield to any foe; and a wound of battle is upon it that shall draw the beleaguered and warn thine enemies.",
+		"Rise up and shout, O house of Jacob, shout unto heaven at the mercy of God, and in that day all the nations shall marvel! And they shall worship from afar off and from near. The LORD thy God hath spoken.",
+		"The army that destroyeth shall worship. They shall cast off their weapons and pick up the aged and the infirmed and bring them to the house of the LORD, and I shall heal them. Mine house that is encompassed shall conquer. The armies that laid waste shall stand in awe, and shall cast off their idols.",
+		"In that day, as in aforetime, I shall make an end of Israel, Israel that scattereth, Israel that lieth, Israel that denieth the way. Without siege and without pulley, without fire and without the battling ram shall I make an end. They that were within the camp shall be left without, and Jacob shall possess the ramparts of his God.",
+		"But the house of Israel shall be the portion for dogs, and the sword shall devour. All those of my people who call upon the Lord of their own imagining and know me not shall be as if they called upon Buddhim and Allahim and Krishna Baalim.",
+		"Thou art still the younger, O Jacob, my beloved. Thou art a spitz and esteemest thyself evil above thy fathers; but I love thee. O Jacob, I have not forgotten thee. I shall give thee that place; for your sakes I shall give it to you. I shall give you the pleasant gardens. I shall give you a swept house.",
+		"In that day I shall pose a riddle, and thou shalt give me the answer. When was Jacob not Israel? When was the younger divided into two, and the elder portion still served the younger?",
+		"Jacob, O Jacob, thou shalt be my prince again. Thou shalt be Israel, and the elder shall be cast away and without inheritance.",
+	},
+	// Chapter 6
+	{
+		"IN that day thou shalt see my vengeance on they who made thy children few—on they who stopped the womb; upon they also who took thee from thy path to heathen Tels to teach thee their ways as mine, saying: “No more do the ways of the LORD, for they are things which are old.” I shall make their ways few, and their traditions shall be no more remembered. Even I the LORD shall do this.",
+		"For in that day the LORD shall make an end of the nations [Gentiles], and their glory shall be utterly consumed. Their congregations shall be called Impudent, and shall be a place for the casting of dice, for the forum and for the market; their heralds proclaiming the jig and the polka.",
+		"And the LORD shall turn the captivity of the nations into bitterness, and their sojourn shall be as dried timber, that the remnant of mankind shall seek the LORD. He shall plunder the nations, and he shall leave them with gall. For all that they have done he shall utterly devour and cast them off, because of their enchanters, because of their prophets, their vanities, and their lusts. Go to, ye nations! Be as primitives adorning your bodies with costly jewels and elabourate piercings! Your vanities only inflict upon you wounds, and they open up to infection and are a place for the gathering of puss.",
+		"Mount up ye who see and hear not, for the babblers are brought to nought, and the dreamer of dreams is set on edge, and the expounder of tradition hath confusion of face. None have children from that point, and are as unto a dried twig that withereth, one that a wind taketh from the stump.",
+		"So shall the LORD do in that day upon the house of the nations, upon the impudent congregations. Mount up, ye that see and hear not, mount up and be not afraid of what ye see in that day.",
+		"For the LORD shall rise up on Mount Moriah. He shall exalt himself above the mountains and he shall fill in the valleys. All they that come to him shall come on sure ground, and they shall see his glory. Bring thou down our walls; let every fortification fall out that we may see thy rising early.",
+	},
+	// Chapter 7
+	{
+		"THUS saith the LORD, When have I spoken in the secret place, or when have my words been unintelligible? My words are not the words of groves nor the wisps of wind. When have I whispered in the ear or carried my word as skulking gossip?",
+		"Your fathers cannot teach you to hear me, nor train your tongue to speak my words. My words are not tattle nor the rumor of strangers.",
+		"All these years I have not spoken unto thee, O Israel. Hast thou not considered? Doth not even a dog whine when his master’s voice is long absent?",
+		"Yet thou hast not considered the sum of thy ways and looked back to see thy footsteps outlined in blood. Thou hast invented doctrines to cover thine inward parts like a skirt for shame, and vain statutes are the covering upon thine head that thou wilt not bear or confront. Thou makest excuses. As a mantel they cover shame, for they cover the things of thine own imagining. Thy study is perverse. It is wisdom only to lackeys. Thou makest excuses.",
+		"Dost thou think it is mine iniquity that hath caused silence? Nay, but if thou couldst thou wouldest hear the heavens resound with my praise. Thou wouldest run to and fro and not be comforted as a lover seeking her spouse if thou lovest me with all thine heart. If thou knewest how great I am thou wouldest brag until the Earth would beat its ears.",
+		"Thou hast not pined and longed for me, but inventedst debaucheries to keep thy mind at ease. Thou sittest and art idle all the day, inventing excuses.",
+		"When for one day thine heart and tongue do not make excuse, then will I declare thou hast righteousness. If a lens can be made powerful enough that I can see thy righteousness then will I say unto thee that thou needest me not.",
+		"Who hath spoken unto thee in my name, lo, these many generations, these thousands of autumns the leaves have fallen to the grave, and it hath come to pass or hath comforted thee?",
+	},
+	// Chapter 8
+	{
+		"JUDGMENT hath come upon you, O my people, yea, cruel judgment hath come swiftly upon you like a stag and deadly as unto a scorpion’s sting. Without warning and without a word from the LORD hath it come. Yet ye have not inclined your hearts unto the LORD, to deliver you from the sore travails— Nay, but ye have courted disaster. Ye have called upon me, and I have not answered. Ye have sought deliverance, but your bodies have formed hillocks. Your dead ye have left by the wayside; the avenue of your sojourn is littered with the corpses of your dispersion. The LORD’s wrath hath burned furiously, but you have only hardened your hearts lest ye should see. Ye look up only to see the vultures. And because ye see something desiring you, ye think ye must be righteous and possessing something worth extolling.",
+		"It is not my silence, saith the LORD, that hath caused thy foot to continue on the hard ground. Who speaketh to a stone, and how long shall one wait for an intelligent reply?",
+		"Behold, your hearts are hard, the heart of this people is waxed stupid. Your minds are defiled with your pride, and your hard conceit hath made you dumb to reason, and rebelling to the words of the LORD. I shall make the presumptuous woman a blemish unto you; and he that maketh a word an offense to make men count their words before him, even he shall be an offense to me. Your hearts and minds are defiled with your idols, and an image of your fancy is as a totem burned into your pupil that ye may adulate it all the day.",
+		"This generation shall end before me, saith the LORD. This pride shall I no more tolerate upon the Earth; they and the farmers that have become your teachers, and they who were at the plow that have become your elders; and their vain jangling that hath become your law; even this shall be the last of it. I shall sift them out as wheat. Not one shall fall upon the ground that it should take hold and bear the fruit of this generation. None shall live out their days that they may as a burden place it upon the sure and youthful foot to carry it forward into their generation.",
+		"They are the forehead of a foolish body. I remember the feet that were their fathers, in the day that I scattered them; and, behold, ye are indeed the forehead and the princes the crown of an ignorant head. In my sore contempt I would not approach thee. Nor did I walk amongst the promenades of thy ruins in wait for someone to come upon me. But I left thee to thy ruins. Thou madest the scale of the righteous and the wicked equal, in that thy foolishness saith “He punisheth the righteous. How else can we explain our travail?” Is it not meet that I should make the head fall? Is it not good that it should fall, such a foolish thing that doth not consider where he who was an husband unto Israel hath gone? Who shall ask, and who shall enquire?— but he in whom I have placed my heart and he in whom my words do reside.",
+	},
+	// Chapter 9
+	{
+		"AND the word of the LORD came unto me, saying, Hearken unto thy heart, and be attentive to thine ears, for I shall put my heart into thy bosom, and place my will before thine eyes. Thou shalt call upon me and I shall hear thee, and I shall answer thee. Thy prayers for this people I shall put upon thy lips, and the deep groanings I shall apply unto their hearts. Of all they who since old have fallen asleep thou shalt call upon me, and thou shalt say, Thus saith the LORD God; and it shall come to pass. For I do put my words in thine heart and I wrap them around thine head; and thou shalt enquire, Ah, LORD God; and I shall hear thee.",
+		"And the word of the LORD came unto me, saying, What seest thou? And I said, “A tree heavily laden with fruit, and upon the fruit thine holy name.”",
+		"Take and eat, for so do I end the famine that I have brought upon Israel, as I swore unto aAmos that I should bring. Therefore speak unto this people, and say unto them, Thus saith the LORD God of Israel: Run no more to and fro, beloved daughter. Lift up thy downcast head, O my son. Be ye comforted, my people and all ye nations. All ye Gentiles that are called by my name, wedge your staff in Zion and be not moved. He that lusteth after God hath found the Most High.",
+		"Let the evil be vanquished at my word, but let my people rejoice and shout aloud.",
+		"Open thine ears, and hear the pleasant waters, for the LORD thy God doth direct thee again in thy ways, and my words shall give thee peace and they shall give thee life.",
+		"(The burden of the word of the LORD which was given unto John ben Kathryn)",
+		"And this shall be the burden of the word of the LORD which I give unto thee:",
+		"Thus saith the LORD unto thee, I am the God of thy fathers, the God of Abraham, the God of Isaac, and the God of Jacob. Thy mother Kathryn is with me, and thy mother Messiah’s Light. They dance and rejoice with their mother Sarah.",
+		"I am the God of thy people. aIn flesh I did appear amongst them, and from the womb did mine eyes see the travail. I looked about at the poverty of their soul and was amazed. My spirit was grieved within me. The wealth of ages flowed out from me, but they preferred poverty of their flesh. In drunkenness did they bwound me. In ignorance did they call judgment down upon themselves and their children.",
+		"I shall shake off their wine of ignorance, saith the LORD. I shall sober their hearts and fatten their lean souls on my mercy. I shall teach them to fast from the world, and I shall set them up for a sign, and establish them as a far gate in my kingdom, that the overflow may come to me; and the world shall know that I am the LORD.",
+		"Say thou not, O Zion, “I am forsaken,” and, “My Lord hath cast me off for the place of a wound.” I am the LORD. BEING is my name. My name is etched deeper than any wound, and for thee I bore it. For my great namesake I shall gather thee at last. It is I the LORD that doth ever have thine image in my pupil, that declareth now unto thee.",
+		"Beforehand it cometh to pass, I reveal unto you. Before they should happen I tell you of them. Before ye shall hear, I speak. Before I gather Israel, I declare unto you that ye may consider and lay it to heart at the doings of God. Behold, I gather mine offspring from amongst the nations and set them upon the path. Let not the nations say: “I never was,” and that: “His hand is not fierce and his visage dreadful;” and of the days that come, “They come by natural course.”",
+		"They come by my mighty hand, saith the LORD, and my voice precedeth them as a howling wind before storm. For this purpose I raised thee up from thy mother’s womb. I brought thee out from a princeling among the Gentiles and restored thee unto thy people which thou knewest not. I took thee from thy righteousness and showed thee thy sin. From foolishness to wisdom I led thee, for great is the day of the LORD that shall come, and great shall be the repentance before it.",
+		"For this reason raise I up my prophets so that none of my doings shall come without mercy. For, behold, I give warning first: I do not delight in destruction.",
+		"Faint not, nor look upon thine own sin. Why dishearten thyself at blackness? If I should raise thee up to be a prophet, what is it to thee? It shall be my words that shall come to pass, not thine.",
+		"I know thy fears and thine infirmities, thy halting speech. It is for my word that thou wast given these. It is I who have afflicted thee since thy youth, and I shall afflict thee until I take thee; that thou mayest say, Thus saith the LORD God, and that thou may bear it; that thou mayest call upon me rightly, Ah LORD God, and that I should hear thee. See the false prophets around thee; see them carried off in their pride. Rejoice then at my affliction, for thou shalt say, Thus saith the LORD the King, and it shall come to pass.",
+		"See how the Gentiles glorify their prophets. But I have made thee base that thou and Israel shall know that it is I the LORD that doeth these things.",
+		"Tie thy tongue into a ribbon and bow if thou canst, yet when thou speakest Israel shall hear thee, for I shall now cause wonders upon the Earth. Even I, saith the LORD, shall bring my word to pass and I shall turn Israel. As I place my column in the sky to lead them, so shall I place it in their hearts to lead them perpetually.",
+	},
+	// Chapter 10
+	{
+		"AND the word of the LORD moreover came unto me, saying: Seeing that I have made thee a prophet unto Israel, take thou no disciples, lead no one off into a far place, separate not, bind not men with customs, take no money, make no public display, shout thou not in the street, nor speak rashly in my name.",
+		"But rather when thine heart burneth within thee, when it hurteth and when tears choke thee, when thou art in passion of my nature and great name, then speak and write in my name and I shall bring it to pass. Fear not, for it is I that speak from thee.",
+		"And this shall be a sign unto thee: I shall burn these words into the heart of Israel, and thou shalt know that I have spoken from thee. Be not lifted up, nor deceived, for I shall do these things and my spirit shall move men.",
+		"Take heed so that thou fearest not at my word, seeing how hard the heart of Israel hath been and how vain have I let the customs of Jacob become. It is I who shall cause men to turn, not thee. It is I who shall be praised, not thee.",
+		"But when thou hatest thy people for their hard hearts and despisest them for their ignorance, thou shalt not speak in my name, nor in thine anger declare my vengeance. For words spoken at my glory are harsh enough, and even from a steady note can no one stand before my wrath.",
+		"Wages I give thee not; but thou shalt inherit of thy portion as a child. Thy reward thou knowest: Thou shalt see of these things come to pass.",
+		"Thus saith the LORD thy God, Come unto me as a child, and like an admirer in an accomplished man so boast of me. Begrudge me not, for my great glory is greater than man’s and my ways worth shouting across the Universe. If thou wilt admire a great man and be filled with privilege in the company of famous people, how much more should not all mankind seek the LORD who is beyond all things and created all things?",
+		"Speak not what Israel wanteth to hear, nor be joined to any group, nor be led to any place, saying, It is the LORD’s will. I shall not be enquired of concerning those things already said. I bandy not with men, nor am I tried by mankind. If Israel shall say unto thee, ‘Judge us,’ say NO. If they say ‘Administer,’ say NEVER. For I have appointed thee a prophet, and I shall judge Israel, and I shall be their ruler.",
+		"But gird thou up and hold thy reigns within thee, and prophecy unto this people and tell them, Set your hearts to turn; let the hearts of the sons turn to the fathers, so that they might understand how they have wandered, so that they turn.",
+		"Soften thy stony heart into flesh, O Israel, for flesh cannot break, and there is none who can mend rock and make it sound again. A wounded heart I will heal; but a heart of stone shall shatter and crumble under my fist; and I shall scoop up the pieces and cast it to the heap; and it shall be a proverb that a hard heart hath no life and can only break, but a heart of flesh I shall wound and I will heal.",
+		"Set thine heart to be wounded, O foolish wandering Israel, that I may heal, so that at my coming I shall afind faith upon the Earth and shall not utterly destroy all mankind at my sight.",
+		"For, lo, I come suddenly, and my sword is in mine hand, and my justice set to heart; my threshingfloor prepared unannounced. Let my people prepare, let the nations cringe and tremble, for the day of the LORD shall not be as they imagine; and it is a day in which no grain shall be spared the reaper’s eye, nor shall one be overlooked to find refuge; and it is a great and terrible day when all secrets of men’s hearts are purged by the hand of the living God.",
+		"I play amongst the atoms, and from nothing I can create beauty. Who will not tremble at my presence?",
+		"Yet am I as gentle as a father with my children. As gentle as a hand stroking a lamb do I comfort my people. I shall make thy wool white with a touch, and I shall rest thine inward parts.",
+		"Therefore thus say I the LORD unto thee, speak to this people Israel, for I turn the hearts unto the fathers. Turn ye that my day catch you not in shame. This shall be thy burden wherewith I burden thee.",
+		"(And from this point forward no more did John ben Kathryn struggle and wrestle at the word of the LORD, for he did not wish to be a prophet).",
+	},
+	// Chapter 11
+	{
+		"I SHALL now stand in awe with certainty, unto the LORD, for his goodness; his word is unrelenting; his honour unreproachable; they are soothing psalms of edification, for his graciousness endureth forever. Beware, O ye nations, and ye gainsayers of foolish traditions: He shall restore his people with a mighty hand; he shall honour us as at the first with his holy name. For his great namesake he shall prepare the future as a fine confection. He shall shut the presumptuous mouth and bring to nought the thoughts of the self-serving. Our honour is the great name of the LORD, and his presence is our joy! He taketh away our reproach!",
+		"Set it to heart to enquire. I shall seek from mine heart his words; and I shall not take them for granted. Let thy people humble themselves and enquire. Behold, the LORD hath not gone so far that he cannot hear, nor are his feet slow that he cannot deliver his people. Let our prayers go up before the Holy One of Israel, and let them be acceptable.",
+		"Who can bear the words of the LORD! Hearts are hardened and fists beat against temples. For the day of the LORD is declared and his words break the forms of Ephraim and dash the carved stones of Israel, and rework the carved trunks of the nations!",
+		"Plain words overthrow, declareth Ephraim. He searcheth to find a means of annulment. The letter is an idol for him but its meaning is an uncarved form to recarve a meaning, that he might worship the works of his own mind.",
+		"Let us, saith Ephraim, go to and cast the prophets from our midst. Oh heavy burden! Get thee hence and prophesy not. When thou art perished we shall adorn thy tomb and make merry over thy words. Is it not said amongst the people, “What the son wisheth to forget, the grandson wisheth to remember”?— Thus hath Ephraim trusted in the word of the LORD, but thus he recognizeth not the words when the LORD speaketh.",
+		"Wherefore, thus saith the LORD, forasmuch as this is true, thou shalt be plundered, O Ephraim, for by a flea in the ear is a man driven mad. So shall the LORD’s words be unto this generation. They shall not be a shout, to cause one to jolt and dismiss, but they shall be a buzz and a tickle that shall not depart from thine ears or from the house of Israel. So do and, Lay on! Boldly on! Thy finger shall route thine ear until thou art a laughing stock, and the people walk from thee.",
+	},
+	// Chapter 12
+	{
+		"BEHOLD, I see Ephraim upon the mountains and in the watchtowers, yea, the very tall watchtowers. They have set their code, and they signal therewith. Though they reflect the sun, their signal is set to their rhythm. Therefore the stillness of the LORD’s doings they have not detected, and the method of his purpose hath been marvelous unto them, incomprehensible and without form.",
+		"Rise up, O ye inhabitants of Judah and ye dwellers of her uttermost borders, and give the signal to the watchmen. The LORD cometh with a mighty hand, and his fierce ax doth cleave mountains before him! But is it that ye see not also? Howbeit none see?—only because your watchmen peek at points of light and ye dwellers of the land wait upon them. Open up your panorama and see smoke over the mountains. It is not the smoke of fire but of dust, the dust of stampede. Behold, they are not far mountains, O Judah. And the men of them ye know. But, lo, ye know not the LORD your God. The LORD sendeth them not a prophet to stir them up, but I send ye my servant, for many winds shall be stirred, and the dust shall approach you from many lands, yea, and encompass your seed even in far lands.",
+		"The LORD is wonderful in wisdom and comforting in his counsel. And from his depth he doth declare his wise things. Woe unto them who do not enquire, saith the LORD, and woe unto those who do not consider the sum of their ways and the burden of history, who take away from what the LORD hath done, and who add but a foot unto his path, either to lengthen it or to broaden it by their measure.",
+		"The LORD shall turn the dust with his breath and make it a smoke of fire upon mine enemies, to raise up Jacob to bear my name, to deliver the outcasts and gather the dispersed of Israel. What thou, O mighty Syria, devisest in the culverts of Riyadh shall bring down Damascus and shake the foundations of Mecca; and it shall be contemplated in Medina; and Alexandria shall bring down Cairo with it. Hear the sound of the LORD’s quake! The LORD deviseth not in secret of his beloved, but hath drawn my banner in days of old.",
+		"The LORD shall save thee that day, O Judah, by his open counsel with the words he declareth here, as in the days of thy Babylonian captivity. Ye shall no more fall back into your idols, O people, for, lo, the LORD raiseth me up again by wise counsel; he setteth me in an anointed place. By hardness he delivereth thee, and in it shall be glory.",
+		"In this his resolution there is tender purpose. In his deep things there is reason, a fuller’s soap, a trying with fire. It burneth forth not with eloquence, but it refineth the metal of dross and it cleanseth the linen. For the LORD shall open again the mouth of the unlearned, and from the reason of the illiterate will he speak again unto his people. He shall utter his deep things from voices long stilled, and Jerusalem shall rejoice again as a barren widow who hath found joy in the son of her first husband.",
+		"Man’s trials are hard, but the LORD’s are cunning. The meek have upset the mighty, and the brazen have learned to keep their mouth shut. O thou, little Judah, though thou be small and as unto a remnant, halt and quivering, shall God’s salvation burn in thee, and as an ember it shall ignite and it shall consume the world. In that day the Gentiles shall cast off their idols, and no more shall a graven image be found amongst them. No more shall a hand fondle stone and bronze, nor heart wait upon a stony eye. Apples shall not be an offering to brass, nor flowers to the artificer’s vanity. The stone altars of the heathen shall be barren, and their foundations shall be pits. They shall break up their idols, and decay shall grow over them; they shall no more be found.",
+		"For, lo, O Israel, the LORD hath declared plainly unto me: I have so declared it this day: Thou hast seen generations of thy children, and thou seest thy prodigal return. No more shall they call you son. But this day I have called you father. I pronounce it clear that all may know that I am come, and that it is I the LORD that dwelleth amongst them, and it is I the LORD that they behold. The LORD thy Saviour hath spoken it.",
+		"The LORD overturneth the shallowness of the world forever. Thou art too great; this heart hath done too wonderful a thing to draw to thy courts affectations, and for thy caravan to be that of flatterers; that the cloisters of thy garden should be the promenade of gainsayers. Therefore I have decked thee with the array of the lowly, but I have made thy breath as a cool breeze in the desert heat; thy words as clear water to those that thirst. I say unto thee, thou art my breath. Greater art thou than wind, earthquake and fire, wherein was not to be found mine intent; but from a soft voice did I speak.",
+		"How shall a king know the heart of his servant, and how shall he test his piety? A golden crown man will obey in action, but anon his heart grumbleth. With a rough cloak tried I man, and he that followeth doth not grumble. I was not comely, and no man durst follow me by reason of gain. Cast aside thy vestures of fine linen and thy golden jewels. Let thy spirit burst forth at my passing. Follow me. Feel mine hand touch thee and awaken thy reason.",
+		"Behold a wonder, O Israel and ye inhabitants of Judaea, a ripple hath gone forth from Zion as when a block is dropped in water. My ripples are gentle and my block is soft, saith the LORD, yet they brought down multitudes and upset many a course. Who shall believe, saith the LORD, and whose spirit shall be tempered? Let him trace the ripples. Is it not easy? But stop and consider. Doth not a straight line lead to the center from no matter where thou encounterest the ripple? Can a ripple come from a false angle? Trace it. Even from the farthest ripple shall they be safely led.",
+		"For this sake I set in Zion my block, and so I let my ripples silently to go forth. Ephraim was upset and hearkened not. He traced not the ripples but rode out the swells. Let him stop and there consider his God. Yea, our God is the only God.",
+	},
+	// Chapter 13
+	{
+		"HEAR a cry, a cry of toppling, O Judah. Ephraim is cast from the watchtower, for Ephraim saw but he could not say. When too late he could only point to destruction but not to whence cometh salvation. Deliverance is far from him, and its path to him is retreat. For the fear of the LORD in that day shall possess him, and he shall cast himself as a headlong torrent to the sea. He shall cast his wise things and the mantel of his prestige upon the waves and they shall no more come back to him, but they shall be swallowed by the deep.",
+		"They shall take up this song: In days of glory did Ephraim not hearken, and with mighty deeds did he not remain content. The LORD worketh a work of substance, and he shall possess the heart of his people. Let Ephraim fall back. Let the shallowness of the world go on. Those that see destruction be many, and those that delight in it be not a few; but blessed is he who raiseth his voice to proclaim the LORD’s salvation. He shall draw the sword of the LORD’s glory and he shall command the mighty men of valour, and on the day of the LORD they who are humble shall be honoured. Yea, blessed is he who waiteth upon the LORD, yea, blessed is he whom the LORD will not move from his place but goeth before to lead him as a young colt through rocky ground. Yea, he who seeketh the LORD in the stillness of his doings shall not be upset, and through the great and terrible day of the LORD he shall rejoice in his salvation.",
+		"Yet though for a season doeth he wonders, it is by his gentle silence that he shall try thy faith, O son of man, and it is by this faith that thine heart’s blood shall be replaced. Let a man consider. Ponder ye the way and consider what hath been laid in Zion. Try mankind as aforetime, saith the LORD, try them with my signs to set their faith.",
+		"Come ye with this people, O Gentiles. Come, hear the trees sing you a fine tune. Hear the calving of young with the sound of joy. Oh clap your hands, all ye mountains. Skip ye the clouds with his thunderous glory. Come ye to the glorious works of the LORD!",
+		"Stumble not, O Ephraim, seek thou them not because thy faith is unsound. Try and give unto him this word that he might see the ripples again, for I do quell [still/suppress] the ocean that they might see the ripples again, lest my block be placed in vain. My spirit doth go out in stillness. Cast thine eyes upon a tempered sea and behold and but seek.",
+		"Pray, and I shall quell the ocean that there withholden any tempests, and there shall be no storms upon the sea 40 years; for thy faith, O Jacob; for thy rebuke, O Ephraim.",
+		"I am a poor servant indeed if I cannot recognize my master, and bring my head down lower than his. Yet I also see my father. Therefore I shall not prostrate myself, but I shall embrace he who saw not his children, and yet he hath generations unto abundance. Let thy spirit gush forth, O LORD, that our lusts for thy words may be filled. The Earth doth starve. We hunger and yearn, but we are not filled. Ephraim’s cakes are apportioned unto us by mince. But thy words are as sweet as angel’s food. They fill my soul and relax my joints. Let thine Holy Spirit go about mankind and see that we are dumb. Feed us with thy salvation, O my God. Stretch forth thine hand and touch my lips. Feed us, feed us, thou who art my maker, my guide and my beauty. In thy resolve there is life, and this life is evermore.",
+	},
+	// Chapter 14
+	{
+		"AND the word of the LORD came unto me, saying: I shall yet do a wonder in Zion. Not since Horeb, they shall say, hath the LORD caused us to tremble so. He bringeth us to the brink; by the mouth of the deep he causeth us to dwell.",
+		"But the LORD seeth Jacob. We shall not be moved. Let the unbelieving depart. Let them seek the mouth of the deep. Let them watch from afar, for God is with Us; we shall mount up.",
+		"Let his banner be raised. Let it go forth before us. Let the sharpness of his eye spare not our enemies. Let Egypt run to her borders first, for she came late of battle. Let Syria be ashamed, and they that dwelt among us find excuse.",
+		"For our stony hearts did it come to this, for in our hard conceit did we regard the LORD as Buddhim and Baalim and Krishnim.",
+		"Oh that we were not a people who did mischief! Oh that we did not hearken unto the prophets of the nations and the leaders of Israel, speaking peaceably. To every desire of our imagination do they say “thus saith the LORD.”",
+		"But thou didst not speak peaceably, O LORD, for thou dost not condone the vanity of man, nor singest thou the praises of flesh. We have no excuse, for we corrupt the love of God and make it usury. All the willful made it a stumbling block to the weak.",
+		"We would not hearken unto thee in thy forbearing. Thy goodness we greeted with a turn of our backs.",
+		"Our face was to those who did evil in thy sight, and we did not look away. In the name of love they justify fornication, adulteries and murders, envy, jealousy and greed. Whatsoever they desire to do, they call love; and if one taketh unto himself from one must it be taken.",
+		"There is no man that layeth hold, freely given; but covetousness is his god and envy his prophet.",
+		"It is because they delight in evil that they corrupt the ways of the LORD to prevent judgment. They delight in frowardness and audacity, and have a perverse lust for irony. They heed not thy laws that they may be reproved. They use love to tip the scales against those who were defrauded.",
+		"We drank of their cup, and are not innocent. Yet for a little while will thine anger endure, O Holy One. Thou pickest us up, and dustest off our knees. Set us on thy way, O LORD. We shall call that day JHWH RAH’AH, for God hath shown us, and we trembled more at his mercy than at his wrath, for God hath shown himself on the field of battle and hath torn heaven asunder and shouted down his name, and in the hearts of men did it burn.",
+		"O LORD, that we had hearkened unto thy prophets; that we had heard thy voice. But even now at the brink, as the mouth of the deep doth yawn for our souls, thy wrath is of short duration; thy wonder as the fire from Horeb. Stir thou from thy place, O God, and guide us to our borders. Guide us again, O LORD, for we have seen thy salvation.",
+		"In that day, I shall hear thee, O my Jacob. I take the elder from thine head; and the maker of traditions as a babe that learneth of thee, even he shall be at thy feet.",
+		"I shall moreover chastise the Gentiles in that day, saith the LORD. How long ere innocency come? saith the LORD— it cometh with each new generation. So come again the days of thine innocency; to feign its destruction is vanity. It cometh from the womb, wherefrom there is no memory of what hath been.",
+		"Behold, the days come when I shall cause to return the innocency of the nations, saith the LORD.",
+	},
+	// Chapter 15
+	{
+		"BE not dismayed at the ways of the heathen and the signs which their own minds conceive. For one leaneth his elbow upon the mantel and smoketh long on his pipe. There he expoundeth a new idea. He hath gravened another idol. It is without form. It speaketh from his mind, “Lo, here I am,” and he followeth wherever it listeth.",
+		"The nations come together and say: “Let us conspire to throw off the LORD, for so was his anointed created in the days of our ancient.” Be not dismayed at their oracle, for it is quicksilver and it cannot take form. It shall be poison to the mouth that speaketh; poison to the ear that receiveth.",
+		"Oh bemoan, wail and be undone, for the owner of all things draweth nigh, and the visage of the dreadful God is in the heavens. He looketh upon his creation, and he crieth out in anguish: What have they formed that ye should treat your minds as gods? for I see a land laid waste; and the whole earth, it is upside down. He who stoppeth the womb createth not, but saith “Come not forth” to that which I have sewn together. Behold, it was never spoken by my mouth, nor did it ever come into mine heart, that man should so stray and that the righteous should say “It must be.”",
+		"Behold, there is none that abideth; there is not one who remaineth constant. I have found me none: he who maketh his sin righteousness and he who maketh his righteousness sin are altogether this people. He saith to his fellow: “Let us go and move together unto a far place, unto the mountains or a wilderness haven, that we may dwell in sameness of mind and in purity.” He shall be cut off from my sight, saith the LORD. Though he saith “Let us not be as the sinners in the city, the children of the damned,” he shall be cut off, he and his fellow.",
+		"He also who remaineth in the city, to walk as all others that therein walk, he shall be consumed. I make him as a strand for the straw pile, and he shall be consumed. For the righteous that would not contend for his righteousness and he who preferreth mischief in the cities shall perish together; even he who was too holy and he who delighted in sin—they shall be the same. He who hath the power, yet liveth for himself shall surely perish: both cause the land to languish under sin.",
+		"Therefore thus saith the LORD, I shall chastise the righteous and the sinners, and shall cause to lay desolate this generation; I shall make vapid the cheeks that were once swelled, for there is no speaker of justice; there is no person mature in sobriety before me, nor circumspect in his ways. The righteous walk as the sinners, and say, “We are too holy;” and the sinners as the righteous, saying, “There is no sin.”",
+		"Behold how great is the uneven weight they create, and how great is the burden they have forged upon their own backs. Even in the great congregation I shall silence the delight: “The day of the LORD cometh.” Rejoice not in the day of the LORD that cometh, nor take ye comfort; for I shall consume the righteous and the wicked in that day.",
+		"Oh that my heart should be set to malice and the earth trembleth not. Oh that I should roar like a bear and growl like a lion and the earth slumbereth instead.",
+		"I shall set mine heart to wrath soon, and as a blinding fury I shall take vengeance upon the great congregation. The forger of weights and the caster of iron chains have oppressed the way of the LORD long enough. Be silent, thou and thy haughty jabber! Give ear to your own salvation, for ye are without knowledge, and I come to utterly purge.",
+		"Oh give ear indeed ye that have made my laws a burden, and my ways of no effect, saith the LORD. I speak not wrath in vanity. I shall perform my words.",
+		"For, indeed, ye are an oppression to my soul. It is not oppression to stop the oppressor, nor tyranny to say no to the tyrant, saith the LORD. Behold, I declare you the tyrant and the oppressor, ye who will not hearken unto my words to perform them and my laws to do them.",
+		"But ye have set prophets to justify the way of young lions. Yea, ye are young lions set to roam. Ye bring down a young stag and gnaw upon him to the bone while one of you standeth off and justifieth their rapine.",
+		"The young lions’ ways embolden them, and cause them to think themselves seers. But see the LORD’s wrath they do not! Nor do any of their signs prophecy their own destruction. Shall lust and vanity see my ways, saith the LORD? If thou seest not Horeb, shalt thou see Nebo?",
+		"Think I shall not avenge? Makest thou me into a hard heart, O man, because I take vengeance? Nay, thy ways are uneven, and I have seen the defrauded.",
+		"Did I not smite the firstborn of Egypt because Egypt smote the firstborn of Israel? Makest thou me hard, O fool, when I gave warning beforehand I should smite? Didst thou, O Egypt, give warning unto Israel? Nay, thy ways are uneven. The sons of flesh hate my reproof; but my ways are right, saith the LORD.",
+		"Therefore thus saith the LORD, I shall cut off the prophets from you, even the blemish from you when she saith “That is hardly loving;” and the shaker of heads and the roller of eyes; and they who glorify an action and condemn a reaction; and he who magnifieth himself by study to overturn my words shall no longer be a guide unto you. Surely, they who say “Wrath cometh not upon the Earth” shall find that day an undoing, for it cometh not save for them and they who in like manner thwart justice and who mock reproof.",
+		"Provocation is their wine, and whim is their judgment. Is he who stretched out the heavens and breathed life as they? He who is wise counseleth for the better of his things. So am I the LORD, and so are all things mine.",
+		"What graven image so evened the scales and so ordered fairness as unto the LORD? Nay, they cannot, for they own nothing. The world is not theirs, nor have they possession of a speck of dust. The LORD breatheth and the meadow flowereth; he sigheth and the forests clap their hands. Man fashioneth thereof what he listeth, but the tree was made by the LORD.",
+		"But your minds have carved vanity, and destruction is your artificer’s tool. Your cities whittle the soul; your babes are buried in canning jars. All creation languisheth under your artificer’s tool; and under the hand that formed it and under the mind that brought it about.",
+		"A sigh stirreth my soul. I have given righteousness as the air, that a man may breathe in and live, and that therewith breathing he should live. Doth death so delight thee, son of man, that thou breathest not? — and thou that breathest, doth it begin with a sigh? beginneth it with a filling of the lungs. Therefore give heed unto the LORD that thou mayest live; and living live to abundance. I have waited, and man turneth not. I have spared that man might not see my wrath; but he would not.",
+		"Truly, I have heard the words of the quiescent long enough. Though he moveth nought but his tongue, he doeth so only to justify those that lead to destruction. His arms raise up only to stop those who speak the words of justice. Surely I shall put an end to his civilization.",
+	},
+	// Chapter 16
+	{
+		"DECLARE thou this word unto Israel, saith the LORD. The LORD of hosts causeth me to prophecy against the nations and against their prophets, for with thy turning I will loosen the teeth of the false prophets off of the nations, so that the remnant of mankind shall seek the LORD.",
+		"Thus saith the LORD your God, Behold, as a pot over-boiling, so have the nations put out their own fire. The words of their prophets are as the vapour from the fire. More doth the broth singe the flame, more doth the vapour rise. Yet as it hath no substance and quickly fadeth, so shall I now make the words of their false prophets to fail and their kingdom to fade.",
+		"Even by reason of their own boiling is their time full, even full to the brim of blood, lasciviousness, greed, and uneven weights. Not for your sakes do I cut them off, but for their great sins do I cut off their head and their tail, their grasping hands and loathsome tongue. The head is their tradition, their grasping hands their congregations, their tongue their preachers, and their deadly tail their lying prophets; and their feet, are these not their judges? supported by the strong tail.",
+		"They are froward mouths and foolish minds; expecting hands are their gestures of praise before me; he whose heart is set upon the crotch is their speaker of philosophy: therefore their prophesyings are lies, and their glimpse of the future blurred by their own lusts. For this also my face is against their lying tongues, and my rebuke upon thee, because thou hast made them thine ally against my covenant, in that they say, “The blessing is in thy flesh, O Zion;” and that thou rebukest them not in error, saying instead, “If it is a matter by which we may gain, it is well.”",
+		"Think and consider, O foolish prophets and ye ignorant who have established traditions as idols of the soul: What is the lot of the village idiot? Though he be a fool, doth not thine own foolishness cause thee to think thyself sound by comparison? Thou restest in an unwise comparison.",
+		"Though he be not carried off into captivity, and of the spoils of his own house doth no man brag of taking, no deal braggeth he the greater portion of getting, yet by comparison the fool causeth thee to rest assured that thou art wise.",
+		"Yet it is thou who art plundered and led off, and it is thy substance that shall be spoiled by a mighty hand.",
+		"Therefore hear ye now the LORD, O Israel, So have your traditions been until now, saith the LORD, and so too do the nations fool themselves.",
+		"When my prophets spoke in the former days, rising early and declaring, O foolish Israel, thou saidst the prophecy was for a long time off. When thou considerest them today, thou sayest the prophecy was for a long time ago.",
+		"Foolish people, hard eyes, hard minds! What I declared unto you came to pass, and what I declare unto the nations shall soon come to pass; and ye shall know that I am the LORD. I shall call that day Astonishment, for so shall it be an astonishment to the nations the day I turn you unto me; and that night shall be called Terror, for shall not the nations be overwhelmed at the sight of it?",
+		"Consider the cedar: before the sapling can grow a young tree; or the babe, before it can have hoary temples, so shall I bring my word to pass.",
+		"Shall your teeth not be set on edge at the doing? saith the LORD. Yea, they shall be set on edge, every one of you. And your eyes, even they shall be set longingly to your coasts. In that day ye shall know that the nations prophesied unto you a lie. Your nakedness shall be revealed, and ye shall know that the prophets of the nations were gainsayers and flatterers.",
+		"Thus saith the LORD; A voice is heard from Achor; a wailing and the weeping of shame. Out of the valley stirreth the voice of ashes; soot is the breath from their mouth. The nations lament, and the great congregation is covered in shame, for I have made the nakedness of the prophets of the nations an abhorrence. I shall strip them of their apparel, and ye shall burn it, O ye valiant of Judah, upon the autumn heap. Great shall be the conflagration in the Valley of Achor; and he shall fill in the valley with their remnant and with the heaps of their ashes, that no more shall ye suffer trouble of the nations. Great shall be the day of Achor, for I shall cause thee to fly on eagle’s wings over the valley to thy borders.",
+	},
+	// Chapter 17
+	{
+		"SHOD the feet of Israel, saith the LORD. Remove the bunions and support thou the arch. Sound a trumpet in Zion, and march on, for the LORD of hosts is thy banner that day: mine eye in the scope, like a lion on its prey.",
+		"My servant Arie, the son of BenHezeron, is he not least among you? and I shall drive out mine enemies, and go before the host of Israel though their backs be to the beach. My spirit shall come upon them, and they shall not faint. Mount up and shout, O Israel: “Our God is in our midst! The LORD of hosts goeth before us!”",
+		"Better is the residue than the sauce, saith the LORD. Blessed be Jacob who waiteth for me, for he shall not be cut off from the land. But they shall trust in me, and wait upon the LORD. I wave away the vapour and bring the residue to taste. And the remnant of mankind shall be the LORD’s.",
+		"But he that preacheth “Behold the day of the LORD is at hand; he doth smite Israel for joy”— that day shall overtake him and be a snare.",
+		"For, see, the false prophet is brought to nought, and the expounder of presumptions is unmasked. I cut off both in one day: He who would take by envy the promises which I have given thee, and he who declareth it falsely as thy flesh. His hands move not over stones, and caress they not trunks, but he fondleth your flesh, and ye are made idolatrous of things not of the LORD.",
+		"Out of the nations we have heard a droning, and there is a constant drip in our ears: “The day of the LORD.” Why call yourselves prophets, ye preachers, when ye have seen nothing? Have ye not declared my day for generations, and then hastened your feet to vanities and fleshpots? When a man seeth smoke he doth declare: “Fire cometh.” But ye have seen nothing. He who is within the smoke seeth not thither it listeth. Only after it lifteth ye see the pattern of char, and ye do declare the doings of the LORD: “Ah, lament.”",
+		"O ye envious! What can you see if ye are not the center of the matter? Ye are in the smoke, ye false of the nations and ye foolish of Judah, ye blind of Ephraim. The fire scorcheth toward you. If you saw the day of the LORD was at hand, O foolish prophets, then why did ye not repent? If ye saw its approach, why did ye also not see your destruction?",
+		"Behold, the day is at hand, saith the LORD, wherein I shall punish the nations wherein ye sojourn, wherein all do only that which is right in their own hearts, for as an old leaven they make sour the whole lump. Therefore I shall purge them to save the dough, that it no more be puffed with bitterness and be sour to the taste.",
+		"For the prophets’ eyes are continuously upon the young lions; they look not away in distaste, but are those who encourage the assembly of bloody cubs. They are an abomination to me, and the prophets prophecy unto them a lie in their midst.",
+		"Their tongues are set to a recital. Vain repetition is the tempo of their justification. Hear ye the words of your fate. Therefore the LORD shall repeat his judgment upon them until they are utterly consumed. For thus hath the LORD said: I have seen each man walk in his own imagination, and I will fill your empty minds with judgment. Inclusion I shall make exclusion, and ye shall not come before the LORD. Your inclusion shall bring into your windows the inclusion of my judgment upon you; and as straw cannot make a nest for fire so can your prophecyings not buffer my wrath.",
+		"Behold, out of the windows in which ye have crept, there is the sound of wailing; lamentation proceedeth where once there was rejoicing. The maidens have become harlots, they who once rejoiced are now soiled because the false prophet is exposed.",
+		"Make well your howlings, ye that are now the leavings of whoredoms. The day of the LORD hath multiplied your moanings, O ye whorish congregation! Proclaim it not from your solemn assemblies. For ye cannot see what is before your nose, and a matter too big is rendered invisible to those close at hand.",
+		"For in that day not one prophet shall declare his soundness, and instead of “The day of the LORD is at hand,” they shall moan; and instead of joy they shall wear sackcloth. For they have proclaimed rejoicing at their whoredoms, gladness at their sin, and greeted judgment with mirth. For all that they desire they have declared “a blessing,” and I have not spoken it. They have mingled my words with gall, and they have set sheep to graze continually. They have covered their wool as dew, it is a full mantle, and the weight thereof a burden that causeth them to languish, even unto death. For this I cut off the shepherds and the prophets, and I shear the sheep, for ye are more than sheep, and your sin is the sin of man.",
+		"Take up weeping at the sight of it, make great the wailings from your shores, for I shall avenge myself on you, saith the LORD, with a great slaughter that they which come hither for generations shall fear the LORD, that they sin not; even as the sin of the blemish who declared lust in my name, and they who set my words on fire by study to make a torch of their own light. Their carcasses shall line the way to Fish Gate and to Dung Gate; those that mock the LORD’s doings shall be heaps, and a byword.",
+		"And in that day Jacob the younger shall take the birthright again, and Israel shall serve Judah: the first the last, the greater the lesser, the larger the smaller. The LORD of hosts hath spoken.",
+		"Tribulation shall be a snake, upheaval an adder, but Jacob shall vanquish them to their holes. I will make the nations as multitudes that follow but a small ensign— the greater, the lesser. And I shall settle them in the land of Benjamin, as multitudes settle after a weary journey in fertile land. The LORD Hosanna shall cut off the serpent from the land, and shall bring an end to the desolation, and no more shall my holy mount be trodden down of the nations.",
+	},
+	// Chapter 18
+	{
+		"AND the word of the LORD came unto me, saying, A head hath no power, nor can a tail survive on its own; and feet and hands require legs and arms; and all are tied by sinews unto a body, and without a body none have any power. This is the nations, for it is not enough that Hazor is corrupt, and corrupteth; she thinketh all should be Gerizim, false practices quaint and no more; and she longeth for Jerusalem, that she may make all as Gerizim, that bastard of Shechem, to whom she hath made betrothal. She hath by Bethel’s conquest emboldened herself, and she magnifieth her emissaries as prophets to willingly sing a song of happiness, an ode of justification, at their lewdness.",
+		"And the word of the LORD moreover declared unto me, Unto what shall I liken the prophets that come from Hazor of the Gentiles, and unto what is the spirit of them unto whom they are sent at Jerusalem? For thus saith the LORD, The prophets of these times are like drunks staggering down a canyon road, proclaiming your own nonsense. Their words echo back to them. From your own stony hearts gladly echo back their foolishness in the Valley of Deception, nigh your land. Ye join the throng and cast ye flowers at their parade, and they enter the gates of your strong cities by treaty. If ye would enquire of me, would ye have so enjoined them at the gate? Ye enquire not of the LORD your God because ye are ignorant of my law, but knowing my law ye enquire not, for ye know I would disallow, O ye foolish prophets and they that set you to prophecy a desirable thing.",
+		"Ye seek the attention of men; it is a sweet nectar to have praise without substance; preeminence at any cost. But of whom should ye have taken counsel, my people? but of him who careth not for what people think, but in whose heart is equity because his heart is set upon the LORD.",
+		"Shall I be fickle and speak not to you this day? Come again, not today. Come another time and I shall perchance speak a word to you. Nay, but the day of the LORD is a terrible day. I speak to you a word. I whisper not at your whoredoms.",
+		"Therefore thus saith the LORD God of hosts, That day I shall make it a day of rebuke. I shall cause the Gentiles to know their abominations, for their prophets speak but what the congregations want to hear. As a dart that whistleth forth shall always find a target, so a mouth that speaketh deceitfully shall always find an ear that delighteth in deceit.",
+		"The mouth hath no power, as a dart hath no sting, save it find its mark. A tongue shall not wag unless there be an ear waiting for it like a quiver waiting for an evil dart to return from its victim, dripping with innocent blood. The ear is an open sheath, and the heart is its bottom wherein resteth the edge.",
+		"Prophecy thou unto the whoredoms of the nations, unto Hazor yonder nigh Lebanon, against those who also say the LORD spoke of times long ago, and for today he speaketh of wrath long to come.",
+		"Speak unto them, Thus saith the LORD God: I have purposed your overthrow. I have thought it, and I will execute it with craft. Jerusalem shall not die from your dart, and I shall resurrect Bethel by my breath on Jerusalem. From the desert cometh my breath, through the dry winds of the Negev. In vain spoke I not from the wilderness wherein Horeb dwelleth, and therefore thou Canaanitish wench hast no excuse because thou art of the nations. From the desert I bring my prophets, from the nations my people. If man should speak and write vanities in my name, should I raise up prophets proclaiming so? Yet there were no prophets raised up justifying a wayward people. Why should I raise them up now?",
+		"Wear the frock of humbling, for I bring sore chastisement upon ye of Jerusalem. Wear widowhood in betrothal, for I smite Hazor, for this your lover was a Canaanite; and ye espoused have a storyteller for a father-in-law. The marriage shall not be consummated, for Hazor went back to her ancient ways, and for her whoredoms I have sent her back.",
+		"Hear ye the word of the LORD, O ye of Jerusalem, long not for Hazor. Come ye forward; be ye circumcised in heart before the LORD your God, where there dwelleth and abideth splendours forevermore, where the LORD is your ruler and your judge of mercy.",
+		"But without there is no man innocent before me by reason of the error of those who rule over them. Each one delighteth in deceit and the perverting of judgment, in that ye judge by defense and not by action. He that followeth seeing the footsteps outlined in blood is as guilty, saith the LORD. Behold, this is the nations before me, saith the LORD, and they in Jerusalem who espoused themselves by heart and practice. But I shall not send ye back Hazor way, for it is from there that I took you and brought ye on to this time. My compassion burned for you. I dressed you in silks of many colours, combed your hair and made it radiant, and decked you with many splendours, until Hazor desired you in the plain of her assemblies and petitioned you from the Tel of her power. Ye shall not depart from me; the wilderness is a poor place for the brightness of silk, and the splendours of my side shall make you remain. No man shall plead his prince as justification. I shall judge the empty soul by its emptiness, and another mans guilt shall not make you innocent.",
+		"Go not to that place, to her ancient stones and the carvings of predatory eyes. There is no deed done by mercy, neither a heart stirreth by pity; but gain is always before their eyes. Have I not listened all the day long to selfish gainsaying and love of reward? Those that say “a jewel in my crown” by whatsoever act he doeth in mercy shall not get one. I shall cause your crown to tarnish your head. It shall rot and fall to pieces because you do nothing in the name of the LORD without bean counting.",
+		"Ah vain revelers! Your places of forgathering are not holy places but a den of merchants and the bazaar of players. But thus saith the LORD, If I cause to open the mouth, wisdom proceedeth; and of the heart, godliness as an ointment and balm of relief. Where is found secret words, for the works of the LORD cannot be hid, and his glory cannot be shut up? He doth not require initiations. He doth not babble, and burblers do not speak his words.",
+		"What have your babblers seen? Declare ye unto me, for the LORD demandeth of you? Thus saith the LORD, Hazor putteth off my name, and each man and woman hath dreams and seeth visions on her Tel, but Israel seeth none nor dreameth dreams. Labour in Jezreel beckoneth, and mounts are a dreamy place. Moreh is barren, Tabor is high, and Carmel is wicked as unto Hazor. Envy not the mounts, nor tend to Jezreel when the LORD is nigh. Beseech ye the LORD in Jezreel, even his compassion unto Sharon. Come to the river, come ye to the cool of the brook, for my compassion is not far from you, and a strenuous pilgrimage shall not endear you. I have not spoken from the mounts, nor appeared to any there in dreams. Behold the revelry on the mounts; how their faces open in sighs of ecstasy as if from a fine wine, and yet their prophets do not pour out my wine. They are not drunk on my ways, saith the LORD. They delight in their feelings; their imagination hath made them dumb to reason. Each one feeleth special, though even a dog they cannot keep from barking.",
+		"But hear ye the clear word of the LORD, the word against the nations and their assemblies, their Tels and encampments on the plains: You have become detestable things in my sight, empty vessels with hands reaching about perchance to grab a spirit. Wherefore? Shall ye make the LORD as something for to shove within your purses that ye may keep him as a Djinn? Behold, your ignorance is offensive. Ye caress idols of your mind, and your hand moveth about to adore idols without forms.",
+		"Long enough, saith the LORD. Indeed, yea, long enough hath mine holy things been scattered in the nations. I shall restore them to Judah, even my name. At the casting forth with a mighty shout of Saviour, that is my name, the LORD of hosts, I shall make Jerusalem a crown and its inhabitants my heralds again. In that day Rome shall be a widow, and she shall call her children bastards. They shall praise my name and bow down to idols, and their hands shall work for Ishmael.",
+		"But I say unto thee, O Judah of my beloved, I say Restore. I place this in thy mouth, and at the saying of this I make thee a mark of offense, a point of chiding and derision unto the nations. I make thee a teething bone upon which they shall gnaw. Say not “I knew it!” I make thee of brass. Thou shalt not be delivered up unto the Gentiles. But because these words shall make thee a hissing, I shall hiss unto them. I whistle for the raven and not for the roosting bird; and my word shall be carried on the wings of contention, so that all Israel shall hear them.",
+		"Thus saith the LORD, In that day I shall break the betrothal of Hazor to Gerizim, that no more the things of the heathen mix with the storytellers, and no more shall they be called the ways of the LORD.",
+		"Take up this parable, my prophet, and prophecy thou against Hazor yonder Galilee, against the ancient stones of her meeting places that she hath dug up, this oracle:",
+		"She who calleth herself my bride hath bidden you, O foolish prophets, as vulgar minstrels and impish players. She setteth you to play at her banquets. Ah, it is a sight! Thou hast a low flame, O Bride, for fear it will set thy fat on fire like a torch.",
+		"Thou art vulgar, O Bride, and these thy minstrels are louts. The place I made glorious in the latter times thou hast polluted with thine ancient stones. Thou carvest even now thine own idols with thy tongue, and with foolishness anointest thine own prophets. Thine idols are lewd, and their votaries— Ah, my, are they a homely lot! They scare varnish from wood! They bring revelers, effeminate men, homosexuals, perverts and strange minstrels. They live off my wealth and mock me because I am patient. I leave thee to thy lovers, perchance they shall abide thy contentions and find musings in them.",
+		"How hast thou become an harlot! How have thy children committed abominations! They go not into the secret chambers to commit their iniquities, but with a mighty beckon bring out their champions of lewdness. They dance with rioters and cavort with revelers. How I delight in the sound of children playing in the streets, but they have brought out filth and trample them therewith.",
+		"Am I not as a mother unto Israel? saith the LORD. Shall I not run and pick up the children and spare them from the trampling feet? Am I not a father unto the nations? saith the LORD. Shall I enquire whose child is in the path of the chariot wheels before I rescue? Behold, I save first before I turn over the chariot’s wheel. When the children are safe, I plan a trap for the trampling of the horse and for the chariot’s wheel, and the driver shall be upset in the snare.",
+		"And this shall be the snare thereof: I give you this, at the hand of the LORD: Israel is too small for my works, and mine hand is too broad. I will draw with a sweep of my mighty arm the treasures of the Gentiles, and they shall come unto me, saith the LORD that doeth this, even the Mighty One of Israel. Ye shall weep in your poverty on that day, saith the LORD, ye congregations of foolishness.",
+		"Thus saith the LORD; An end hath come, an end of the nations. Now smite the arch, saith the LORD, until it collapse, that the steeple fall, and make great the rubble thereof over the street in which the revelers have defiled my holy name, that no more traffic shall be heard therein, nor the sound of children playing. Better a ruin than a snare; a place of shadows than an highway of blood— until the LORD raiseth up his salvation, that he who is delivered of the children of Israel shall know it is I the LORD who hath delivered him.",
+		"Then smite the dam and the stones shall crack, that a flood may be prepared. Strike the base and the water shall flow. Shake the ends thereof and the flood shall gush forth. When it becometh a trickle shall the dam be easy to rebuild, even with flimsy stone shall it be built and then reinforced; that they that come not forth at the word of the LORD may be snared by precepts, fall back and not be delivered. (These are the mighty oracles against Hazor of the Canaanites, and against the wisdom of her ancient stones, her prophets and allies)",
+	},
+	// Chapter 19
+	{
+		"WOE unto the rebelling nations, and woe to the horde of mischief makers, who say “It is” and therein is found the will of God, and a blessing to be ordained; the purpose of whose council is to overturn the word of the LORD; who make themselves the center and by their own council mold the LORD’s doings to justify their set ways.",
+		"Lo, the nations imagine a vain thing; their mind is in league with deception. Each man formeth me in their own image, and if I were to send them a prophet they would not hearken. Not with stones form they my image, but with their vain minds and self-seeking spirits form they my image. Better they made a dumb idol than pollute my ways, saith the LORD. For an idol hath no power of itself to teach any man its dumb ways nor enlighten a suppliant with its ignorance. The ignorant seeketh an idol and remaineth in his first estate, but a fool seeketh the counsel of the foolish and is made like unto his foolish image.",
+		"Vanity is deceit itself, above all deceptions wherein the sons of flesh deceive themselves. And I the LORD regard no one more foolish than they who fear not at my word. Is not an idol worshiper and him that revereth a carved trunk less foolish than they who take my name upon their lips and do not my ways? who declare I live and then declare I speak not clearly, and that I have not made the heart in mine own image?",
+		"And the word of the LORD came unto me, saying, Is not the melting of snow a slow and steady process? In like manner shall such be overthrown. Destruction shall come upon them, by my hand shall it come, and who can save them in that day? Yet by grace it is thought, and by mercy it is written: Only they who call upon the LORD shall escape.",
+		"When indeed have the nations sought me, saith the LORD, and who in my far journey hath taken hold of me by the hand that he should lead me in his ways? Doth not a shepherd take his sheep to seasonal grass, and when the season turneth yet again doth he not take them to the old pastures and the old cotes to graze? Behold as the sheep graze he mendeth the cotes and then leadeth them into safety. Or seeing a fire come to the seasonal grass, will he not hasten, especially when seeing that the old grass groweth ready?",
+		"Therefore thus prophecy thou unto thy brethren, the children of Israel, So am I a shepherd unto Israel, saith the LORD, and so shall I do. Follow my paths and come to my sheepcote, for my shepherds have gathered speckled sheep. But I will not mix ye, saith the LORD. I shall sack my shepherds and scatter their speckled beasts. And from my pure sheep I shall raise me up seven shepherds. For from sheep born of another pasture, of the seasonal grounds, shall I speak unto this people and lead my people back, counsel them, govern them, and restore them to the old cotes.",
+		"My name shall be carried back as the holy things of old, and upon my people, my chosen generation, shall it reside. From the islands afar off shall they return, from Tartessos, Gog, Ashkanaz, and America. Britain shall give forth; Sepharad shall stretch forth its hands and carry my people. Kittim shall boast and rejoice, and shall fill my courts. Ishmael shall come by caravans and shall deck thee, O Judah, with the wealth of the East. Cathay shall come with aloes and ointments, and whithersoever the nations encounter thee, my beloved of Judah, my chosen of Israel, shall they carry you, and ye shall all rejoice in the LORD.",
+		"And the word of the LORD moreover came unto me, saying: And in that day this parable shall guide them: I came to my cote and, behold, my shepherd was gone and my sheep unattended. And I was wroth with my shepherd and I sought him on the high and rocky hills. There I met him, in a place between the tight rocks, in his arms a strange lamb. And I chode with him. Why hast thou left, seeing there were no sheep missing? And he said: “I went forth to seek, perchance any stranger had happed into thy domain. And, behold, I have found one, lame, this one in mine arms, who could not pass through thy land. And I have brought her, even as a little ewe lamb.”",
+		"A son speaketh unto me. This is the voice of him that loveth me. Surely thou hast done well, my shepherd; for so shall I take of the strangers’ lambs, and so is a diligent shepherd before me.",
+	},
+	// Chapter 20
+	{
+		"THUS saith the LORD God; And it shall come to pass in the days after the fullness of the nations hath come in, that they will no more say: “Last days;” and no more shall it be heard. But they who walk in my paths shall be gird with a pap of iron, and my words shall be written thereon. Upon my word shall they engrave “sandal,” and they shall say of my name: “Mine identify and mine honour.”",
+		"And it shall come to pass in that day that they shall long to see me, and their hearts shall be turned to crave me. Yet for all that I will not end the days of man upon the Earth, but I will stretch it to the uttermost that life may come from my side until the time of my desire. And if they should turn to no longer desire my face, I shall still not end the days of man upon the Earth for vengeance sake. But the dreadful day of the LORD shall come at my choosing.",
+		"Speak unto them, saying: For all this the nations have sought and not sought, and neither hath its desire added one pim unto the congregations nor have they prevented the evils of mankind therewith. Therefore prophecy thou unto the nations this oracle:",
+		"Thus saith the LORD: Prepare for a day of jealousy, for the Bride’s children are as their mother, and a proverb of ignorance. There is not light in the nations. Behold a smoke, a blue smoke, thin and curling upward. Thy children kindled a fire. A fire is kindled in the nations. The smoke shall be a furnace and the fat of the Bride shall be its fuel.",
+		"But there is in Judah a sound. Hear one and all the sound, a sound is in Dan and Naphtali, the sound of bells tinkling over the deep. Zebulon heareth them. Joppa doth ring. Acre rejoiceth and all the seacoasts there between. Gaza is glad again. Jerusalem weareth bells of gold, and the soft breeze sendeth the sound thereof over the mountains. “What fire?” say we. We see the fire of the LORD. He hath restored our glory and made himself our refuge.",
+		"Therefore thus saith the LORD God unto the nations: Consider Judah, and dismiss not the mercy of the LORD. I have moated thee and made thee a keep, O Judah, and Bethlehem a parapet and a tower of rejoicing. Bethel and Shiloh are places of congregation and praise. But Samaria I make a place of warning, a warning unto the nations. I write it on the hills so all they who pilgrim from the nations can see it afar.",
+		"For I have set my name amongst you again, and ye walk the paths of Judah and Israel with my sandals. Lo, I have returned unto my people and ye have returned unto the LORD your God, and his Holy One. The young men are handsome and set in array. The maidens are decked in the finest silks, and the locks of their hair are intertwined with flowers. The ancient is honourable again, and no man regardeth small matters or turneth he aside another for a trifle.",
+		"Sound an alarm of lovingkindness unto the nations, that they look upon thee. See that it goeth forth as a mighty trumpet. For, behold, how the LORD loveth the Gentiles, that he should call them forth. And in that day ye dwell safely they shall forget their philosophies, even the Samaritans their stiff neck; and vain knowledge and false tels, the congregations their divisions and contentions; and the Samaritan his solitary isolation. I shall heal thus: humble yourselves and enquire of me, saith the LORD God of Israel, and I shall hear you, and I will lead you to my compassion, for I the LORD have spoken this.",
+		"Consider ye not tribulation, nor thirst for judgment lest ye be snared and overtaken. He who seeth destruction the clearest shouteth the loudest of salvation. Redemption do I bring, a correction and a sore reproof that no more they that dwell upon the Earth shall call me by the moon, or by the winds, or say I am the power in the sun or in the river. All shall say, We know he is the LORD. For I the LORD am One; I had no rising. I have no setting. I am. There is no force but my will.",
+		"(The oracle against the vanities of Heshbon, whose watchmen’s eyes are always upon Ammon.) The desolate places of Moab and Ammon make ye to see every whirlwind and every wisp of dust, O ye watchmen of Heshbon. Ye dwell too nigh desolation, and your face is set to it daily, and your brow squinteth to mark an undoing. Your ways are contaminated with theirs, and emptiness prompteth your visions. Shittim is bored with your words, and letteth her gates lie idle at night; the passes to Jordan are unguarded. Though Nebo is close, ye mount not and look not yonder to promise.",
+		"Tribulation, great tribulation— my soul is in tribulation at the bleat of their words. I cannot bear them. Last days, last days are a mockery. I don’t wish to hear them. Your days have been long. Full of days hast thou been, O Heshbon. Ammon still remaineth, and Moab’s wastes are still not arid. Have ye been removed except by judgment so that another may take your place in an arid time to come? So shall thy preaching foretell thine own removal. Thy days have been as full as the former days, prosperous Heshbon. Whose last days do thy seers see? Say no more “last day,” but come unto the LORD, the Great God, the God of the horn of Judah, the Redeemer of Jacob. Behold how Jacob was a trickle ye flicked with your toe, and now he is a torrent.",
+		"Tribulation shall vanish with your days and the bleat of your ignorance; but Judah shall be set aright. Her shores will be glad, and her fishermen heralds. Her coasts shall not be rocky, her lighthouses not desolate. Hearken behind ye as well, O watchmen of Heshbon. See that the desert hath laid waste your oasis whiles ye scanned the desert; your gates are sprung. Thou Heshbon art become as Ammon, and dry as unto Moab because of thy watchmen who left off thine oasis to watch yonder wisps kicked up of passing caravans.",
+		"Bend ye your minds unto him who is from the beginning and changeth not; and set your courses by the helm of the LORD. Hearken unto his word and take ye comfort at the mercies of God, for he is the head of days, and he is their extender unto the uttermost point. He bringeth beginnings, and he shall bring an end according to his wise purpose.",
+		"Why must I set a tune and play it again? ye caravans of Heshbon passing fair through Shittim to yonder Jordan’s low banks. The LORD is not a suppliant that he should entreat before flesh. Nay, he is not a minstrel that he should replay the same tune to a market that heareth him not. I turn not aside caravans into the market square. A new thing the LORD doeth not to get the attention of the crowd.",
+		"What use is there in speaking to man? His ways are set. His path leadeth to destruction. Upon a horse, with a switchback, doth he set the gallop to his end. Take ye heed, O ye of Samaria, and hearken unto this oracle: ye shall not go down to aMegiddo. Nor shall the LORD overturn you with a mighty battle. You set the tempo to your end, and it cometh as a snare in an unexpected place, without honour and without chance to draw the sword.",
+		"Look off to the north, ye caravaneers of Heshbon. Set up camp. Raise your pulpit and set it to the north. The Assyrian watcheth from the heights as a predator. The Palestinian lurketh in the clefts. Judgment cometh from behind you and not from the desolate realm. They have passed Jordan before you, because your eyes were laggard on the camp of your neighbour, upon the desolate places. They are within your camp, and ye are cut off first.",
+		"(The oracle for an end of time) Thus saith the LORD unto the nations: An end, an end cometh. The time hath returned. Now hath the time of your hebdomads come to fruition before me. Say not, From whence cometh destruction?— ye are within the seething pot. Your congregations saw false visions in the desert sand, and your house is laid desolate. Your retreat is also stalked. I set ye now to the time of your probation. Hear ye the word of the LORD: My mercy setteth ye a time. Shout it aloud, and from the rooftops let this message go forth! Get ye to repentance! Cast off your ways, O foolish people, and harden not your hearts. Put aside your uncleanness, your vanities, and your cold self-seeking hearts, and I shall lead you yonder Jordan where dwelleth aSharon’s fertile plain and bJezreel’s cultivated fields.",
+		"A son is a son, and the choosing of a son is the same. Cometh it not from the heart of him that chooseth? Therefore I was your God, and in far lands that heard of me not there I was called upon, and there I heard. But, come, your sins are a river crimson, and your ignorance is the puss of your infection. Your wounds I have opened because your strength is eaten up from within, expelled in riotousness and iniquity.",
+		"Come bind them with free gauze, and nurture them with balms that cost no money; where wit maketh thee none the wiser, and beauty none the better. Why spend money for that which perisheth? Come unto the LORD upon a free path, and perish not.",
+		"The end of the oracle: For when the Israelite shall smite the Assyrian, ye shall know your watchmen looked amiss and led you astray. The LORD shall cause an end of time upon the nations, upon their congregations; and their watchmen shall be no more. Ye shall return over Jordan and re-inhabit the desolate places, and Heshbon shall not be dwelt in until it is within a fertile valley, and her watchmen no longer see the desolate mountains of Ataroth and Rabbah; and the Ammonite and the Moabite shall dwell peaceably within the congregation of the LORD, and the nations shall dwell peacefully with Israel.",
+	},
+	// Chapter 21
+	{
+		"THUS saith the LORD thy God, in the year of the 70th hebdomad of the Gentiles set thy face to the east, and set it to the west, to the north and then to the south, and prophecy thou against them: Your times come to the full. The LORD God setteth ye a time to repent.",
+		"And the word of the LORD came unto me, saying: Set thee now a goodly vase before thee. Fill it with fine flowers, with delphiniums, roses, marigolds, wild flowers, and every beauteous flower thou canst find.",
+		"Prophecy unto the nations: For so is the LORD’s fulfillment. I set my ways and my laws to the purpose to which they were intended, and it nourisheth and causeth all beautiful things to grow and to sustain.",
+		"Empty now, my prophet, the vase of the goodly flowers. Prophecy unto the nations, unto my Bride, for so she fancieth herself: This is what ye call fulfillment; then smash it against a wall so that it shattereth.",
+		"Therefore thus saith the LORD God, so I do with thy fulfillment before me. So I make of thy fulfillment, and this shall be the end of thy fulfillment before me.",
+		"In the year of their 70th hebdomad, so set thy face against them and thus prophecy thou: In your probation ye shall traverse 40 miles. Your watchmen shall fall to the side of the road, the might of their camels collapse, the burden of their loads dropped aside; and ye shall leave their parched bones. But ye shall be holpen by the sight of Bethel, for the hand of the LORD rebuildeth it. Plant the goodly flowers at Bethel, the late flowers also and the early flowers in their season. They shall be sanctified by Bethel, by the spring breath of the LORD, and he shall blow their seed over Jordan and make Heshbon’s vista fertile.",
+		"But as for the vase, this is mine oracle against it, saith the LORD. Thus saith the LORD: When thy probation shall be ended I shall smash thee against the wall, for empty thou shalt be, and a vase be of no value unless it is set to the purpose to which it is intended.",
+		"Bend now a little; give me some time of your minds. I set thee now a sign and a riddle: Ezra riseth of the nations [Gentiles], from a cold and inhospitable place, and again with nails my laws, my ways, and my fulfillment are raised up unto all nations, and my laws go forth.",
+		"Upon the outer door they be, proclaiming not what is within but what is not. So understand ye the prophecy of the 70 hebdomads of the Gentiles, for they who calleth themselves my watchmen have been of heavy ears, heavy eyes all these hebdomads since.",
+	},
+	// Chapter 22
+	{
+		"IN that day I shall set to me eight princes of my people. And upon them and my prophets shall my spirit come, to direct the ways again, and for a sign and a pillar unto Israel. They shall raise up my standard as aforetime, and my people shall look up and be healed.",
+		"Fear not, thou trembling land; for a great thing have I done in all the land. For, lo, I am returning this second time the captivity of my people Israel. Whilst the land trembleth, even by reason of boots, do I swell your borders and enlarge your house. My laws do go forth, amidst the up-cry they are heard in Judah and Jerusalem. Laud ye the LORD in his land! And tell ye the nations to repent at his doings.",
+		"And it shall be a hissing to the nations in that day, a source of offense, and as a shout of rejoicing is to him that seeketh solace. I shall gather the congregations against the house of Israel as one that gathereth an army to battle, and as one pulleth the bit in the foamy mouth. But I shall be jealous again for my people, Israel. And it shall come to pass in that day that the congregations of the nations shall be broken by the staff of Jacob. I have made it a double staff and a quartershaft, and Jacob shall vanquish his enemies; on the right and on the left shall the staff strike as unto the sting of behemoth’s tail; and in that day Israel shall know that it is I, the LORD, who is their righteousness, and that I have not changed from my purpose, for my purpose is spoken before the world was, and it shall be lauded after the world passeth away.",
+		"Laud ye the LORD in the land, for he hath doused his mighty sword. Whole Palestina rejoice! The LORD, even the LORD GOD of Israel, speaketh, Even I speak peaceably, He saith; unto the seed and unto him that soweth I speak peaceably. Worship ye the LORD in the land; let the images of Hazor be put from your minds, ye Galileans. Call him not Baal after your fashion. Form him not in crude minds. Understand the LORD this day by his doings. Worship ye the LORD. See his doings today, and know it is done by the LORD, the God of Israel. Where his name was meek it is now mighty. At his roar bears do retreat. Bank not your flesh unto the nations, ye of Israel. But say: What is a Jew that we should marvel? But God is our boast. Yea, beauty upon beauty, beauty burning brightly, shall they call the LORD God of hosts, the king of Israel.",
+		"Rejoice, O children of Zion, for the LORD, even the Living God, draweth you unto his bosom. He taketh the glory and traditions of men and casteth them from you, and with them the Gentiles shall be confounded.",
+		"In what ye gloried, ye shall abhor; in what ye feared among the Gentiles, ye shall tread as though clay; in what ye had pride ye shall scorn with laughter.",
+		"Howl, O ye nations, saith the LORD, for one of you dreadeth the day out of envy when I shall turn Israel and the other lusteth for it to see a sign. Yet though you be as far apart as the poles, yet shall it be an undoing for you when ye both shall see it.",
+	},
+	// Chapter 23
+	{
+		"OH, my soul, indeed, ah, my soul grieveth that man doth not consider; that no one layeth hold of knowledge; that man’s heart seeketh only what is before his face, and yet he doth not consider the sum of his ways.",
+		"Ah, man hath no knowledge, and my servants are but a breath amongst the crowd. Why must I raise up my servants to face mockery, and in the public place to scorn? Hath it been any different before?",
+		"Behold, we are mocked; behold a worse vexation, O my Lord: they make themselves prophets by fashion and fad. We no sooner declare thy fury and thy righteous way, and the foolish of the nations and the boisterous of the congregations scramble from between the planks and declare “We too are prophets!”",
+		"Can any man take the LORD Saboath so lightly that upon impulse or envy he declareth his words to be the Almighty’s? Prestige is their god. They lust after the feeling and contend all day to appear accomplished. Like a ravenous dog their neck is half full yet their eyes are not satisfied. They are lodged in the corner of their sockets upon the meal of the other dog. So do they seek prestige without having the necessary merit to warrant it.",
+		"Dispensation, hear the cry of dispensation, O people, for by it ye have set your ways to destruction. Ye are a river of foolishness, a highway of noise in what otherwise could be a peaceful cobblestone walk, ye that call out dispensation.",
+		"Dispensation is thy death warrant, O fool. By crying it thou hast signed it, and thou hast shouted from the housetops thy guilt. So the LORD shall make thy overturning a public matter, and thy fate a matter of the public square.",
+		"Woe, my prophet, declare thou woes unto the nations, and rebukes unto Israel, saith the LORD. Woe unto them who declare Dispensation, for they cannot be turned. He sanctifieth his ways as mine.",
+		"Woe to them who rest in Chance, and who live by Luck. Luck cometh not before the LORD; nor doth Chance find a place in the Almighty’s quiver. No archer fireth blind, nor doth any warrior rest upon an untried weapon.",
+		"Woe unto them who search my words merely to overturn them. Woe unto them who are drunk for signs, for they shall drink the wine of those who interpret signs, and they who interpret have created them.",
+		"Their signs are revealed by shouts of Conspiracy, Conspiracy. They are both gods and prophets of their own vanities. They declare their word, and they shall fulfill it. Thus saith the prophets of the nations, Conspiracy! Those who hearken not to our words have fallen snare to conspiracy!",
+		"Behold, they have declared, and the LORD shall fulfill their words upon them and heap the end result upon their brows. What is the end of conspiracy but tumult, and of tumult is it not upheaval?",
+		"There is no afterthought with the LORD. There be no chance or luck in the outcome of things. There is no splinter of wood wherein the LORD cannot count the weight or discern the grain thereof. Know ye that what cometh of judgment upon the Earth cometh of the LORD; and then consider thy ways, O man.",
+		"Have the sum of thy ways, lo, these many generations been written in the sand that thou shouldest not see the works of God? Though I spoke not unto thee by prophets, did I not make the way of the LORD clear unto thee before? Why seekest thou new prophets to declare unto thee strange ways when thou wouldest not hearken unto me before?",
+		"What ways do they declare unto thee?— but the ways of thine own lust and avarice. Why needest thou justification? As fire burneth so doth man run to his weaknesses. Why should I make my word an emolument for them? Thy prophets do not point to any way; they justify thine own conscience.",
+		"Yea, the generations of man are foolish and their ways utter nonsense. He hath not tempered his ways with my laws, nor shod his feet with my words. He waitheth not upon the word of the LORD to see it come to pass, but saith: “Yea, hath God spoken?” But ye justify the false prophet when his words come not to pass. Why should I not give ye up unto them for a season? Look back and see your oracles, your incantings from books and your teachings, and declare unto me, if you can, have they come to pass?",
+		"Yet my words have come to pass. I declared unto Israel, but the daughter of Zion heard me not. I declared I should go unto the nations. I declared that I should place my foot upon the dry ground and raise my right hand toward heaven and declare I live forever. But she preferred her timbrals, and sewed upon her elbows pillows that she might lean on her hand all the day and contemplate the handsome men.",
+		"Hast thou not waited upon the LORD, but I came not? Hast thou not longed to see me, yet blinded thyself to my judgments, lo, these many generations?",
+		"Be grateful, pat thy breast with a sigh and regain the wind taken from thee by sudden startle. Be grateful thou hast not seen my day, else thy doom is complete. They who walk after the dispensation of their mind’s idol cannot hear my words, nor see the end of their ways that they should turn and be healed.",
+		"I walked not with the idolatry of Rome, and fashioned not my kingdom after the ways of the high places of Carmel, after the place of Dagon and the straddling floor of his feet. But I brought down Dagon of Askelon, and his poised legs could not withstand. So shall all those be brought low and upset who keep a foot on their floor of their past to justify it and a foot on the way of the LORD JHWH as he marcheth forth to accomplish his ways. There shall be such a spreading that thou shalt be spread and fall.",
+		"Leap thou now, cast off thy past idols, and let thine old ways be forgotten, and leap thou fast upon my chariot as it passeth the way. Clutch upon its rails and see thy master drive goodly steeds. There shall no one upset my hero. Is he not a breathtaking sight to see at the reins?",
+		"Cling not to thy past idols of the mind. Let thy soul leap within thee at the good ways of God.",
+		"Hearken and Consider, look to the end of the ways of many nations and therein say: “Thus saith the LORD.” See idols smashed and justice raised as a standard, and say: “The LORD’s spirit moveth amongst us.”",
+	},
+	// Chapter 24
+	{
+		"WHAT hear I, say I; A sound, a sound of rushing. Whence come ye, and whither go ye on? Get ye from this torrent down! Behold, look behind you and consider what hath been; yea, pause and consider.",
+		"Hear ye a sudden wail. Be ye moved at a curdling shriek cut short. Stop in your way, look behind you and retrace your steps. What hath happened?",
+		"Hear this the word of the LORD, O ye nations, ye headlong to destruction, hear this as if it were a shriek in an unexpected place. Be not fearful but curious. Consider and investigate, O man. Ponder, ye sons of men. The way of thy path leadeth to destruction. The avenue of thy course bringeth thee to tumult. Forsake the crowd and hearken to the cry. Break from the undaunted torrent. Enter a doorway— close off the din without, for all they without who hear the din go to destruction, and even a jolt will not cause them to turn.",
+		"O blind and conformist, thy world is as a chicken coop. One cackleth and they all cackle together the same tune. A false idea spreadeth faster than a disease amongst the hens and addeth therewith unto the din until no man can hear the way but courseth with the headlong torrent.",
+		"Even if the LORD should provide only a shriek, we poor souls should have no excuse. Stop and Consider, O nations. Pause from thy feast, O Bride; rest not in thy matchmakers’ interpretations, in thy lawyers’ adetente. The LORD hath spoken plainly. His ways are manifest about us. Take ye up again the edicts of a mighty king, yea, our God is a mighty king.",
+		"There is no hidden way before the LORD. No code to satiate the mischief maker. As the LORD saveth from the adin aforetime, so is his word a house of refuge, a den of comfort to those who break from their journey.",
+		"The LORD hath an house of refuge, a citadel high upon a mount, a solemn place of retirement, a place to rest your weary souls. The LORD hath always been our refuge, a gentle and soft wing under which to seek comfort and solace. The LORD hath always been. He is from everlasting, and his ways declared from the beginning. What hath taken him by surprise, or wherein have the sons of flesh invented something curious for him? Hearken unto his words and live.",
+		"They who run to judgment, to the day of the LORD, run to their own destruction— and they who run to their destruction see not what is ahead of them.",
+		"But see ye God by all his wondrous works, he who formed the Earth and broadcast the stars as if casting sand from his fist; he who created the light within your eyes. Tremble then and let fear seize the marrow in your bones, for he liveth and his judgment shall come to all men, and there is none who shall stand without shaking before he who created Hell also.",
+	},
+	// Chapter 25
+	{
+		"MOREOVER the word of the LORD came unto me, saying, Set thine heart to grieve over this generation, over a multitude led by rumor and by secret words, for they will not hearken unto my words, but they prefer mischief. They have made the day of the LORD an allegory, a song from the complacent, saying, “Nought shall overtake me.” They provoke a reaction only to condemn it. “No action leadeth to good or evil, but power decreeth.” Choose to do good, saith the LORD. But they say, “Choice is not ours but God’s. If our feet be laggard, is it not the LORD’s doing?” Shake thine head soulfully, and say, Alas, an end hath come, an end on the power of the ancient and established. Swords shall fall and borders shall be redrawn in blood. For all this is required by their hard hearts because they would not hearken unto the words of the LORD. They built the ways of the LORD by fad, and they tear them down equally.",
+		"Behold, saith the LORD of hosts, I bring such days as thou hast not imagined, and pleasant days few have enquired to discover. They come as unto the birth of a baby, after the travail and the anxious hours. I bring days upon the trickle of this generation as have not been seen for many, many evenings and mornings, for many still autumns. The earth is struck with a mighty jolt, and out of the valley of decision cleaveth two ways. From aHinnom who can see the house of the LORD for the multitude of dwellings that magnify themselves? But from bKidron look up. So is the dwelling place of the LORD seen first by the wilderness, and it is clearest even in the dark of night. A beacon of hope and comfort is the name of the LORD and his Holy One. Delight in the captivity’s end, all ye of this generation, for the days of Hinnom shall come, wherein they shall seek the LORD by ways others have set, by alleys and byways between the tall buildings, narrow walks and culverts, wherein there be many who tempt and who are set",
+		"Ho! How he bringeth fat days upon them that love him! He bringeth his children out again to wander in the good grass, back to the nursing stocks of their olden days, to enjoy the peaceful habitat of the LORD. Send forth they who sought refuge into a green and fertile valley.",
+		"Straighten ye the way of the LORD, ye who go to the fertile valley. Come out of my refuge and see the land remaineth, the hamlets are peaceful, the houses have arbors hanging with fattened grapes.",
+		"Go gather and eat, cultivate and plow, tread out the new wine. Feast unto the LORD, for he is good. They that sought the end are gone; the fox is left off the land and the tender grape shooteth forth again.",
+		"Thy day is as a day before me, saith the LORD. Rest ye the Sabbath Day and let no man oppress you. Lift thine arm to remove the yoke. Let this be thy Sabbath work, a true work unto the LORD. For in six days did the LORD do all his work, and in the seventh did he rest. Therefore fill thyself on the Sabbath and feast on what the LORD hath done. Rejoice in the pleasant days to come, after the LORD hath removed the din. Make it a day of gladness, make it a day of Spring, a day to hear the pleasant waters, the trickle of the brook.",
+		"Thy year is a year before the LORD. He hath been forbearing. He hath ransacked the nations of their goods, and he bringeth the latter harvest to Zion. What great patience requireth the LORD if he knoweth not the duration of thy travail, or wherein is his mercy a sure rock if he knoweth not its urgent need? Look back and see the history of man, how the LORD hath endured the violence of the nations and taken the goodly shoots in their time. How patient is our God! How beautiful are his ways! Longsuffering is our God. Who can move him from his purpose?",
+		"But look ye to the nations and their follies today. The LORD bringeth their last days to pass, and the second time to fruition. And the LORD shall say to them in that day: “Here is your desire. But why do ye have confusion of face?”",
+	},
+	// Chapter 26
+	{
+		"THUS saith the LORD, What of mockery have ye found in my ways, O city of excess, that ye should mix my name with profanity and that ye should regard urine the comment of aprofundity? It is because mine eyes are upon your neighbour for good, and yours are upon him for gain, for covet and for evil, for ye seek to be lordlings and care not for the LORD’s reproof. Wherefore find ye reverence in your heart for a carved stock, that ye should walk on your knees? but your neighbour ye turn him aside for trivialities. A dunghill is your bTel, and it is the place of your horn of wisdom. Lo, it shall come to pass in the days of the mount of the LORD that ye shall be ashamed of your profanities, your lewd pictures, and your spoilt heart.",
+		"O thou New York, thou mighty city of pinnacles, I, even I the LORD, shall make thee a scape of mountains and thy homes hillocks of rubble. Thou hast made of mankind a sewer, and thou art a caster of snares. Thou art a crown of culture, and therewith thou art become a polluter of inspiration. Thou hast built many a grave, but none so much a monument as the mountains that shall come from thy rubble. Thy byways shall be impassible, thy streets valleys and stagnant bogs; thy marbled porticoes deep caves. Thou shalt no more profane the name of the LORD, but thou shalt be the place of the hermit, and the lodgings of wailing, and the grazing of herds.",
+		"Though there be many days until thy fulfillment, it is because I extend them for the sake of Jacob, and not for thee. But it shall come to pass soon, saith the LORD, that aI shall build me a city and an house. In that day I shall also prune thee of my people; and as thou sawest the nations usher to thee, so shalt thou also see them exit. I shall draw unto me their tender shoots. Unto me shall all of them be brought. And I shall plant them in Zion. I shall leave them grafted, and I shall graft them in with Israel, and my vines shall yield a sweet fruit, and I shall be surrounded by all my children, saith the LORD.",
+		"Jacob shall play at my feet, and the nations shall be as Edom. Yea, the stranger that sojourneth shall sojourn no more, but shall be bounced upon the knee of the LORD.",
+		"Come unto me, saith the LORD, for I have not the spirit of a man that I should waver and be flattered. There is no turning in me, O Israel. There is no sudden fury, no faltering mood. My spirit is steadfast and great and doth not falter. Leave behind thee the coast of Tyre and Zidon to the bcormorant, for her coasts will no more be inhabited, nor shall they hear the barking of merchants; but there shall be instead the barking of the seal.",
+		"Howl and bemoan, oh, bemoan! for Tyre that great city, which was so great, is uninhabited and a wasteland. O thou Zidon which rang of liberty art become a snare and a trap, a prison, and thy rulers bailiffs. Israel laboureth to the tune of a requiem within thy walls. The baritone voice lamenteth.",
+		"I have spoken and it shall come to pass. I say come unto me. I am not like man, but I have created man like me.",
+		"Surely, if thou canst love thine own parent, thine own children and all that which is thine own, then thou knowest how to come unto the LORD without hint of gain or desire to deal.",
+	},
+	// Chapter 27
+	{
+		"RETURN unto me, return unto me, return, O my beloved. I see the fruit trees. I lay hold of the golden wheat chaff. I consider the proportion of all things ’round about me. What bearing tree, bringing forth its food, is so high above that I cannot reach and taste the LORD is sweet? Tell me their numbers; tell me why none towereth high like the pine. Yea, all are within my reach.",
+		"What is the purpose of the sycamore or the cedar and the great sequoia that is so high? Upon them doth no man subsist. Of their fruit doth no man winnow. Upon luscious fruit, even nuts and roots, doth man subsist and not on the branches of the uppermost pine.",
+		"All I see about me is marvel. The swift is easily brought down by man. The sea is thy fruit basket, O son of man. Cast thy net and take of its bounty freely given. Pluck fruit off the fruit trees. Breathe in fresh air because of the pine.",
+		"Consider the proportion of things all ye sons of flesh. Thresh wheat in its season and sift barley to overflowing. Walk amidst the corn and take of its sweet ears.",
+		"Let the fool climb to the tip of a pine. Let the ignorant gnaw on the bark of an eucalyptus. Nay, there is no man so foolish that he will not pluck off a grape in its season and reach for a peach in due course.",
+		"Shalt thou herd lions, O fool, or shalt thou herd sheep? Shall the lowing of cattle cause thee startle as unto the roar of a tiger? Dost thou contemplate breeding eagles that thou mayest feed mankind?",
+		"Consider the proportion of things, O ye sons of men. Take not for granted the stars, nor forget the clapping thunder, the sprinkles upon thy face ignore no more.",
+		"Though thou canst not reach to the moon, it bringeth to thee fish in its given season. The sun is forever out of thy grasp, O man, yet it bringeth forth thy herbs from day to day.",
+		"Consider the grass that is soft upon thy feet, a sole for thine arch as thou journeyest on thy way. Consider the drink of life, O son of man, that of all things water becometh lighter when the harder, the longer it endureth cold. It sinketh not, but floateth upon the sea, and therefore all ye sons of men are not squelched from off the Earth.",
+		"All things cater to you, O sons of flesh, therefore take ye heed, for surely wrath shall come upon men justly who say in their heart there is no God, who say of their right hand, “Thou art my god; I fashion what I will;” and of their mind: “Thou art the lathe upon which I shall form him.”",
+		"O foolish mortal, thou canst not see the glory of God about thee. Thou hast taken for granted a agilded habitat, and how can he who readeth no blueprint fashion God?",
+	},
+	// Chapter 28
+	{
+		"GOD knoweth I did not seek this avocation, nor from wrestling at the word of the LORD would he remove his hand from me. The LORD hath purposed, and it shall be done. I wrestled in vain at the word “Thou shalt surely be my witness to Israel” in the day that he visited me, for who can say what shall come of this? Yet I know I have spoken rightly before the LORD the King, the God of Israel. Of all that he hath done since the beginning he hath well done, and blessed is the man who, so understanding, saith, “It is well done of my Lord.” It is right, yea it is exceedingly right in his eyes, that he should turn Israel. It shall be in that day a sign and a bconsummation to the Gentiles so that the remnant shall seek the LORD.",
+		"Hear, O ye Gentiles, he hath set me as a teething bone unto the nations, but I shall not be devoured, for he hath surely spoken, I will not deliver thee into their hands. But the LORD shall provoke Israel against your provocation. And in his cunning Israel shall hear, and ye, O nations, shall be astonied!",
+		"Yea, blessed is he the LORD who declared with a strong voice our calamity in the day before our calamity at the hands of the Babylonians. Yea, blessed is his spirit that led us no more into idolatry from our smiting. Yea, blessed is the terrible day of the LORD to come, that he declareth beforehand the smiting that should come, so that in that day we shall no more delight in the captivity wherein we have remained captive, so that we go not to the grave in gloom.",
+		"He is merciful in his doings, for generations that come shall recall these the works of the LORD that I am sent to declare, and shall wondrously marvel. How the LORD hath revealed himself! They shall know that all that hath been from the beginning is of the LORD; that he is righteous and consuming in his ways. He striketh dumb earth and giveth life by his breath. The LORD grant me a quiet blessing, and give me the peace of his salvation. How well hath the LORD done in all his creation!",
+	},
+	// Chapter 29
+	{
+		"AND the word of the LORD came unto me, saying, Take a child, a boy of 11, and set him to hold his hands in piety. Place upon him a atalis of btichales and white, having him gird in black. Teach him to repeat: “Give me, my father, whatsoever I ask of thee.” Let him bow three times before his father. Let him approach and walk around him thrice. Let him say: “Give me my bread and I will help thee when thou art old.” And when his father in the midst thereof doth strike him on the cheek for his selfishness, say unto Israel, SO SAITH THE LORD UNTO THEE!",
+		"Is this not this Israel before me? saith the LORD. So have I seen their insincerity. So do I see vain gestures, and empty words do I hear all the day long. Away with it! My soul loatheth it! Your souls are not made cleaner for the vanity of your words. They make you not clean but filthy.",
+		"Behold, foolish people, man is not greater than God. He is not deeper than the ALMIGHTY. His eyes are not sharper that the LORD is so dim. Nor is his perception so sensitive that the LORD is dull. There is no creature that can make itself small enough to escape his wrath, nor is there one so base as to be unworthy of his salvation.",
+		"But the haughtiness of this people maketh them dumb to reason. Are ye not moved to indignation and can ye not see the selfish acts of your children when they supplicate in such a manner? Shall you endure them? I think not, saith the LORD.",
+		"But this people is ignorant, saith the LORD. They lift up a shield of my law; on the outer face thereof it is written for all to see, but their face is to the underbelly wherein is written their own law: ‘Thou shalt seek thy want more than anything.’ O ye hard hearted and foolish hypocrites! Ye cannot keep my law without first your heart. Behold, it is right, saith the LORD, that I should smite you grievously this time; that I should strike your shield and break it, that ye may bend to pick up the pieces and there again see its face and my laws written thereon, lest the world should say of my great name, He spoke not unto us from Horeb.",
+		"My mouth shall devour you, and the sword of my vengeance shall cut your hearts. Speak now, and declare if you can, when I commanded in a corner that ye should love the LORD your God. Set your mind to recall. I cannot not, saith the LORD. I mumbled it not from under a stone that ye did not hear; but the whole mountain quaked at my word.",
+		"Turn ye unto the LORD from now on and forevermore. Plunge your hearts into the LORD and rest ye there assured. For surely the LORD saith, the God of hosts, This shall silence the impious lips. Justifying tongues I do not hear. It is as language to the deaf. But love the LORD your God more than anything, and then shall your prayers issue from your soul and the LORD shall say: This day I have heard you. This day I have made your fears acceptable. Then shall ye know he is deeper than a father and more knowing than the wisest of men.",
+		"I see your heart and know your impiety, saith the LORD. Your words and rituals do not fool me. They make you not clean but filthy.",
+	},
+	// Chapter 30
+	{
+		"THUS saith the LORD of hosts, the God of Israel, Hearken Judah, art thou not in captivity in thine own land? Hearken Jerusalem, art thou not the city of the great king, but abhorrest his crown? Why tremble ye at your neighbours? Is it not because ye have turned from me unto the land and because thou, O Jerusalem, abhorrest that which is thy glory? Now do the wise of your children walk in the nations as upon egg shells, and the man of wisdom placeth his forefinger upon his lips as counsel.",
+		"Yet though ye discern the times and their rough edges, ye cannot incline your heart to discern the ways of your God. Ye who pride in being my chosen pride not in him that chooseth thee, I the LORD. Ye know not even what ye are. There are those who say they are Jews and are not, so that they may milk your cow and gore your ox. Wherefore bring ye upon you men of different customs, brutish men, men of other religions who say they are Jews that they might live off your wealth? Because ye are foolish, saith the LORD, and know not even what ye are, also whereof setteth ye apart. Is this not remarkable, saith the LORD: the left hand and the right hand join only when ye sit on them.",
+		"Furthermore, saith the LORD, Did I scatter you amongst the nations that ye may settle by a neighbour, and say, Unclean, thou unclean! so that ye may boast of thy heritage? Nay, ye know me not, even what is clean and unclean.",
+		"Even in simple things have ye trespassed against the LORD your God; and your hearts have been double sided like a coin with two faces engraved thereon, in that ye have taken my gift and ye have straightaway thrown it back in my face, for I have heard you say of my Sabbaths, They are not a gift; and that my day of rest, It is a day of merit. Behold, ye have made my rest work, in that ye say there shall be merit from it. Ye break my law and ye mock my heart, saith the LORD. Keep my Sabbaths as a gift from me, not as an investment for a reward. Indeed, ye trespass in simple things.",
+		"Thou trespassest in more than this, and I the LORD have seen it. For thy heart is surely foolish before me, and thy fears are born in a cold and guilty soul. But thus saith the LORD, My thoughts are not the thoughts of a merchant, that I should set in store for a profit. Thou canst give me nothing; and if I commanded thee to keep in order to get a return I admit I have want. Then am I a merchant and not God, but I am as the dumb idols of the nations which need men to carry them. Are they not created by the mind first, before the hand layeth hold of a tool? So are the gods of the nations the potsherd by which men pour their own wisdom and from which they balm their own guilt.",
+		"Hast thou played upon the bands of Saturn? Hast thou put thy foot upon the dog star to rest thine arch? Hast thou set the galaxies in their place and began evening and morning so that all things revolve? Hast thou formed wisdom from thine own bowels? I need nothing from thee.",
+		"Canst thou count the number of my days? Wilt thou lead me when I am old when I have no age? Dost thou generate life from within thyself? I was not. Nor can I become, saith the LORD. I always am. I AM BEING. BEING I AM BEING.",
+		"If there is an act awaiting— behold, I am already there. Is there a time to come?— Lo, I have already formed it. How can thy rest make thee acceptable to me? I set thee at liberty that day, and thou madest it a burden. Indeed, your hearts trespass before me even in simple things.",
+		"Thus saith the LORD moreover unto this people, To what end did I say ‘Love thy neighbour as thyself’ if thou knowest not love? Thou art not that far lost, O Israel, else would I destroy thee entirely. Children of this confused generation, hearken unto me! Thou art dumber than an animal if thou knowest not love.",
+		"Wilt thou claim my words were edited, that thou knewest not these things? If thou knowest to love thine own, thou knowest how to love the LORD more dearly.",
+		"Where is thy pride in my law, O man, for thou didst not add one measure to it, nor counsel me one iota in one of its jots? Yet thou actest as though it is thy glory that it proclaimeth rather than thy shame.",
+		"For if thou wert righteous, why then must I restrain thee with laws? If thou wert wisdom, why must I dress thee? If thou wert not a child, why should I feed thee?",
+		"Thou bitest mine hand, and braggest. Thou piercest the feet that led thee, and decry, It is not He. Indeed, thou trespassest even grave things.",
+	},
+	// Chapter 31
+	{
+		"The Lord hates divorce. Those who love him will not do it. We are commanded to love others and do good to all men. Love and common sense are more important than rituals and tradition.\nAND moreover the word of the LORD came unto me, saying: Take unto thee a foolish child, an adolescent, that she smoke in the face of her mother who is ill and cannot bear the vapours of it.",
+		"When she cougheth, will she bless thee? With every breath shall she believe thy words of devotion?",
+		"Thus saith the LORD: So have I said I hate divorce. Why doest thou it then if thou lovest me? Why do the things that I hate and say “I love thee”? I will not be fooled. If thou lovest me thou wouldest do what I delight in and needest no commandment.",
+		"And the word of the LORD came unto me, saying: Command a child in secret, tell him to take of thy soft and luscious cake a wedge and tell him when he walketh across the room, through the gathering of elders, that he pelt it in the face of a man.",
+		"How long shall it be before his father grab him by his arm and squeeze him until pain overfloweth his eyes? In shame shall he drag him from the room. In shame shall he leave and with stripes will he howl.",
+		"The LORD commandeth not good deeds to give thee righteousness, but to care for his creation [other children]. If thou canst not stand to see thy child abuse another and cause upon thee shame, what thinkest thou of God?",
+		"With what spirit did I breathe into Adam?—was it not mine own? saith the LORD. Thou art surely made in mine image, O man, and have no excuse in that day. Thou shalt cry “The love of God was different” and thou shalt be condemned.",
+		"I have no double weight, but thy love hath a double weight toward me: fat toward thine own and lean toward me. Therefore do good unto all men. Thou shalt receive no reward. Thou shalt do it unto the LORD, knowing they are thy Father’s property, and he doth care for what he hath made.",
+		"If thou lovest me, and admirest, and makest thy boast that thy God is thy father, then wilt thou love the works of mine hands. Thou doest well in all thy boastings if thy boast is not words but deeds.",
+		"Though I hate putting away, yet love I my works. All things are mine, and there is nothing that doth not belong to me, saith the LORD God.",
+		"If a wife is sore abused by her husband, then separate her from him and punish him alone, for he hath abused my property, and took no thought of what I made; for I will have mercy and not affliction, and the love of God over the potentate of men.",
+		"Other than this and adultery, if thou puttest away, thou doest the thing I hate. If the man repenteth of his arrogance before God, then let her return.",
+		"Search the book, make it sure, and see what is written therein of me. For a meal I overlooked rituals, for a full belly in them that love me did I cast aside my strictures. This covenant with David shall never pass. It is as the ordinances of matter. Unto them to whom I say Live, shall no man say Die; and unto those to whom I say, Friend, enjoy, be merry and be free, shall no one make into a servant. There is no more haughty person than he who maketh my covenant a burden to any. But a haughty person I will bring down.",
+		"David need not sacrifice and offer, but had a heart after mine own heart. Surely the mercies I showed unto David shall follow them that love me; and surely they who make my covenant with David a bargain shall I bring down. To him whom I call a son, I shall never make into an accused.",
+		"I spoke unto thee face to face and thou sawest me not. Wilt thou now believe me because now thou knowest I am God? Was it the mountain trembling that thou hearkenedst to, or is it the substance of my words?",
+		"Yea, had it been that thou wouldest have believed me though my voice was meek and my breath did not disturb straw.",
+	},
+	// Chapter 32
+	{
+		"HEAR the naked truth, O Israel, and be ashamed at its telling! Let the veiled head bow, and the haughty thinker have confusion of face. Did I not free the black man, and bring to the dust empires? I multiplied the Arabian in the desert, and brought the Huns from the Steppes. I caused to inherit Britain the Anglos and the Saxons from the hinter forests and the Normans from France; and they are not yet removed from their lands. The Spanish came from Spain at my command, and the Americans from the uttermost parts. Mine eyes are upon my creation to do according to what my spirit listeth. Mine eyes too are upon them that love me, and my soul delighteth in him that keepeth my commandments.",
+		"Wherefore glory ye in your flesh, and wherein find ye rest in your land? Is your flesh and land more than I gave others? Then consider to what end I placed my name upon you, ye idle thinkers of Judaea. Then consider why ye are still in captivity and moreover scattered among the nations. And no man asketh: Wherefore hath his hand been so severe on us alone? Then hear ye this word: Thus saith the LORD, I shall not be severe on you after a time; but I shall gather you, and ye shall put down roots in Zion, and ye shall not be plucked up. Cast off your cynicism and your habits, and prepare ye for the day, for surely it cometh. I shall remove the jealous heart, and replace it with humble knowledge.",
+		"No more shall ye speak great swelling words and on your lips will not take my name. When commanded I this hollow respect, and when did I say I crave worship? Prostrate yourselves before your parents and measure your works on a scale to calculate your reward. See if they shall be moved with compassion for your double self-seeking hearts. Ye shall not inherit of their estate, but your selfishness shall bring you to want, to make you a castaway. Why do ye think ye shall inherit of the LORD’s estate, when I can see every thought of your hearts?",
+		"Your mindless offerings, O people, are a burden to me. Your prayers are gibberish. I wish I could drown them in the ocean, that I may laugh at the sound of them. But your prayers are not humourous and your ignorance is offensive. Ye read the words of others, but care not for the words of the LORD.",
+		"O Judah, thou that dwellest in dark shadows, I have made thy cynicism a boil unto thee. Jerusalem, thou that shouldest be his bright spot, is forsaken. Instead of casting a light, ye draw your curtains. Thy children make love into obligation, sincerity into appearance, and think that I cannot understand what is in their hearts. Ye delight not in my words, but ye put your faith in your strength, and your counsel cometh from the valley of your sojourn.",
+		"Is it such a marvel that thou dwellest not in peace, O Jerusalem? I have afflicted thee, but thou hast not grown wise from thy stripes. Thou thinkest with thy flesh, why then hast thou not learned from thy stripes? Instead of thy crown, O Judah, Jerusalem is thy last bastion. The LORD shall plead his cause by his sword, and deliver thee by his mighty hand. What shall your pride be like in that day, O ye stubborn of Israel and ye haughty of Judah? Shall ye speak out of form that day, and bring insincerity in your basket of offering?",
+		"But speak ye every day, The LORD hath holpen me; I know not all. Evil hath betimes come to me, yet I knew it not, for the hand of the LORD delivered while I slept. We shall rejoice in the sun’s rising as in the day of our deliverance. Perform not the covenant of the LORD with an eye and heart to getting a reward, lest ye perish as the LORD’s enemies in the day of your deliverance.",
+		"As thou seekest the best for the things which thou lovest, so more shalt thou seek after the LORD thy God to do his ways. With all thine heart shalt thou seek after him. With all thy soul and thy strength thou shalt love him.",
+		"Then, saith the LORD, Peace shall I bring. I shall make your things heavy unto you, and the love of God an easy thing in that day. Is it not an easy thing to hearken unto your creator? The LORD hath never commanded usury of men. Think of a time when he laid a burden upon your backs. It is the LORD, even I the King, the God of Israel, that freed you out of Egypt. But ye are as a wound upon my side, a pain in mine arch, and a buzzing in mine ear.",
+		"Calculate not the measure of thy will with me, nor incline thine heart to consider the measure of thy soul. Thou canst not look into the abyss and there is not weight and form to thy righteousness.",
+		"I have shed love upon the Gentiles, and given thee laws for the betterment of all. Measure therefore the righteousness of my ways, if thou canst. Draw a line upon them. Find a scale upon which thou canst bring to harmony the weight of my ways with thine. If I should give thee the Universe to heap upon thy side of the scale thou shalt be found wanting in that day.",
+	},
+	// Chapter 33
+	{
+		"BEHOLD, the LORD knoweth that thou canst not see him. Nor can thy light reveal his countenance. Therefore is he pleased with faith, and he hath made it as the sure foot of the babe running to his mother’s comfort. He shall hear thy cries, and he shall be quick to comfort.",
+		"Why should the LORD judge a man by his works? A peaceful time breedeth peaceful men; and the righteous of an easy season could very well be the transgressors in a hard season, at the time of the LORD’s testing, at the moment of decision; and contrarywise he who is a rock in an hard time would be overbearing and an offender in an easy time. When the ax is laid to the stump, could not he who died exalted in a peaceful time, falter in a calamitous time? Therefore are the works of man an uneven weight before the LORD. But faith abideth in any time, and the love of God is a steady weight to weigh faith more precious than gold and more enduring than time, and of more value than flesh.",
+		"Why seek ye one and all the LORD by the light of your own counsel? But hear these words, O Manasseh, thou who followest thine uncles so close, and hearkenest not unto the LORD thy God. Thus saith the LORD, for thus ye are quickly gone astray: even because the light of the anxious is dim, and it lighteth only their countenance. The LORD is as unto a mirror, and he revealeth thy face. Therefore, ye sons of Israel that follow the sin of Manasseh, ye keep your light dim, for the sojourn of such as ye is as in a dark tunnel.",
+		"Thou too labourest with but a dim candle in a dark tunnel, O Judah waiting for redemption, because Manasseh is thy light, and he is thy younger, and Ephraim is belligerent and leadeth with shouts; thou seest not anything but that which is around thee. Whither thou goest thou knowest not, hither by what route thou camest thou hast long forgotten. Thou knowest only where thou art, and the circle of darkness encircleth thee closer. The sum of thy ways is lost. What hath been thou canst not remember, and whither thy way now wendeth thou canst not imagine.",
+		"Interpretation is a dim candle, and rationalization is a brittle wick. No man hath seen his heart while he liveth. Therefore walk after the way of the LORD. He declareth unto thee again, thou hardhearted— Ephraim, thou hast made of thyself a god of thine own imagination, and created me in thy changing image. Thou wilt not speak in my name, but thou wilt command and declare thy traditions to be holy and thine oracles to be those of mine own mouth.",
+		"Therefore have I spoken clearly unto thee, O Israel, while Ephraim gropeth the walls, and while Judah waiteth to see what shall become of Manasseh. Behold how I, even I, saith the LORD, speak unto thee plainly that thou mightest know in the day of my smiting thee wherein thou hast sinned, and so cry unto me and I will hear thee, and I will turn thee unto me, saith the LORD God. Thou shalt recall my words and thou shalt turn unto me and I will deliver thee out of the troublous hands of thine enemies.",
+		"I have written upon mine head Faithful, and I shall call ye back. I the LORD do declare it. My word shall stand, O my people. I shall not see you destroyed anymore. I shall perform my words which I speak here upon you. I shall wound and I shall heal. Though ye have been halt for so long, your legs shall tighten in their joints and spring ye into balance, and ye shall leapcall at my words as a young calf. Ye shall cast aside your dim candle, and ye shall place your feet on the steps of Shiloh. No more shall ye have an idol of your minds— no more shall there be an high place in your hearts, in the day when I bring you back from your coasts and restore you to your dwelling places. Henceforth and forevermore from that day Israel shall say, I am the LORD and Thou art my God.",
+	},
+	// Chapter 34
+	{
+		"I DO declare faith unto them that have not faith. Unto them that thought of me not, shall I be as a fountain of life. Faith I shall give as water that a desert may bloom. As a chorus of fine instruments stirreth the soul, so shall I cause a rushing in the heart. The Gentiles shall see thy resurrection, and they shall come forth to life. For faith is life to a body. It is the picked chord from my soul, a highway from mine heart. Faithful am I, saith the LORD. I shall call thee forth and set thee up as aforetime. Death, get thee from me! Away, thou evil affliction and curse of man! The LORD mocketh thee from his heaven.",
+		"If thou be plunged within thine iniquities as in a churning torrent, doth it require much faith to know the land shall support thy foot? Stretch forth thine hand. Come from the river crimson and be thou blotted clean by a clean cloth. Be anointed by a fragrant oil and let thy sins be blotted away and left without trace. The LORD shall change thy countenance with a touch, and he shall give thee comfort to abundance.",
+		"For the LORD’s wrath caused the whip to crack, and for his glory sake he was far from us. But the LORD’s mercy is everlasting, and his faith the finger that doth pluck the chord to thine heart and cause it to come to life and hum a new song. He doth declare: I shall make thy children as an ancient, and he who hath few days as if he was from the beginning. My ways have not changed, saith the LORD. As a mother loveth her child and as a father kvelleth for his son, so have my ways not changed, and so my ways shall remain forever.",
+	},
+	// Chapter 35
+	{
+		"WHITHERSOEVER I send my word, it is the breath that cometh before my body, as the shout of a caravaneer before his train. Hearken unto the LORD, O ye mountains of Israel and ye parapets of the free cites, and ye towers in the cultivated fields. Let his words reflect from off your souls like the sun off the purest gold. Send forth the message: The distant land trembleth before my words, and my caravan is a mighty army. Entreat the LORD while he approacheth, and harden not your hearts or make dull your ears. Let not the battle be set in array. Your troops are but a line of ants before me, and your great men but a bug of a bigger belly. If ye entreat not, then be as wise as the ant that fordeth up for the flood of the LORD, for the LORD sweepeth over the land, a sword from Galilee to Gaza, from Acre to Elat, as unto a winter flood before he shall sweep over the land as unto a spring flood. In that day the LORD shall reveal the beauty of his countenance, and in the interim ye shall come from the American wherein ye sojourn, from the nations wherein your feet are not sure.",
+		"Come ye that make haste, and make ye haste indeed; come from the wilderness in that day, for the nations conspire wrath. Come ye a rejoicing, come ye with haste that see the LORD’s doings; that rejoice in anticipation of his beauty. Zion is bare. Her ground is smooth by reason of flood, and not tilled; her seed waiteth to spring forth. Some shall spring wild and some shall wait for the tiller’s plow.",
+		"Hear ye now even the word of the LORD concerning this Zion that languisheth by reason of its hardness. I have given thee glory in the nations, O Zion, O crowning mount and choicest parapet of Israel, and made thy glory as unto many springs in a dry place. Yet thy garden hath not grown, nor the beauteous flowers of spring bloomed. I have made thee beautiful, and thy people as the beautiful bodies in the sun of Elat, of tan skin and white breast. I took away from thee the scourge of Europe and the falsity of impression. Admirers have come with flatteries, and thou hast hearkened unto them. They sought thee as the bodies of Elat, and they delighted in thy flesh, and thou wast flattered.",
+		"What good was their counsel? Was it not from the lust of their eyes? Where now is the abode of thy glory? Those that remain to flatter thee are few and foolish. Where will be the unterlickers in the day of judgment? Behold, it shall be so: the flood shall cause them to cease first in its undertow.",
+		"How thou hast delighted in usury, and banked thy flesh unto thy flatterers in the nations! Thy credit is near at an end. Why didst thou not deal in my word as in sacks of grain, and as in the bounteous harvest? where money cannot affix a value and no lien shut off its reaping.",
+		"But thus saith the LORD, Because of thine unbelief I have removed thy flatterers and made thy borders to quiver. I have taken the fine bodies of Elat and replaced them with the black hat of ignorance, and the coolness of the seaside breeze with that of the presumptuous mouth. Not because of thy flatterers, but because thou wast smitten by their lies. And why wast thou smitten?— because thou believest not in the LORD thy God. Turn your heart unto me, ye captivity, even now, saith the LORD, even now, and I shall hear you. How can your heart be right if ye do not believe, and how can ye obey if ye do not believe? And how can ye believe and not obey?",
+	},
+	// Chapter 36
+	{
+		"BEHOLD, I knock down the pillars and the roof shall fall; I kick out the braces, and the walls give way; I remove the floor from under this generation, that he who standeth by faith may be declared unto this perverse generation.",
+		"To him will I look, and I will make him a pillar to come. I will put my heart into his bosom, and my words into his mouth. Even to one as this shall I look favorably: he who standeth by my faith, and shall not yield to the multitude of perverse counsel; that saith not of tradition, “It is the will of God;” or of things wherein man delighteth, “He hath declared it.” The generation that shall come shall call him a prophet and a prince, he who standeth by faith and putteth aside the vanity of this wicked generation; that knoweth and declareth to this people that traditions are the will of God only to those without faith.",
+		"I shall strip this generation of its walls and its foundation to see whose faith is in the LORD. I shall strip them as an army defeated is stripped of its armor. And no more shall I be called Idol, and no more shall my words be called Totem; but they shall know me again, and they shall scamper to do my words.",
+		"Upon such an one that shall stand by my faith I shall place my name, and he shall declare me his God; and I shall be his God. The evil of the days that shall come shall be as a coward before him. He shall vanquish them as days that are already long forgotten and not to be seen hereafter again.",
+		"Faith is a rock indeed, and he shall say of the LORD, “Thou art my mason.” Blessed is the man who saith of me “He Liveth,” and the son of man who saith “He is my rock.” I shall guide his paths, and all the days of his life he shall know that his God liveth.",
+	},
+	// Chapter 37
+	{
+		"AND the word of the LORD came unto me, even the burden of the vision of Judaea, saying, Go my prophet to the place of the hatching of crocodiles and to the breeding ground of cockatrices. As the eggs of the serpents hatch serpents, and cockatrices hatch cockatrices, why then doth Israel’s eggs hatch forth a worse generation than them that laid them in store? Was the former not bad enough? As serpents’ eggs hatch so hath the self-righteousness of Israel given birth to worse ignorance and ignorance to greater presumptions.",
+		"Declare unto them, peradventure they shall hear thee: Behold, the LORD shall be as a vulture to this Judaea, and I shall swoop down and kill the serpents a laying. And I shall be as a badger, and I shall steal the unguarded eggs and take them thence to another lodging, and there I shall place them by partridges and train them at their hatching and teach them they are not serpents, that they no more know the ways of their fathers, whom I slew in my wrath.",
+		"The LORD enquireth of Judaea, Doth not a buzzard cast a shadow for a time to come? Therefore take this as a warning, O Judaea, before the buzzard descendeth. Ho, all ye of Judaea! I speak plainly unto you. With no rhyme, poetry, pictures or prophecy will I thus again declare unto your hard hearts the superfluity of your ways. I speak plainly, and put no fine point upon my matter with you: Ye are rude. I pass by your marketplaces and your sacred meetings and, behold, ye are a rude people.",
+		"O Judaea, thou braggest of the law so thou coverest thy droppings lest my spirit see uncleanness in the land as I go about the camp. Thou delightest over a tree, yea thou gloriest over a sapling, and makest thy rivers clean and thy hills green.",
+		"Thou makest the land a clean place and the environment a shrine in the name of the LORD; but thy sins stink more than thy sewers, and no sewer can discharge thy filth.",
+		"Your rudeness is more offensive then your draught. Sins ye cannot bury under dirt, my people, consider; but your sins ye cannot bury under dirt, and with time they do not decompose and feed the soil. They rot the land and corrupt your generations after you.",
+		"Put thy sins from thee, O Judaea. Love the LORD thy God with all thy heart and call him not a liar. Hearken unto his word, even from the beginning until now, to believe and to do it. Let every man love his neighbour, do justice without reward, and I shall see no uncleanness in the land and my soul shall not abhor thee.",
+	},
+	// Chapter 38
+	{
+		"WHEN indeed hath Israel believed upon me, and when hath any nation embraced me? Who hath called upon my name that I had not appeared unto first, as unto the morning star?",
+		"They see me from afar. They see me early; but no man approacheth. Yea, no man draweth nigh to the place of my habitation. No man keepeth my sight in his heart as he goeth upon his courses.",
+		"The sun casteth a shadow upon them. Its shadow is a burning canopy. All they that walk upon the earth tread in a desert place. There is no tree, nor doth rock and cleft give coolness from the sun; but its own shadow casteth the sun. Their light causeth dimness of their eyes, and the lids thereof cast a shadow over their whole hearts.",
+		"Night cometh and even this light is removed. Man stumbleth in the evil thereof, and terror is his companion. The darkness of their noonday yieldeth to the bleakness of their night, gloomy darkness, stillness, except for the surefooted approach of calamity.",
+		"And as dawn approacheth yet again, and the fear of gloomy terror is removed, and the heat of day not yet full come, man considereth the evil of his ways. He looketh off to the morning star and considereth his ways yet again.",
+		"So hath been man before me all his days, saith the LORD. Why wilt thou not remain content, O man, and keep the dawn vision before thee? The sun shall not scorch thee, nor a mirage seduce thee in thy desert journey. The terror of night shall keep to its abode as thou passest the way thereof.",
+		"No nation hath called upon me, saith my Lord, and no man can boast himself of me, saith my God. Who is there among you who looketh to a star where it be not?",
+		"I plundered me Israel upon whom my name is called. I plundered me the nations, who called upon my name. Out of many, I took many, out of much I took plenty.",
+		"But no sign holdeth a man for long, nor can the image of it remain in his pupil. He goeth back to his ways. The sun casteth a shadow upon him and he cannot behold me. He squinteth his eyes; but his heart closeth his ears.",
+		"I call not out; but I shall be found of him that considereth, of him that forgetteth not the stillness and peace of the early dawn vision.",
+		"Ah, to what end availeth it a man that he should boast of my name for prestige and know me not? saith the LORD. What is this saying, my prophet, that the children of Israel have appropriated amongst the nation in which I cause thee to dwell? —we must keep up with the Joneses.",
+		"Set thyself against thy people, and prophecy: In like manner doth Israel call upon the name of the LORD. The heathen boasteth of wealth, and Israel boasteth of me; but it is a false boast, saith the LORD. Oh, how my soul groaneth at he who boasteth of my name to feel the better amongst his neighbours! Of they who journey in the desert, there is none so foolish.",
+		"They construct a pleasant mirage, a beckoning oasis in the desert of their day. They lie amidst the palms of their fancy, and in the cool breeze of their imaginations do they lie idle. They drink the elixir of deception; and none knoweth that he withereth in the sun. They who make it to night shall be delivered to the terror. They shall not keep strength to pass through to the dawn. Their redemption is ended.",
+		"Hearken unto the LORD, O Israel: I chose thee not, O Israel, but I chose thy father Abraham. What good dost thou see in thyself? Say not the LORD chose me for this. I chose thee not.",
+		"When I promised thy father, to set thee apart, to what purpose was it? Did I not magnify the nations that day, the day I honoured thee with my name?",
+		"The LORD set thee as a light unto the nations. Yet thou drewest very few. He who setteth the tune and writeth the lyrics thereof, for whom doth he do this? Is it not for all who shall hear?",
+		"Oh, how valuable the nations that I did raise up so great a light! I made thee the holder, and my candle to shine forth.",
+		"Yet I look about me, and I am grieved. There is an empty holder, and a man tendeth a brittle wick. Behold, over the nations darkness and a gloomy bleakness. I am grieved for there is no man to strike a light.",
+		"Therefore the LORD doth set his light in the tumult of thee, O Judaea— a light as unto the morning star. Remove ye the brittle wick, my people, clean the holder.",
+	},
+	// Chapter 39
+	{
+		"PREPARE ye to hear a sound in Moriah: “Let us reside.” I say ye, an echo in the courtyards is heard. Leave off thy labour and come and worship the King. Lay upon his steps, and shed tears upon his feet; and be thou clean every whit.",
+		"They that counsel in blood cannot be found, and he that seeketh them knoweth me not. Blood I abhor. How my soul loatheth death, and my heart the soul, the thought of him who intimateth death. The pit beheld me; at the day of its forming it dreaded my coming. No place was found for it in the earth, in the day of its begetting. Corruption was afraid of me; for my abhorrence of death it would not draw nigh. As God liveth, so do they who call upon my name live forever. What hath cattle death to do with me, and where can its place be found in my abode?",
+		"The ways of the LORD create peace, and they cause the flower of Zion to bloom; but the slaughter of thy flocks created a cold heart, and one not inclined unto the LORD. But come ye to the LORD, for he is comely, and of a gentle hand upon all those who shed tears. Be thou forgiven at the sight of the LORD. Kiss him as a beckon before he cometh.",
+		"Hear, O Israel, declareth the LORD with a mighty voice, and all ye nations that call upon the name of the LORD, offer now of your hearts offerings unto the LORD: peace, graciousness, and a meek spirit, love and benevolence, oppress no more the hireling, and give unto all a fair measure for their labour. Ye who shorten the hours of an hireling that he might not earn his bread, shorten no more. O vexed land, be thou clean, and set not thy cleverness to cunning.",
+		"Rejoice O land that is muddled, and O ye careless thinkers be subdued. For the LORD, for his great namesake, shall subdue the impudent brow, and he shall cause to cease the perverse tongue. The circumspect man shall be a congregation. He shall declare the great name of the LORD, and take courage and inspiration from his doings. For the LORD is honour and dignity, he is the ancient and honourable, and the noble Lord. He mindeth not small things that he might lay a snare. For his great namesake he shall never run before his enemies, nor see them dash to pieces his people. His crest is upon him and his holy name gird about his waist. By liberty and abundance doth he conquer, forever conquering is the Mighty God and never oppressing. Liberty conquereth; she is forever in his hand; oppression is not her scepter.",
+		"The liberal shall be elevated, and the seeker of iniquity cut off. In place of the grasping hands, the giving palm; in place of the clamorous mouth, circumspect speakers. Wisdom shall choke out weeds, and shall cast its pollen in the wind until thy land, O Brier, is a land of flowers and berries. Beauty as the thorny rose shall pierce those that draw night unto it, that it may be regarded with respect. So shall the LORD clothe the land by his wisdom, and place over Israel the apparel of beauty, and so shall he cause all those who approach to respect her.",
+		"And we shall dwell in peace and surety. The hours of the hireling shall not be stopped nor shortened that masters may make long their income. He who is skilled shall not be treated as a migrant. In all the land there shall be plenty, that a man may call a stranger friend, and friend his brother.",
+		"Woe unto him who deviseth mischief, whose counsel is set to find means of annulment and circumvention. The LORD shall not set him in an high place in that day, nor him whose mind is set to extract money, who thinketh long and hard on how money may be gained. Woe unto the government that setteth money as the annulment and as the redemption, for their clerks govern them and their officials are money changers and their judges are reapers of lucre.",
+		"But rejoice thou land of adders’ tongues, for the LORD shall give unto thee the eloquent man, the artificer of beauty, the clever wit, he who maketh profound his music, the builder of gates, and the judge who judgeth rightly by the heart of the LORD. In that day the adder shall withdraw into its den, and for lack of air and stalking it shall die. Great shall be the death thereof, that the adders’ dens shall cave in by the multitude of their rot— and the place of their nesting, the chief habitat of their domiciles, shall be called the valley of silence for the depth of the valley that shall remain. And ye shall no more be vexed by the adders’ tail. I have heard this from the LORD, and so shall it come to pass.",
+		"Behold in that day a sound, in Moriah a sound: David singing to his lover. An offering from the LORD: flesh reappearing. The LORD of hosts shall perform this.",
+		"(The oracle against the heads of Ephraim)",
+		"Thus saith the LORD unto the towers of Jerusalem, and unto the mountains of Judaea, I call from the horn of Jacob— and who is he who answereth? He is gathered as unto the fallen stones and dispersed seed. A call to assembly is heard in the plains, and a gathering is made of armies in the ruined places. Moreover, thus saith the LORD who calleth from the horn of Jacob and maketh it the sounding of his voice: Come ye from the houses of death, ye within the cities. Let the watchmen of your gates see the gathering of banners. But no man stirreth, and there is pity because none answereth. The houses of death have done their work, and the watchmen are next. They shall come out and join the army, and leave off the artificer of death.",
+		"Is this not the state of the wise of my people, saith the LORD? Have they not dealt in natron, instead of the fragrant spice? and in detestable things rather than pure.",
+		"Bile is the broth of their ointment. In your hunger ye went to them, and in your thirst ye drank of the wisdom of mankind, bile unto the LORD. And then as ye withered, ye turned to false teachers and yet ye did not grow fat. Your ways grew thin. Your laws and your traditions were as parched skin on dried bone. There was no substance and ye could see no substance.",
+		"Speak unto them this word, saying, Thus saith the LORD, Is this people’s pride not a stiff neck or is it a weak heel? Yea, saith the LORD, it is a weak heel. I have afflicted you, O captivity, and yet ye would not turn unto me. Because your withered teachings told you to regard my words as less than those who scavenged them, ye could not believe.",
+		"When my words were spoken, your masters snatched them from you, saying: “We too act on behalf of the LORD.” A word here. A word there. Let us make it into a mountain impassable. They weighed my words falsely, saith the LORD, and in the balance they added their own words and tipped the scales to anger me more.",
+		"Therefore I speak clearly in my wrath, saith the LORD, that ye may know I am, and that ye may be sure it is I who bringeth this sore chastisement upon you. And that ye may also be sure, saith the LORD, that it is mine hand which bringeth also the good, in the day in which I turn you unto me, to make you a peculiar prize amongst all nations; that ye may know from hence forth to walk in my ways, to teach all nations. For I will teach thee once again to be a glory, O Jerusalem, and thou O city of David to be a fair virgin; Ariel to soar, where my justice dwelleth. Thou shalt hearken unto my words and I will guide thee. What is not clear I shall make as glass. What man hath made murky, I shall distill.",
+		"Thou shalt surely soar with Ariel that day, for I have promised it, saith the LORD. Thou shalt swing from Orion’s belt, and converse with Cassiopeia; Pleiades shall be instructed at thy voice. Thou shalt say to Dov, “Come, hear;” and thou shalt take of the ladle, even the greater and the lesser; and thou shalt extol the name of the LORD thy God, whose hand hath made all these, and whose hand hath chosen thee. Soon thou shalt giggle at the heights. Yea, thou shalt laugh at thy former ways, and the philosophies inspired by rubble. Thou shalt not mourn at thy turning for long, and shall humble thyself at the graciousness of God.",
+		"For in that day you shall see that I AM, that I AM God, because I draw you unto me as tender children, so that not one of you faileth in the way. Now shall I arouse the envy of Jacob, saith the LORD. The languishing of Ephraim will be no more. He who is exalted from on high shall be the envy of Jacob and the pattern for Ephraim. Laughter setteth well on him who despiseth petty things. So shall Jacob and Ephraim scorn the teachings of your Polish swamis, and so shall they shake their heads at the cowherds and barbers ye made Rebbes. For Jacob’s envy of the LORD hath waxed strong, and Ephraim’s desire shall be to his God.",
+		"Thus saith the LORD, be not like unto them. They are not the ancient and honourable, and their seed no older than 10 generations. They are mystics whose souls soar as the crow; but as a crow landeth on a pig so too do they haggle in common courts. They taught you to round a corner of your heart that ye may not serve me, and to weigh my words, and of their study they make a false science.",
+		"Upon their women they require a covering and then make it a false covering of hair that they may mock substance and make it look as if there is no covering.",
+		"Better not to be born than to do shallowness in the name of the LORD. The sins of my people have a false covering of righteousness, and your bandage, ye Perushim, causeth their sores to fester, and the Pious to wax as a canker. I see them dance as do the primitives. I see them carry my law as a token and as an idol of men. But they know not what is in it, nor do they understand.",
+		"See from afar off, ye mystics; let your souls seek the past, even the mists of Europe, to see what I have done to the place of your nativity. For your father was a Pol, your mother a half-cast passing thought, and that place became a desolate inheritance. Mend your ways while the LORD speaketh peace.",
+	},
+	// Chapter 40
+	{
+		"THUS saith the LORD, The embalmer’s art doth speak. By strange practices the heart hath been preserved, though withered and empty. They who escaped of my people went thither unto the house of death, unto the artificer who preserveth, unto the vats of natron to be immersed therein. Thus they hid themselves from famine. Corruption did they not see, neither did they live and thrive.",
+		"Therefore thus saith the LORD, I am against you, O ye Pious, the chief artisans of vanity. According to your vanity will I do unto you, and according to this will I accomplish your rebuke: Behold the crow and her kin. I have arrayed them in black that they might scavenge and not be desired. They come from their place with a loud noise, and no man careth for it. But a loud report sendeth them on their way. So are ye crows, and your conclaves the roostings of her kin: the magpie is black and white and hath a beak called beautiful; the raven maketh a threatening sound, and the rook appeareth wise. The jackdaw congregateth. But they are all scavengers of the odd and unusual, and all squawk an offensive sound. That which delighteth their eyes take they to their nest. So do you scavenge the words of the LORD, but only of those things which attract you, and so do you take them back to the place of your nesting and do nothing with them.",
+		"Are not the master’s birds fed at his aviary? but the scavenger feedeth without. Bend your ear unto this condemnation, ye artificers: thus saith the LORD, your ways have arisen from the wilderness; your food was retrieved from the unfurrowed lands. I commanded Moses from Horeb, and I spoke through the Prophets, but spoke I not from the groves of your dispersion nor from the mountains of Poland. Keep your 613 ways, divide them as it is pleasing unto you, and at the time of your gathering unto your fathers ye shall say, “We are the flesh for which the seething is prepared.”",
+	},
+	// Chapter 41
+	{
+		"MOREOVER, the word of the LORD doth declare, O ye shallow and blind-hearted, not a double way, as in your own, but a single way. I shall plead wisdom to my people with a rod and a sore humbling. I shall make it as the exchange for barley and for wheat, and I shall exchange therewith and feed my people. No more shall they who sit in conclaves teach my people. And no more shall their lurching prayers declare my spirit. They are as those who pass stones, and the deep contortions of their faces have marked the passing of offense into the bucket.",
+		"It shall come to pass in that day that all those who keep the things whereof I gave unto Moses, thinking that he shall gain from me the resurrection, shall be as they that sacrificed in secret places. He shall be called ‘He who maketh the LORD into a usurer and merchant.’ He will hide in the graveyards, and the things of the LORD shall be a shame unto him. He shall preach to the gophers, and he shall root as the hedgehog, and the bats shall be his commiserants.",
+		"But who is the man to whom the LORD shall look upon with delight? even this: he who should love me more than anything; he shall be as my child and I shall be his father. He shall keep my ways for he seeth that his father feedeth him and clotheth him, and freeth him from the hand of man’s oppression.",
+		"Is there any father among you, saith the LORD, who placeth a burden on his son or delighteth in keeping accounts with him? It is this that the LORD hateth: that we know not that our piety is sin. We all Pious are dried sore as a well with no water. We all made his laws selfishness. Yea, self-obsession is our light; it is waxen gross upon us like the bright spot of disease. Our sins are grave indeed, but, behold, it is our hearts that have truly transgressed, for they roar like a lion, and we boast like unto the cockerel.",
+		"Let the lion roar no more, and let the cockerel see the roasting pot, and leave off its boastings. But hear ye the word of the LORD: Ye say I clothe you— but I recognize you not. Ye say ye brag of being my people— but ye fear every man, and a dog maketh you to tremble. Your eyes wander at a count to 10, and for fear ye will not admit the number. How deep ye are! How shallow must my spirit be if I walk with you. Rather should my people eat of swine’s flesh and break a dog’s neck before me than cast my name unto the carnival and tie my laws with the knot of the superstitious and fearful.",
+		"The heavens rejoice at my glory. The Universe trembleth at the awakening of my wrath. My glory causeth the angels to burst forth.",
+		"But ye are as brave as your conclaves and as mighty as your inability to count to 10. Call yourselves not my people, for my sons would be as me. Neither the coming of the night nor the heat of day would retard them. Mercy they would run to. Graciousness would light the paths before them. A whirlwind they would tame, and a burp they would not consider.",
+		"Behold, they whom ye have detested, I have not detested. And they for whom ye had no regard, my countenance hath looked upon. Though they be not like you, because they be scattered amongst the nations I shall gather them back and give them the land of Israel. And many peoples shall run for refuge unto the LORD in that day, and I shall plant them safely in the land.",
+		"But be thou Samaritan, saith the LORD, for thy ways shall not be linked with me, saith the LORD God of Israel. Whence cometh this foolishness that I see? Behold, they who are called the most pious amongst you serve other gods, and your hands lay hold of a chicken wing for justification. Ye are as they whose hands caress stones, and as they who covet a tree to make of it what they will. Be thou Samaritan, for thine abominations and sacrifices do make thee as Bethel, a place of false altars, and thy heart the hob of false fire. Thy flesh is a false sacrifice, and Gerizim a seedy mount; and it shall come to pass that as the Samaritans thy flesh shall be false and thy ways far from me. Thou shalt be no more Israel, and Judah shall not accept thee, nor shall Moriah be thy mount of rejoicing. But thy ways shall be the ways of mutterings, as thine incantations; and ye shall be cast off, every one who corrupteth his soul with the flesh of beasts and the sacrifices of hen’s blood.",
+		"I seek to rejoice, but it is far from me; for this my people hath corrupted their way. Their hands fondle birds and their hearts utter strange spells. This people is iniquitous; the branches are foolish and the stock is dry. Why? Because guilt hath made wise their own knowledge, and the people shrug at indifference.",
+		"Come ye to the graveyards, to the pits therein that ye have dug, and sacrifice to the idols of your own incarnation, for no more shall the light of day bear them, or the sun cast its rays upon them. They are for the moon, and for the deep and shadowy haunts.",
+	},
+	// Chapter 42
+	{
+		"THE LORD speaketh bold, he proclaimeth clear from his mouth his holy oracles. Let all men live by them. Let them rejoice at their understanding, in the day of their understanding. Let them not forget the LORD their God, for he is a mighty terror unto the nations that hate him, and a sure rock for those who call upon him. Blessed is the man who shall live by the LORD, who calleth unto him in passion “My God, My God.”",
+		"The LORD is not joyous when the wicked perish, and he hardeneth his heart only to bring to balance the scales, that no man should say of his creation: “It is my trough,” and of his fellow man: “He is my ladle.” But the Earth is the LORD’s, and all they that labour and all they that rule are the LORD’s. Let no man say “Grace hath given me the right,” for who art thou, O man, to always think of gain and consider thyself in the measure of things?",
+		"But upon whom will the LORD place his rebuke, and who will the LORD harden— even him who esteemeth himself by grace and who useth grace as an excuse. To him will He make His works a burden, that grace may have no form, that pity may have no meaning, that the works of God may have no substance, so that he might stumble and fall, and be cut off from the name of the LORD. Even such an one as this will God harden, that grace may be only word upon word, work without deed, feeling without substance, precept without purpose, that they that teach grace without mercy may be snared at the heart of God, and destroyed. For him will the LORD’s words be winnowed, strained and sieved, that the LORD’s wrath upon him shall come and not tarry, and that he may not see the days of grace that shall come. They are chosen for destruction, and grace to them will be without mercy, grace without form, word without deed.",
+		"But let your hearts go forth. Let them fly from you as a swallow, that ye may visit all men and be messengers of the most high God. He is the LORD your maker, the great king, the God of Israel. Blessed is the man, yea, blessed is he whose feet weareth bells sounding the good tidings of the LORD; whose heart chimeth in tempo, proclaiming the lyrics of the doings of our God. It is he who craveth not worship, nor commandeth appearance, nor accepteth persons. It is he who is your God, calling the heart to repentance. He delighteth not in a heavy rod, nor doth he come with scales that his words might be the barking of merchants.",
+	},
+	// Chapter 43
+	{
+		"THEREFORE thus saith the LORD your God, who is he that is foolish and what maketh a man to delight in a stupour so that he will not stop and consider himself and the sum of his ways? Is it not he whose mind and heart is always to his own gain? Thus saith the LORD, this is indeed this people of mine. They have indeed become stupid, for only one thing is before their face, and this causeth them to interpret my law: even their own gain and their constant thought of rewards. Therefore I shall bring up with me always they of the Gentiles who shall believe upon me. Because their fathers also saw my terrible works which I wrought in Egypt and came forth from Egypt to hear me at Horeb; and because they came to me with no works of gain, but sought the Lord while he was near; if my words should ever pass, if my way should ever end, so shall the remnant of the nations end before me. I say unto thee, Never shall they of the nations cease to be a people before me, to tread my courts, and to praise my name. The LORD thy God hath declared, and so shall it be. I shall never make an end of grace, nor shall pity cease before me. They are as the ordinances of the heavens: they shall never cease. They were spoken in the beginning, and they shall not cease to be spoken after the end. They are the works of mine hands; the labour of my soul.",
+		"Mercy, mercy, hear the armies of the angels sing before me. See my throne carried from on high, for I the LORD do mount up my hosts. Trumpeters sound, tambourines are beaten, the host move forward. Who will stand before me and put up his hands and say “I cannot find my covering. Where is the place of my kippa?” I send forth mine angels, they who have stood before me, and where is their covering, and what cloth is so fleet that it may match their speed as they go forth?",
+		"I proclaim it as I am true and that there is no other God beside me, saith the LORD. I look down from my heaven, and marvel. This people hath become shrunken and blind hearted. Ye are shrunk within your wall, ye Perushim; it is as a band constricting you. Therefore, thus saith the LORD, I shall appear first outside, and I shall marshal mine army against your wall and against your masonry, and your gates shall not withstand the army of the LORD.",
+		"If my ways are small, saith the LORD, and if my mercy should be set in bounds, my goodness drained as if from a bucket, so shall Israel remain a remnant before me, enclosed in high walls, shrunken, picayune and without knowledge. But as my mercy is a torrent, my nature a never-ending fount, so shall I as a flood course through Israel, even Judah, and I shall make your remnant as a multitude, your hearts an open green pasture; and Israel shall not be again made unto a remnant, but the sons of Isaac shall be many, many as unto the stars of heaven. Your walls shall I bring down in my flood, and my torrent shall disperse the masonry of your hands. So shall the LORD do this, for your masonry is as unto an idol before me, and your shrunken laws as useless as a lifeless form which cannot speak, even to say Ho! there is your God, O Israel, he cometh, he cometh hither even to shatter me before him.",
+	},
+	// Chapter 44
+	{
+		"O FOOLISH people, hearken unto your God. Ye of the seacoasts, prepare! Ye of the mountains, look afar off to the east, to the north and to the south. Place your hand upon your brow and squint. Set a watch. Set a watch for a sandstorm, for a wall of destruction, and a bitter overthrow. Sound a trumpet at the sight. Ring the bell of warning. Let him sink in the valley of decision, and cover himself with the LORD.",
+		"If ye believe upon the LORD would his scorn be injurious to you? Why suffer it! Why endure ye in the dry and desert place, only to come to the darkness of your ways?",
+		"If thou believest that I am, O seed of Jacob, why dost thou tempt me? Why dost thou walk a desert place? My water is for thee to drink. Why then hast thou poured it upon a desert weed that it may grow? Shall a weed give thee shade from my wrath?",
+		"Thy potsherd hath committed sore abomination because of the wickedness of thy soul. Thou hast poured it as magical potion, thy magicians declaring “Make a curse into a blessing, and rejuvenate this land.” Thou hast poured out thy soul as blood in the doing, and wherewith will thy soul now be rejuvenated? Is my law a riddle? When I gave thee plain riddles, thou understoodest them not. When I speak plain, thou sayest “eh?” Your captivity languisheth; its feet laggard, its heel a lead ball. But ye masters of foolishness snatched away my words lest my people should consider and repent.",
+		"Ye are scattered about the Earth, to the four winds. Jerusalem is in desolation. Ye are in captivity. Was it sin or righteousness that caused this to come upon you? But ye say my words are of no moment anymore. We shall hearken unto the Elders. Let them tell us we are beautiful and that our souls are clean.",
+		"Therefore, thus saith the LORD of hosts, hear, O Israel, the hard way that thou hast chosen. This last time I shall afflict thee. Affliction is a hard tool, and a rod from Syria shall I bring upon thee, and the Egyptian from the south. I shall lift up thy filthy skirt so that the American no longer adoreth thee and plieth for thine hand. He shall not deliver thee from the affliction that I shall bring.",
+		"Go call him the eagle if thou wish. But he shall have a woman’s head and a man’s desires; a scepter she carrieth to tilt the scales of justice. The LORD is a mighty archer, and I have brought me down many a swift bird of prey, and lured many a chicken hawk from the nest of its neighbour.",
+		"And the LORD instructed me, Go place a caldron at Damascus Gate, for out of the north I will bring tumult on this land and on this city Jerusalem. It shall be a river. The American shall pass through this gate to Joppa way, as a river also, and he shall no more return. For I make Jerusalem a caldron and the Syrian as the boiling water seeking its stony hearth.",
+		"For they have provoked me in all that they have provoked, saying, The American will deliver us. I am angry with Jerusalem and discontent with Tel Aviv. I shall make Jerusalem as the handle of the caldron, and Tel Aviv a morsel in the seething therein. I moreover shall make your walls as the potsherd and Tel Aviv as the shards of its residue. Hear this word that I have spoken, O Jerusalem, and turn and repent that I shall relent. Cast ye off all the malnourishment of the dispersion, wherein ye hearkened to foolishness in the poverty and famine of your souls, for I did not speak unto you then, nor did I counsel your fathers, nor would I hear their prayers and guide their dreams. None came into my presence and sought my counsel, nor instructed I them in anything. But you have sought the American, and you have desired the nations. Even now do you desire them to deliver you from hard times; but ye know not the LORD your God.",
+		"Yea, surely all they that walk within the desert walk alone. They seek a companion; they look for footprints to guide them. Yet they who come to the night seek to be alone; fear of footsteps in the darkness, a companion coming through the unknown. Before the dawn breaketh upon thee, O Israel, that companion shall afflict thee. In the darkness before dawn, the terror cometh upon a drowsy slumber.",
+	},
+	// Chapter 45
+	{
+		"THE word of the LORD that came unto me, saying, Speak unto Israel, even Judaea and Jerusalem, and reserve a word against that day that cometh, and say: O ye scattered captivity and thou entire Palestina— Ye have chosen a hard way. A fool’s ears are on his back, and with a rod must he be taught. Ye that pass through these gates, that have made Dung Gate a highway and Valley Gate a river, say not “We come nigh unto the temple of the LORD” while ye betimes do business. “By the hand of the LORD, by the hand of the LORD, the nations preserve us.” Thus saith the LORD of hosts, the God of Israel: Pass no more through Dung Gate, for I shall make the nations a heap and a stench in Hinnom by my fury. I pour out my fury on them, and I upset, and make them to bewail; and ye shall enter Sheep Gate for the stench thereof shall be great and the heap thereof a source of scattering.",
+		"O ye muddled and wearisome, ye make long your avenue of repentance and short your avenue of pain; long distant your place of confession. Each one flattereth himself and expandeth the chest in boast. By ignorance ye boast of the nations, and by foolishness ye set no watchmen upon the walls, that ye may make long your deception of surety within. Hear ye these words, for the LORD God of Israel is he who lifteth up his voice in the gates, and his cry from the walls. It is just that I should make an utter end of you for your arrogance, saith the LORD, but it is not just that I should cause sudden wound unto mine own heart. Therefore I will not see you cut off as a people, saith the LORD, though schlunder ye make your pace and laggard your heel. Thus saith the LORD, It is I who did scatter the Ishmaelite and the Palestinians around about you that I might bring this day to birth, even the day that I wipe away your pride and blot out your transgression.",
+		"I have waited for the day, and now I speak it. I form it with my words, and I prepare your hearts to hold it. Even while your fathers sinned I preserved them, even while ye sinned I wiped your enemies from you these last [45c]55 years . Do ye think it was your own power which dissembled them? I set in store for this moment. Ye shall never perish before me as a people, nor shall I make an utter end of you from the land. I speak it now forever: it was not to preserve thy flesh that thou shouldest be preserved before me, O Israel, but it was for this generation to see my great namesake justified in all the Earth; that they might be raised up, and that Israel should bear its place, a nation of believers in the LORD their God. I shall get me honour in all my creation that day when all the Earth knoweth I have prepared this day from the beginning, and now I speak it.",
+		"Hearken unto me, for I will not part from my purpose. For surely, saith the LORD, I make an end of thy surety on the nations. Thy rest upon the Gentiles is vanity, and thy embassies to America to deliver thee pointless. The Lion of the Isles will not come to thy rescue. But I say, Nay, go thou not up, whole Britain, nor send thou the Scot with the American, lest thou sufferest a greater wound from thine ally. They shall not thwart my purpose nor douse my hot sword from the smithy’s furnace, for I set over the East Gate a two-edged sword is in mine hand, against thy rest and against the nations it shall burn.",
+		"Kedar shall not be a safe exile for you in that day. It shall be a gathering of armies, and into every wilderness ye flee ye shall be within the scope and not be hidden. For my sword shall swiftly streak over Jerusalem in that day, toward the West, and it shall cause the great sea to boil, and the strength of the occidental lands to fail. It shall cut in pieces the priests of Baal-America who bestride Valley Gate in Charisma-Hinnom, saying, “By the temple,” but denying I am He, and it shall dash even the armies that come not hither, in every wilderness yon. Because of the nations and their priests of Charisma and their lying tongues is my wrath sore upon this place.",
+		"Have a dull ear, shake the head, pull away the shoulder. Ah, think thyself the wiser for thy implacability. Let the cynic be raised as a standard; every tiller of the ground and plowman think himself a prophet in that day. But I shall bring my word to pass, saith the LORD, though ye say, “God cannot speak;” and though the guardian of customs maketh himself bold: “Let us hear him then, if so he speaketh. Was he not the invention of our fathers?” Do they not provoke me to anger, saith the LORD, though I will not be provoked? “Speak not a word against our customs that set us apart. Say not God is not, but speak thou not in the name of the LORD.” Though it is a provoking, have I provoked me that my soul should lash out?",
+		"Stand ye aside from the gate! What aileth thee, watchman of the LORD? Have we not called thee our brother? Wail not in the name of the LORD, thou Nazarene. We hear no marshaling of troops. Shalt thou, my servant, be provoked, if I am not provoked? saith the LORD. The voice of Hananiah from America doth magnify himself as Hananeel , and declare the LORD hath not spoken unto me harsh tidings, to thwart thy people that they should repent. Behold, they say let all worship what he will; the LORD causeth this peace, and he is found in whatsoever causeth contentment.",
+		"Behold, saith the LORD, do they not make a mockery of me, saying this is my peace, these whoredoms they have pronounced? Therefore thus saith the LORD, though they provoke, it is their contentment that shall cause sore pain upon them. For this I will deal terribly with them, for my people are corrupted as unto the Americans without, and thus they hear not the marshaling of troops. Though troops marshal not, I shall destroy them; them that marshal I bring unto this place. Ye shall flee via Dung Gate, and cast behind you Hinnom and its baalim. Content therein is every man in his valley. Everyone seeketh distinction, rewards, and power. The most judgmental are called liberal; the searcher of iniquity is called tolerant; and he who defendeth himself is called hateful. As the kingdom of Jeroboam the son of Nebat, are they any different than the Canaanite about them, and any less venal than the Phoenicians in their day?",
+		"Thus saith the LORD, O ye foolish custodians of vanity, ye that say God is our expedience: Behold I, even I, saith the LORD, bring convulsions upon your gates, and grave fear the nations; even from the Dung Gate south unto the Sheep Gate, from the Horse Gate east unto Jeshanah, even as the world as upon Jerusalem; and ye shall be as enchanters who have lost their arts and flatterers who have lost their guile. I shall pour out my fury and mine anger as bitter wind upon the nations; the horse shall lose his rider and the oxen their yoke; and thy yoke off Israel I shall take. And it shall come to pass that they who be left shall tread my courts with gladness, and lift their song in the house of the LORD, where there will be no more enchanter and no more gainsayer.",
+		"In that day my mountain of fury shall fill the Earth, and it shall rise forth as a smoke and a thunderous mounting upwards. And there shall be no place wherein a man may run and say he hath excuse, for my wrath shall scorch even the hinter forests wherein dwelleth the reindeer.",
+		"Search ye now for the fox’s roost, or for the place of the dwelling of the unicorn, for surely if ye repent not now at my words ye shall flee and hide from me. No scorner shall remain in all my holy mountain. For my wrath shall not be quenched upon the nations, saith the LORD; my fury not made short until I have sunk the heart of every man, and removed the adder’s tail from every mouth; the prophets of Charisma from Hinnom’s gates.",
+		"Flee unto the multitude of cities, but a flood shall overtake you all. Flee unto the sea, but upheavals shall upset you. Flee not and stand haughtily in your place, but the green fire of heaven shall descend upon you, and ye shall lose your hard eyes. Thus saith the LORD, seethe your meat in the caldron, and draw the flesh upon a seething fork, that ye may know the LORD’s intention upon you in that day. For thus saith the LORD of hosts, I shall cause great fear to come upon all the northlands ; and bodies shall make their homes their tomb. The American shall walk on foot. I shall bring up they from the south for fear of what shall befall. The seething shall take them; it shall consume their tongues first; their eyes shall be consumed, beast and man, great and small. Yet for all this I shall not speak peace upon the earth, nor stay mine hand until I make an utter end from the Old Gate westward, and a wind to cleanse upon the East Gate to the islands afar off, until the houses of their god’s are bare and their gold an abomination.",
+		"I shall speak unto the deep, my voice shall reach unto her lowest abodes: Bring up Leviathan, that king of the sea, that coiled armored serpent, to be discovered from the place of his myth, into the harbours, for too long hath the LORD been declared myth; and as he breatheth fire so shall the LORD declare his vengeance upon this generation; and they shall no more say, “He is a myth.” See your stars crash down, they which ye have placed in orbit. Who can turn the moon, and who is he that bringeth the heavens upon us? Who can change man’s speech by waves ye know nought of? It is I, the LORD. Glory is my going forth. Justice is my burning fire about me.",
+		"And astonied I prayed against the dread which was to come, saying, “Lord, have mercy upon this Earth and turn thy face from thy fierce wrath.” And the word of the LORD came unto me, saying, I shall have mercy upon the Earth by my fierce wrath. Thus saith the LORD, as the Ishmaelite burneth his own wealth to spite the nations, so hath this generation burned its own mercy before me and halted its own rise. I shall plunge it low and utterly purge it before me; I shall utterly bleach it in the depths of its iniquity, and that which escapeth shall be escaped in mine hand, for an upward thrust maketh the LORD, and a sudden deliverance. No more shall they kill and eat their children; no more shall the meek be a prey and the speaker of justice a target. They shall pray unto me, but I shall harden their hearts that they reprove, even reprove the LORD God, that I may bring upon these nations the wrath they have courted for generations. For too long did they raise their bloody hands to me in piety and praise.",
+		"And ye shall set watchmen upon your walls in that day, in the day wherein ye need them not for the desolation that I shall bring upon the face of the earth. And they shall look for men, and they shall not find them; but they shall find the unyoked oxen; and the place where the horse was tamed, lo, there shall he be wild.",
+		"Say not the LORD will not do this thing. I have made it long, that ye may know the prophecy cometh and tarrieth not, cometh even unto your gates and unto your desolate mount, and extendeth even beyond the days of your rejoicing and recovery, that ye may take warning from the bitter overthrow I have brought upon the nations which cast me off, and ye shall trust in the LORD your God, and ye may have peace because he cut off all they who would not walk circumspect upon the earth.",
+	},
+	// Chapter 46
+	{
+		"AND the word of the LORD came unto me, saying: My prophet, speak thou unto Israel this word, also this word to lay up against that day, a word to bring down the high places of Rome and Carmel, and a word to shake the powers of the nations; to lay in store, a day to build the shield of Jacob, that I may bring the younger to age and set them up in that day as an anchor, a reserve I shall not deplete. No, they shall not be depleted, but they shall be a fine oil, a fragrant oil in a jug that I shall pour out on all nations and yet it shall not empty nor its line decline.",
+		"This is what they shall do to lay in store against that day: In the year of the 70th hebdomad of the Gentiles, counting from Passover unto the 50th day, for I am the only shield and buckler unto Israel;",
+		"Therefore in those 50 days, let them enquire of me thusly, for I shall hear them, and there shall be no doubt. Enquire of me of thy whole heart. There shall not a man or child waver.",
+		"Enquire of the LORD your God: Did I come in the flesh amongst their fathers at the time appointed by my servant Daniel? I shall not hearken unto a lackey, or of him that doth not enquire heavily of my spirit to know.",
+		"They shall enquire thusly:",
+		"In the volume of the books is it written that I should do this, that Israel should not hearken as a body, and that I should go out unto the Gentiles, and that they should hear me?",
+		"No more of their excuses. They shall read, and they shall enquire of me during those 50 days. They shall enquire of me and study my words daily. They shall pray with a humble heart to know.",
+		"If they hearken unto thee, and if they should believe in the LORD their God with a whole heart, and if they should enquire of me with a whole heart, I shall answer them, and I shall deliver. Lo, they have hearkened. I shall keep my words whereof I spoke unto Moses, and they shall not be cut off; for, lo, they have hearkened unto me, and I shall swell their hearts and enliven their spirits, and I shall remove their tremulous hearts and their tense inward parts, and I shall give them a new heart; and I shall give them peace.",
+		"They shall bow with their hearts to the ground and pray to me, without book or rehearsed speech shall they pray unto me. They shall pray unshod. They shall pray as they walk, and as they cook, as they study and as they dine. They shall be unshod; for I shall pass amongst them to look upon them; and I am holy. I shall pass over them not as I did in Egypt when I passed over their fathers; but I shall come to the humble heart that seeketh and shall not pass him by or be far from him.",
+		"In those 50 days my spirit shall search out the heart of Israel to see if they shall abstain from their ways and from the idols of their minds. He that crosseth the lintel of the beams of the frame of his door, I shall visit. The LORD thy God hath come to thy house to establish it. He that placeth it as a charm or as an idol, I shall not come to. See, I am spirit, and man must worship me from a right heart.",
+		"I shall open the synagogues, and Israel shall teach the nations. As Jerusalem yielded to Constantinople, and as Constantinople yielded to Rome, and as Rome yielded to Wittenberg, so shall Wittenberg yield back to Jerusalem. The time shall return. But ye shall not be like them, unto their ages and times, nor walk according to their ages and times, saying what happened therein was precedence of the LORD, and I shall not humble you before them.",
+		"Thus thou shalt not drink of the cup of the nations; but thou shalt drink of the juneval headwaters of the LORD. His water is pure indeed, at the mouth of the rock; but, lo, the further the water floweth the more impurities it picketh up, and there where it sitteth it becometh brackish and unsound for mind and body. So shalt thou consider the day of salvation as the juneval source of the LORD. Ye shall hearken unto Moses and all the prophets, and all that I taught your fathers in the day they would not hearken unto me before my face before I scattered them upon the face of the whole earth; and ye shall repent.",
+		"Ye shall repent. Every man and every child. Ye shall repent. Your wives and your daughters. All that is within your household. Ye shall return and turn unto me.",
+		"Ye shall correct the Gentiles in their ways, for, lo, they have corrupted themselves; in things old and new they have corrupted themselves: in matters pertaining unto the powers of the living God they have corrupted themselves, in that they say I am not one. Lo, I Am One, saith the LORD. JHWH is my name. I have none other. My Spirit is holy and my tabernacle is Saviour. I am Saviour, and beside me there is no god. There is no other path but mine. I split not asunder. Thou shalt not be instructed of the churches, but thou shalt instruct them, for indeed have they corrupted themselves before me; and my spirit shall come upon you to teach the nations.",
+		"Behold, they have kicked fat, being fat and spoiled; and having not seen me each fancieth his spirit’s indulgence. None prospereth, and no hands join for a common good, for all seek only what is pleasing in their own minds. But put ye aside your witchcrafts, astrologers, your palmists, the workers of Wicca, your wizards and enchantments, your quartz charms, and those who work with familiar spirits and speak for dogs and animals. Cast away the bones and carved lewd images. Bring out from you these abominations that it may be well with you, for by these arts doth a haughty mind deceive himself. Those who claim a familiar spirit are abomination to me, and those who declare “It is a gift,” lie in their deception. Put these away and cleanse your souls that it may be well with you in the day of my wrath. For all this mine hand shall not be stayed; but I shall utterly purge the nations wherein they have committed these abominations, and wherein they pollute my holy name.",
+		"Who layeth it to hold that the LORD is holy? And who understandeth his great glory? As East is from West, so are my ways mysterious to man, and my ways approachable but never surpassable. Know ye not that the LORD is honour, and without him there is no dignity. There is none who can command of my spirit that I should perform it.",
+		"Shalt thou see me as I am? Shalt thou say, Lo, God speaketh to me by each evening’s twilight? Nay, there is no creature that can bear my sight as I am. I croon not by the window of maidens past their prime. I visit not underachievers to give them their daily pride. Behold the great powers of heaven— it is as straw before my glory. But by my words ye shall know me and by my doings ye shall see me.",
+		"I am the LORD: I speak and it cleareth the muddled mind. I breathe and it giveth wisdom. My words do not prolong, but they give fruit an hundred fold. It lingereth not in the ear, nor doth it remained caged within the heart. Understand they not, nor doth flesh comprehend, no mind hold, that no one can stand before the LORD as he is; no creature can behold. No man, yea, no angel, hath seen his face. No creature can withstand my glory; and as I live, saith the LORD Most High, I will not change my glory or sully it. Fire would quench your land, glory blind your eyes, and your earth set a smoke.",
+		"Therefore I did a wise thing, and there where I placed my spirit mine house was honoured. Canst thou lock me in a house of stone, saith the LORD? Rock and metals cannot hold my spirit. No, nor can the piercing of flesh destroy any man’s soul. Therefore did he walk among us, and spoke unto us face to face, as we are. And who is there that understood, and who is there that comforteth? We all are ignorant. We all comprehended not. O Emmanuel. We called upon a temple of stone, and we delighted in a place that did not speak, and no man called upon his tabernacle. But we shall call upon thy tabernacle forever more. So shall ye call upon me in that day and my voice ye shall not ignore, and ye shall know that I the Lord, am the LORD your God.",
+		"Who knoweth the heart of men but the LORD? I see thy pride, O Israel, and I shall hone it after knowledge and not after foolishness. But the stock thereof, thy pride in me, I shall let remain as the shaft of the beam that I shall hone. And with it I shall support the uppermost works of the house.",
+	},
+	// Chapter 47
+	{
+		"HEAR ye now the cry of Jacob, a swaddling cry! From baby to manhood he groweth, for his hand is in mine hand, saith the LORD. I teach him to walk again, and I bring him forth and guard him through the travails of life. How love cannot choose! One is bound! Love compelleth! So am I bound to Jacob, saith the LORD, and he will not earn me by knowledge, or by craft, nor will a work endear him. Speak not to me of thy merit; gratitude I am tired of. But come all ye who are compelled toward the LORD, and receive everlasting life!",
+		"Behold, saith the LORD, though I gather Israel slowly, or gather I them fast, I honour my words, and they shall be gathered.",
+		"In that day thou shalt go up Nebo. There thou shalt take within thy fist the dust of the earth. Mingle it with clots of clay, hard earth mingled with dirt, clotted earth. Mix within it pebbles. Within thy fist clutch it. From thy right hand broadcast it before thee with one broadcast. As the dust scattereth and findeth no foundation so shall I disperse the power of the nations about thee, and as the pebbles hurl to the earth in one gathering, so shall I gather Israel and scatter the power of the nations about thee; and as the mingled clumps of clay and earth shall fall around the pebbles and intermingle so shall they be a bulwark about thee, O Israel, a bulwark from thine enemies in that day.",
+		"Fear not, for I have scattered the power of the nations ’round about thee, and I have driven them to thy borders, beyond the river Jordan, beyond Galilee and Golan, and even unto the river of Egypt; and thou shalt go up Nebo and thou shalt perform this sign before Israel; that it is of the LORD their God, that he shall perform this, at the time when I have turned them.",
+		"I shall give them laws in that day, laws from my heart, saith the LORD. Laws that are good, good for their souls and good for their bodies. I shall codify my laws; laws to live by; and all Israel shall hearken unto me, saith the LORD; all they of the nations with thee shall hearken unto me; the kohenim, the prophets, the seers, and all they that dwell within the land; and they that cling to the LORD his God to love me and to keep my commandments.",
+		"Laws they shall be to impart unto the people my ways; from a right heart they shall hear and they shall do; laws that shall not tip the scales of my words; but laws that shall be from an even weight before the LORD. I shall bind them to their feet as sandals, and I shall engrave them upon their hearts, and in their minds will I rehearse them.",
+	},
+	// Chapter 48
+	{
+		"WRITE this in a book and set the words in store against that day, against the resurrection of mine house, for the keeping of mine house, and for the ordinances that I shall give thee, for all who shall come to my house to worship. See that mine house is built according to all the manner and custom; and according to the need for size; that the plan of the inner court and land oblation be done as I gave unto bEzekiel— Was he not thy predecessor?— in the day that I gave it to him.",
+		"At the base of the cross thereof, according to the design I gave him, I shall meet them. It is where I place my feet. It is my throne. It is the holiest of all. That place shall be holy. It is not an idol for the people, nor is mine holy name an idol to be cast out, now allowing, now forbidding. But this is my throne, and the outer chamber wherein there is the candlestick is my footstool. Thou shalt worship before me. This is not thine house, O Israel; this is the house of the LORD thy God. This is not mine house in Jerusalem, among many houses in other nations. This is mine house. I am the LORD thy God.",
+		"No more shall they demean the kohenim in that day, nor make light of them, saying, “It is only symbolic,” but they shall hearken unto my word.",
+		"The kohenim shall keep their place in mine house, but him that polluteth the office, whether by deed or by mouth, saying, This hath no weight— he shall not serve until he hath repented before the LORD.",
+		"And the kohenim shall comfort they who come to worship. They shall walk the courts of my house and they shall walk among those that worship. He who is in distress and weepeth shall they comfort. They shall walk the courts, whether they be the courts of Israel, the women’s courts, or the courts of the nations; they shall walk them and perform their duties. They shall comfort and edify all who come, whether from the nations or from Israel; for this is the house of the LORD your God, and this is the service of his priests.",
+		"And I shall look upon their soft hearts, and I shall have mercy upon them; and the comfort wherewith they shall comfort people shall be my comfort, for I am an holy God, and I do look down upon the humble heart and I do see the soul in distress. They shall call upon me, and I shall hear them, and the kohenim shall comfort them and shall bless them.",
+		"With harp and chorus may the Levites play before me, in a great congregation before me in the inner court; and they shall sing a solemn sound before the Most High. With Kantor and with psalms shall thy songs be acceptable to me, whether base, tenor, and soprano voice. And the people shall sing unto me. Even the voice of the boy and the voice of the old.",
+		"The Kantors shall enter the holy house, and they shall sing before the LORD, before the holiest of all shall they sing. With base, tenor or soprano voice shall they sing. They shall sing in exultation, in solemnity, and in adulation. They shall sing with loud and with temperate voices.",
+		"This shall be the mood that ye shall keep in all my holy mountain, in all my courts, and in the passageways: Let their be joy and a rejoicing, and let all who come remember this is mine holy house, an house of prayer and peace, of stillness, and of a burning heart, a lump within the throat, and a penitent heart. It is the place of my feet.",
+	},
+	// Chapter 49
+	{
+		"LET the Great Basin be used for baptism. Let the priests lay hold of they who come, and let them anoint them with oil for comfort and for healing. Let them pray unto me, and I shall hear the right heart.",
+		"Let also the baptisms be done in order, and once only; once for each. Let the children come forth to be blessed. Let them be blessed and the babies also, before the house of the LORD in the inner court by the Great Basin. Let the sojourner that sojourneth among thee, even he that saith I shall not be separated from his people, nor from the land, let him come to this place, and before the priests let him be purified by the house of the LORD. There I shall see him, and there shall he be a Jew to keep my ways before his eyes all the days of his life, and his children shall come also.",
+		"Let the priests remember before whom they stand when they shall perform their oaths and their duties before the Great Basin and the house of the Most High. For they who come, come to me, and man may not prevent a sincere soul from coming before the LORD God at mine holy mount in Jerusalem.",
+		"This shall be the law of the nations who come before the LORD, to be baptized, to have their children blessed before the LORD, or for the sick to be anointed before the Most High, to be comforted before the eyes of the Almighty. From the side doors of the inner court thou shalt set a barrier, a barrier of fine ringed cords, royal cords of purple and gold; and thou shalt extend it to the Great Basin, in the form of a right angle shall it lead up to the Great Basin from the door to the inner court; and on days set for the baptism of those of the nations, or on days in which they shall bring their children and their babies, ye shall admit them to the inner court of mine house. Ye shall ring off the soreg, and let them pass through; and they shall approach the basin in order. They shall not step over the royal cords, for I have given that unto Israel to worship me any day or night; to bow and sing to me, and for the Levites to harp before me. It shall be a solemn place, an holy place to worship the LORD for Is",
+		"But on sundry days, or as the need alloweth, thou shalt admit the nations into the inner court, all they to be baptized before me, and to have their children blessed and the sick to be comforted and anointed. This shall be the work of the kohenim, and ye shall keep the barricade, for as I covenanted with thee so did I go out amongst the nations and they heard my call and came to the great king, to his kingdom. Therefore they shall be admitted into the inner court on sundry days and times and occasions as is necessary; and shall walk within the cords and approach the priests of the basin, those who perform the things of the Great Basin wherewith I charge thee now.",
+		"There shall they be baptized unto repentance; there they shall bring their children, their sick and their babes; their old and their maimed, and I shall look out upon them, and thou shalt bless the humble heart. Let not he who is proud approach me, no not of the nations, no not of Israel; for I am the LORD God, and thou shalt minister my things before me and be a light unto all who come, warning and cautioning that they who come walk on holy ground; for I am holy, saith the LORD.",
+		"But he who is baptized unto the baptism of Jewry, he shall tread mine inner courts as a Jew. Whithersoever the worshipers be gathered before me of Israel, there shall he too be.",
+		"On the days, and on the occasions wherein ye minister the things before me on behalf of the nations, on these occasions ye shall open the opposite door of the inner court— doth this not lead to the court of the women? They may approach as far as the royal cords wherein walk the nations, and they may worship the LORD their God within the inner court where all Israel doth worship. They shall worship before me, and they shall be dressed modestly. They shall not come with makeup or with jewels, nor great rings and plenty. They shall come modestly attired, and with a humble heart.",
+	},
+	// Chapter 50
+	{
+		"THEY who come of Israel, male or female, and they who come from the nations, whether male or female, shall be baptized and anointed by the Great Basin. Upon the steps they shall mount to the top, and by the Great Basin they shall be anointed.",
+		"And this is the baptism wherewith ye shall baptize them: They shall kneel upon the platform, by the brim of the basin, upon the platform ye shall make for to access the height of the Great Basin, and the priests shall dip a large golden bowl into the holy water of the Great Basin, and thou shalt pour it over their heads, for I shall pour my spirit upon the humble heart of him that cometh before the LORD his God at Jerusalem.",
+		"Let it be done in order, without rush or expedience, for ye do administer before the LORD your God, before his holy house. Each in turn shall ye do it, and priests shall keep the basin full so that the chief priests among you shall continue to baptize they who come to the Great Basin to be purified therewith. I am the LORD thy God that watcheth thee, and thou shalt not perform this grudgingly or with an empty heart. This is the law of the baptism of the Great Basin, whereby ye shall baptize Jew or Gentile before the LORD.`",
+		"But the baptism of a convert ye shall immerse. Water shall be taken from the Great Basin and placed in a lesser basin which shall be at eye level in the holy court aside the Great Basin. There ye shall immerse he who cometh unto you, to cleave unto the LORD your God; and he shall remain a Jew all his days. He shall not depart from the ways of the LORD, nor his wife nor his children.",
+		"The lesser basin shall be of aurichalcum, as the Great Basin, supported not by the symbol of the might of the LORD, but by four horses set as steeds facing out at each angle; for he who cometh unto the LORD to be as one born in the land shall be carried upon a mighty steed to declare the ways of the LORD all through his life, in his manner, in his ways, in his voice and appearance.",
+		"If a man or woman seeking to cling unto the LORD God at Jerusalem, to be as one born in the land, shall have a spouse that consenteth not, let there go from amongst those who know him, or those of your company, and let them reason with her or him. If they shall not be moved after several times, then let that spouse still come to me to be baptized with the baptism of the convert, for he cometh to me, and nothing shall prevent him or her. I am the LORD, and all things are mine, and none shall come between me and they that love me to come to me and keep my ways and take upon him my holy name.",
+	},
+	// Chapter 51
+	{
+		"THERE are no gods of the nations, saith the LORD. I have never been formed in stone, nor hath paint found mine image. There shall no one come into my courts with a graven image. Nor shall anyone come to be blessed in the inner court as one that maketh the courses of diverse religions to be blessed. No one shall be blessed of me who supplicateth to krishnim and baalim, and whatsoever idols and gurus they have. The LORD your God is not one among many. I am the LORD, and there is no God beside me. He who cometh to the LORD, cometh to him who IS, and he cometh knowing fully well that there be no God but JHWH.",
+		"Herein shalt thou keep mine inner court holy and pure: on the days that thou shalt admit the nations, thou shalt not permit any graven image before me. It is abomination. No embassage shall come before me of the nations to gawk. Thou shalt not make a spectacle of the blessings of the LORD. But thou shalt minister before me in the blessing and the rituals of they who come to appear before the LORD.",
+		"Thou shalt not receive money of any suppliant; no donation. Gifts shall be given unto the LORD for the keeping of the courts at another place; and it is another matter whatever. The gold of him who thinketh he buyeth of the LORD shall be a curse to him. And the priest that taketh things for his service shall be cast out into the nations.",
+		"For no man keepeth the LORD, and he that bringeth gifts, doth not the LORD also give a portion unto you for your keeping? Therefore ye are kept by me, and ye shall receive nought of payment before the LORD. Take care, lest the LORD see abomination before him.",
+	},
+	// Chapter 52
+	{
+		"AND on the day that ye shall come together to dedicate mine house, so that all Israel casteth its eye to this place to rejoice and to sing, on that day ye shall open two of the great side doors to the inner court; those that are further back from mine house, the doors wherein ye shall cord from time to time so that the nations may enter before the LORD to the Great Basin. Ye shall open them both, as they are opposite each other, and ye shall cord off the inner court in a straight line from those doors so that there is a walk between the cords from door to door.",
+		"After ye have dedicated mine house, and have worshipped and shed your tears of joy, and of sorrow and repentance before me, when and during your songs still coming up to me, at the moment of climax of your service before me, as ye begin your duties before me, ye shall open the doors and allow the nations to pass through, from one side unto the other ye shall let them pass, stop, bow their heads and lift their hearts to me, and pass through before the LORD.",
+		"And ye shall open the hinter gate of the inner court to the women’s court and ye shall let the women to enter and sing and worship before the LORD their God. On that day ye shall do this, and on days if the press is many and the inner court can handle it. But it shall be done peaceably. It shall also be done on especial occasions before the LORD, on the feast days, and days of great rejoicing and press.",
+		"Only the fool saith I covenanted with the nations with not the same covenant. Therefore ye shall let them pass through before me in the inner court. But the rest of this court shall be for Israel to worship, and it shall not be tread by the nations, save within the path that leadeth up to the Great Basin on those occasions wherein the priests shall perform their holy duties. Then a walkway shall be corded from one of the great side doors, and they shall approach the Great Basin. Have I not commanded thee already?",
+		"But Israel shall worship me, both in the inner and outer courts, the soreg; whether by day or night, they shall worship. With a solemn sound shall all nations come before to worship the LORD God.",
+		"The prophets that are prophets indeed may have latitude. If they should come into the house of the LORD, they shall be shod in socks of red, and wearing white tunics of the sort they wear, and their cloak, which is their fashion, shall be around them and upon their head— Is this not their custom before me when they worship? This they shall do, for I have set them apart as my servants, and I speak through the prophets. Therefore they shall have latitude before me, for thou knowest not if I command them. Only in their rough cloak and tunic shall they not enter mine house; but they may enter mine inner court dressed as unto the prophets’ dress before me, for I have set them apart.",
+	},
+	// Chapter 53
+	{
+		"THERE shall no gold or metal wrought, whether precious or blunt, that shall come into mine holy house— for it is an holy place unto the LORD’s name and a dedication to his laws and his ways—a fount of the purity of his way—that hath been wrought already into anything else, whether idol, furniture or object. All gold and metals that shall be used to build the holy house, the courts and their promenades, both inner and outer, shall be virgin from the earth, and no tool shall have struck them before their smelting save those to extract them from the earth. They must not have been fashioned into anything and then molten again: they must be virgin from the earth; this is the ordinance for the precious and blunt metals that shall be used in the house of the LORD, whether inner or outer courts; and likewise for the stone: ye shall use no stone that hath already been hewn by man. The same applieth to wood; it shall all be virgin before the LORD. No idol that is melted down shall have of its molten gold or other metals used in the house of the LORD. This is an holy place to the true God.",
+		"Save only for gems and precious metals. If they belonged to an idol, were its eye or adorned any other part of its false lifeless body, it may be brought before the LORD as a tribute: the eye of an idol crushed and broken it shall be called. It shall be laid before the LORD as booty, and it shall be honourable as prize of conquest over the superstitions of men. It may adorn rooms of the courts that people may gaze upon the gems and glory at what God hath made, ponder at what man hath corrupted its use thereof, and what it hath now become: spoils over the idols.",
+		"So shall be the law of gifts brought unto the LORD. There shall no idol be brought. But if an idol is brought, and it is of goodly stone or metal, let it be received, molten or hewn for the sake of the poor or for other service unto Israel or those upon whom my name is called. It is the dispersion of conquest and booty, and it shall be acceptable after it is hewn or molten. But no idol shall be sold in its form, for they are gods which are not gods, foolish lifeless stone and the incarnation of the ignorance of man.",
+		"Whatsoever gifts are brought before the LORD at his holy house, and shall be received, ye shall accept, and ye shall place where all Israel, and where all who may come to pilgrim themselves in the land or to the house of the LORD, may see and observe. But an idol ye shall not receive, no not even they which are not worshiped and which are of great value. Ye shall not receive them or sell them, nor broker them, for the kohenim serve before me, and the LORD is the only God. Ye are not to have anything to do with idols unless it is the casting down of them, and the taking of the eye of the idol. Ye may conquer idols. Ye may not serve their interests. Any man that serveth an idol of the nations shall not come into mine inner court.",
+	},
+	// Chapter 54
+	{
+		"SEE that ye build mine house and make my courts accessible to all. Ye shall abhor no one who cometh to the LORD to worship, neither because of skin, heritage, rich or poor, maim or beauteous. All shall come unto the LORD. But into the inner court only the nations may come at sundry times, within the royal cords, and there to worship and make peace before the LORD. Ye shall in no wise prevent them, or resist mine oracles, by hook or by loophole; but, see, I shall make your heart soft at your turning, and ye shall know to administer rightly before the LORD. Harden not your hearts so that you abominate not my holy sanctuary.",
+		"Thou shalt give no upper place unto the rich, nor cast back the poor. He that cometh shall worship at a place that is open to worship, whether rich or poor; in the outer courts whether Jew or Gentile. But the inner courts shall be for my natural son, for Isaac. They shall worship, sing and weep before the LORD here; and he who hath joined himself to them, as one of them; he shall be as they.",
+		"There shall be no permanent place for a worshiper; not one for purchase, rent or from custom. All shall be circumspect before the LORD, and shall quit themselves when they are finished, with circumspection. So shall I look favorably on the running of the temple.",
+		"They that come shall worship sincerely. For their sakes I have given them that house, and I do see the heart of them that come and I do not see the pretense. Let flesh look out upon flesh, but the LORD shall see thy heart. Cause them to understand, and let the teachers and the priests cause them to understand, that I flung the world into being, and all things seen and unseen. There is no greater fool before the LORD than he that imagineth that I cannot see the heart and that I only see the gesture.",
+		"Ye shall not abominate with hearts of insincerity before me, saith the LORD, for the visage of my wrath shall be upon this place if mankind should harden its heart and defame mine holy habitation whereupon my name is to be called.",
+	},
+	// Chapter 55
+	{
+		"TAKE care, and guard ye well, in the distribution of judgment, for many nations shall come up to Jerusalem, saying “Let us go up to the house of the LORD at Jerusalem, for we shall receive his justice there.” Let the judge put a knife to his throat, and let all they who come be heard. Let them not be silenced from the matter at hand, nor let the courts be run as if a game and a show, for justice is sought of the LORD your God.",
+		"Let no case be tried by less than 5, and let them give themselves to prayer and humility before the LORD, to render his judgments, for the name of the LORD is upon thee in that place; and many in the nations and in Israel shall say, “Let us go up to be tried and we shall receive justice from God.” Let no one who cometh to judgment render payment, but the judge shall judge from the heart of the LORD, with his words ever before him. Justice is no business before the LORD, nor cometh judgment by luck of the draw. Unto all an even weight: rich or poor, great or small, handsome or homely, Israel or the nations, for I am the God of all flesh, saith the LORD. And this is judgment before the LORD: equity. There shall be no expedience in judgment, no fad or precedence: precept upon precept, case by case, on the merits thereof, by the actions and not the defense of those who come to judgment shall they be judged before the LORD.",
+		"Take heed, O ye kohenim and judges, lest ye corrupt my name in judgment, for the world saith, “We shall go up to the LORD God at Jerusalem.” I shall hold him guilty of the punishment, he who goeth to the left or to the right of my judgment. I shall visit him with punishment in his life, and he shall not escape who tippeth the scales. So is the scale before the LORD: equal and even; he that taketh it shall be taken from that none may profit by deceit and treachery in the land. The LORD your God hath spoken.",
+	},
+	// Chapter 56
+	{
+		"THE royal courts thereof, the royal promenade of the main entrance unto the temple, even at the outer courts before all those who pilgrim and petition shall enter the perimeter of my holy mount, ye shall make deep and columned. Of precious stone ye shall make them, of pleasing colors, variegated, and the capitals thereof shall be covered with precious metal. It shall be a place for cover. In here man may teach and speak of such things pertaining to godliness. Ye shall have courts in the outer courts where ye shall hear cases, and ye shall be holy before the LORD in all your doings, even upon the most outer cloisters.",
+		"There shall no money or trading be done within my holy mount, nor anything done wherein one shall say, “We shall even up outside the house of the LORD.” Ye shall be holy.",
+		"Mine house shall be a refuge for the needy, a place of solemn worship and comfort; a place of edification and rejoicing; a sure rock upon which one may call upon the LORD God, and I shall hear him, the place I have tread.",
+		"He that causeth a tumult ye shall cast out until he repenteth. He shall come in and he shall repent before those before whom he caused the tumult; for mine house is an holy house. If he be a prophet indeed then it is I who cause him to make a tumult. Look then upon your ways and uncover your misdeeds before me. Cleanse yourselves and repent. No prophet shall cause a tumult unless grave abomination is being done or about to be done, and I raise him up to warn you.",
+		"In rooms within the cloisters ye shall have classes and teachers, and they shall cause thee to understand my ways. Ye shall likewise have a place whereupon priests shall sit in the seat of enlightenment to dispense my laws and instructions to whomsoever should come, they who need verification or instruction in my ways and in the equal weight of the LORD. They shall sit upon their seats and they shall instruct all who come, whether of the nations or of Israel. They shall have the books before them. All who come to enquire of the law shall have an answer of instruction.",
+		"The kohenim shall keep the temple always. They shall not be polluted, nor shall they pollute. See, I have set ye to your ways by inheritance. None hath earned of me. Rejoice in your calling, and possess hearts of flesh, so shall ye minister before the Most High day and night unto all who come. This is your lot before me.",
+	},
+	// Chapter 57
+	{
+		"YE shall have no waste upon my mount, nor shall ye make waste and cart it from the mount unto the sewers. Ye shall be clean as so much as it be politic. Ye shall not spit; none who cometh shall have an issue from his body; save only tears shall I accept, the washing of the heart. Places shall be provided within the lodgings of the kohenim for they who fulfill their course there, but ye shall not make stalls and places as a part of mine holy house. Let all take care before coming and when going. Places beyond the outer court shall be provided. Sneezes shall be held by hankies; wounds shall be bound.",
+		"Someone who becometh ill, seized upon a sudden, shall be taken to medicine. Ye shall provide a place wherein they may be taken in emergency. Ye shall have mercy, and it shall be as a river, yea, a flood, upon my holy mountain. He among the kohenim who saith “I am too holy to have mercy or to touch and be touched in mercy” shall be unclean before me three days. I know the weakness of flesh, and mine eye is upon the maimed, the injured, the distressed, and the aged. Ye shall be holy in all my holy mount.",
+		"The priests shall walk the courts, the open places and the cloisters, their ears diligently seeking the distressed, the plaintive and the tearful, and they shall comfort. They shall comfort from the rising of the sun and the setting thereof. In darkness and light, in heat and shadow, shall they perform the mercies of the living God, for my spirit looketh out. It goeth abroad from my holy habitation and scoureth the land, seeing who shall follow the ways of the LORD. Ye stand at my feet, all ye kohenim; be ye holy and circumspect. Ye who are too holy, I shall be too holy for, and ye shall not minister before the LORD JHWH.",
+	},
+	// Chapter 58
+	{
+		"THIS shall be the law of the High Priest. It shall be even as before. This shall be his duty upon the Passover. Upon the Passover, ye shall slay the lamb, and ye shall hearken unto the LORD, unto the laws I gave unto my servant Moses. Ye shall eat of the lamb as I commanded him. Ye shall not dispense with doing this. Ye shall slay the lamb before the people. It is not an offering. It is for your feast. Ye shall make no offering before the LORD, of blood or of flesh. I will not see it. It shall be as manna before the LORD, when your fathers gathered too much. It shall be rot, for ye are a bloody people before me; and if ye make it an offering it shall be as if ye heaped to yourselves an over-abundance, beyond the measure of the LORD; and it shall be a canker unto you. I shall be a canker unto you until ye put the abomination away and make penitence.",
+		"Each Passover thou shalt recall the acts of thy people, and their deeds before the LORD, and when thou slayest the lamb thou shalt rend thy clothes. Thou shalt (the High Priest) wear a red cloak, and he shall rend it at the slaying of the Passover, before all the people he shall rend it in anguish.",
+		"Ye shall eat of the lamb, and on the third day ye shall burn what remaineth, so that nothing is left to rot. This is perpetual before you. And ye shall remember that at Passover thrice the LORD passed ye over: at Egypt; at Golgotha; and even the day I turned ye again, when I came unto your souls. I am the LORD your God.",
+		"And if there shall be a great concourse of prayer and supplications at mine house, and the kohenim shall receive prayers from all who give them, petitions and supplications before the LORD. And they shall pray for all right prayers before the LORD; and they shall humble themselves and wear deep crimson over their tunics, for ye are a bloody people before the LORD; all the kohenim shall wear wool stained a deep crimson on that day before the LORD, about their courses.",
+		"And it shall come to pass in the latter days to come, saith the LORD, that when thou abominatest before me, even with blood and foolish things, things which never came into my mind nor ever did mine heart counsel, I shall send blood unto thee. Thy courts in which thou delightest shall be a mourning. The cloisters that once heard rejoicing shall hear anguish. The open spaces that were thronged by worshipers shall be a place for the laying of cots and for the stretching out of the wounded. Thou shalt minister before the wounded and the battle scarred, and thy feasts will be shrunken and without joy, for I shall bring upon Judaea, even unto Jerusalem, the sword that avengeth.",
+		"It is I the LORD who shall stir up the host of thine enemies, and the train of their armies shall cover the hillsides and encompass Jerusalem. As thou delightedst in the blood of bulls and goats, and in thinking the sound of the slaughter of thy herds should herald my forgiveness, so shall I bring upon thee slaughter and the beast. Away with your bloody hearts! The screech of an animal being slain shall not herald my forgiveness. If it shall expiate the guilt in thine own heart, I declare it a false expiation. Thy guilt remaineth, saith the LORD.",
+		"Then shall mine hand shame thee. By a people that thou hatest shall I restore my holy things in that day. I shall declare unto a nation that thou hatest, Bunyin, Restore, and he restoreth.",
+		"But if thou shouldest put away the pride and the abomination, even in that day that thine enemies are sore upon thee, I shall scatter them, and their standards shall not remain at the gates of Jerusalem. So shall I do. Thou shalt remove the abomination and I shall shame thee at the hands of the nations. And thou shalt know that I am the LORD thy God. What I have done can never be added thereto.",
+		"Why endureth the LORD the froward, the arrogant and the multitude of evil fellows? —only to declare those who shall follow the LORD, and to make them a light of his word, that he might be understood and justified when he bringeth judgment; and also he convicteth the presumptuous mouth— all they shall see what manner of men the evil are. And they shall know justice, and reconfirm it, and equity shall be their sword, when they see the LORD overturn a perverse man and a generation like unto a haughty, spoilt woman.",
+	},
+	// Chapter 59
+	{
+		"AND on the Day of Atonement, when ye shall come to the LORD, the chief priests shall come with the High Priest, the upper courses. They shall walk behind him into the house of the LORD. He shall bear in both arms before him an empty bowl. It shall be a plain bowl, a wooden bowl without adornment or mark, roughly hewn. And he shall set it before the holiest of all, before the curtains royal of the holiest of all, and he and all they in the house of the LORD shall cast their crowns to the ground, upon the golden floor, and they shall bow their hearts and their foreheads to the floor and ye shall declare: “Holy, Holy, Holy, is the LORD God Almighty.” And ye shall pray for forgiveness, and ye shall pray for the people.",
+		"And the curtains shall be drawn open on this day, and fastened, while the priests perform their duty, and they shall be closed upon completion.",
+		"And the High Priest shall rise up, and they with him, leaving their crowns upon the ground, cast aside, and he shall take from one of them a deep crimson cloth, made of wool and dyed a deep crimson. And he shall unfold it and place it in a golden bowl, and he shall there bleach it until the color bleedeth out and staineth the water. And the bleached water shall he pour into the wooden plain bowl, for plain is thy soul and empty and incapable of offering before me. But as he filleth it with the bleached water, so have I filled your souls with mine atonement.",
+		"And if one should shed tears, another shall wipe with a clean cloth, whether it be the High Priest or another. None shall wipe his own tears. Ye shall not let your tears fall to the floor.",
+		"And one of the chief priests shall place upon the High Priest’s hands and upon his unshod feet marks from the dyed water, stigmata, dyed with thick dye from a golden jar, mingled with the dyed water, so that it may be stained upon the palms and the back of his hands, and the same for his feet. And the High Priest shall walk outside the house of the LORD and he shall stand before the people, with palms out, he shall stand upon the steps leading from the house and looking out over the inner court, before the altar and Great Basin, and ye shall all recall your sin. All Israel shall humble themselves and repent of their sins, for I have atoned for them and shall pardon them. He shall call out: “Behold your sins; behold your atonement.” And ye shall consider, and ye shall recall your guilt, and ye shall recall mine atonement.",
+		"Alone of the chief priests shall the High Priest be unshod on that day before me in mine house. He shall not have socks upon his feet. The others shall have socks upon their feet; royal purple it shall be. But the High Priest shall go barefooted within the house of the LORD and without. The others may shod without.",
+		"And he shall take the bleached linen of wool and he shall take it to the altar, and he shall burn it upon the altar; and he shall sprinkle the fire with the crimson water thereof, from the plain wooden bowl, until it is gone. He shall do this before Israel, and ye shall know that I am the LORD.",
+		"Save only the first Passover, when thou performest this for the first time before me; then thou shalt not burn the bleached cloth of wool, but thou shalt fold it before the people, and keep it in a storage vault aside the house of the LORD that ye may look upon it from time to time; for surely its whiteness will fade and its fabric become mehrp.",
+		"And because this is an high day, ye shall open the opposing doors of the inner court, and ye shall cord the area, and ye shall admit the nations to pass through that they too shall see the work which the High Priest shall do.",
+		"Notwithstanding, after he burneth the linen, the High Priest shall mount up upon the walls of the surrounding cloisters so that he overlooketh the soreg and the outer courts, from the utmost heights of the surrounding cloisters of the inner court, at a place prepared. He shall overlook to the south; he shall overlook to the east; and to the north; he shall overlook to the west. He shall come forward and be seen by the multitudes; and he shall raise his hands, palms outward, and give the blessing of the priests to all the people, wherewith I commanded Aaron and his sons to bless. His voice shall be heard, and his hands shall be plainly seen, the stigmata plainly seen. He shall do this east, south and north, and west.",
+		"And this shall be throughout your generations, upon Yom Kippur, as a memorial to the people, and unto the nations, that ye are a bloody people, and I have brought atonement. I am the LORD your God.",
+	},
+	// Chapter 60
+	{
+		"UPON Tabernacles ye shall come as before, to build dwellings, impromptu lean-tos, and recall your wanderings in the desert. Ye shall build your dwellings where ye are able, and ye shall make them as before. Only now shall ye drape over them a blue cloth, made of linen, and it shall insulate your roof. It shall be for all your tabernacles for a perpetual reminder that the LORD your God causeth a covering for you in the desert, a covering from your sin.",
+		"Whether they be of Israel, or of the nations, they that come to build a tabernacle to dwell therein during the feast, they shall cover it with blue. All they who come shall cover it therewith. It shall be a perpetual memorial that I am the LORD your God; that I am the God of all flesh, and my covenant is my covenant with all flesh. Ye shall give no separate place unto the nations and unto Israel. He who cometh, cometh, and he who buildeth, remaineth at that place. Ye shall eat bread and honey, and your feast shall be sweet.",
+		"At the Feast of the Ingathering, ye shall open up the opposing doors of the inner court, and ye shall string off the walk between with the royal cords, and ye shall admit the nations; for so gather I all nations unto me, saith the LORD. And they shall walk between, and I shall have mercy.",
+		"Thou shalt be clean before the LORD Almighty. Thou shalt not eat anything prepared with sodium mixed with another chemical. Thou shalt not preserve thy meat with it, thy fruit or thy vegetables. Thou shalt not cook with it, or use it as seasoning. It is abomination unto the LORD.",
+		"In this fashion do all the nations that I draw you from abominate before me, and cause sore illness upon their own people. Ye shall not do so, for the LORD hath wrought a wondrous thing in his creation, and ye shall be holy.",
+		"There shall no man come to mine inner court bearing upon him a tattoo, save one burned upon him against his will. It shall be removed before he cometh into mine inner court to stand before me.",
+		"See, I have given thee these things, which thou dost not wish to hear, both symbol and meaning, that it may be a blessing and a weight upon thee. But thou shalt bear it. I shall give ye that house again, and it shall be a memorial to your ways and a light unto my ways that ye depart not from my ways again, to abominate before me.",
+		"Behold why I have made such symbols: that at thy corrupting of them, thy heart may be revealed, and the evil known for what it is, made manifest to all mankind.",
+	},
+	// Chapter 61
+	{
+		"LO and behold, I create a new day. I mend the rend between thee and the nations. I heal the injured and I broadcast wheat in the charred soil. A great [61a]sunflower taketh long to bloom, but then it is magnificent. Yet it dieth and decomposeth, and is food for the ground; but from its richness seeds there again springeth up another. Birds may eat some of the seeds and worms the saplings, but the seed of the LORD shall grow a mighty sunflower.",
+		"For they who are in a shadow there shall be no darkness, and they who are of his company dwell under the shadow of his wing. This generation shall surely say we are in the shadow of a time past, of things we thought not, of confusion and convulsion. But they that dwell in the shadow of the LORD’s wing shall dwell in the light of his countenance. Thus shall I preserve my seed, and none of it shall be eaten by birds or devoured by worms.",
+		"For, lo, indeed, seeds hath my sunflower cast to the ground and none have taken root. I shall let the head fall and embed the seed. A seed shall take root under the weight of the head, and it shall spring up and cast off the dead head; and I shall build me up a towering sunflower. Let the times pass as before, for the LORD remaineth. I create Jerusalem anew, and a new heart in my people. And there shall no more be there the peasant preacher, even the Perushim, and their gezeirah and minutae; nor the lackey eager to obey. But they all shall hearken unto the LORD, and their heart’s lust shall burn for me, and I shall care for them, and I shall there love my people again as the fatlings of my flock.",
+		"For, see, I cause peace. I shall tie my sunflower to a stake and fasten it at a cross stake. I shall tend it and it shall not be wild. This shall be its support, and it shall endure. Watch ye the ties, watch ye that they grow not brittle and frayed. Tend ye to my work, O ye in my garden, lest I try my ties and find them frail. For without support, when the head of the sunflower becometh full, it shall yield to its own great weight.",
+	},
+	// Chapter 62
+	{
+		"THE LORD sendeth a message; by his mouth he doth declare his judgments. Restrain thou my soul at the doing, restrain my heart at my passing, lest the dead rise before their appointed time; for the glory of the LORD passeth by; his feet touch the earth and if he should not restrain it should cause Sheol to tear open. Restrain, saith the LORD, restrain my heart lest all the sons of flesh are consumed. The work that I do is glorious, and the peace that I bring eternal.",
+		"Let Sheol tremble, for it is reminded that its day doth come. It languisheth not. Its door trembleth as in a pillar rumbling beneath its cornice in a mighty earthquake.",
+		"The dawn cometh again, and the vision in the cool of the purple horizon. The third day doth dawn and beginneth bold, and the way thereof to the gleaming beams of the LORD. For two days I wounded thee for thy sin. Now I say, Arise from the pit. Shout ye, declaring, Resurrection of our God, the Regeneration! We see again and pat the face of our beloved, and feel flesh again. Even if my shadow should touch a man he healeth, and the dead he riseth. Thy strength is renewed, O thee of my choosing. All ye whose faith is in the LORD, my beloved is justified, his name is magnified among they who heaped curses upon him, they who were once his friends. I stand in the sunburst, all ye inhabitants of the land, and what power can match mine, saith the LORD? They who heard the curses, curse not, and they who delighted in mockery are vanquished. A clean sweep doth the LORD provide; by a mighty wind doth his spirit breathe upon the face of the ground. The dust and the dirt is blown away, by a steady east wind it is blown",
+		"They who heard the curses now bless. They follow the east wind and brush their hand over the foundation. Upon this they shall build their dwelling. But they who cursed are blown, yea, blown far from the foundation. Sand is in their eyes and fret in their voices. The LORD hath silenced the scornful and diverteth their tongues; and their shaking fists are turned into groping palms. Truly, this is of the LORD.",
+	},
+	// Chapter 63
+	{
+		"AND the word of the LORD came unto me, saying: Give ear, my servant, if any of the elders of Israel come before thee to prove thee if thou hast spoken in my name, or to say outright thou hast not spoken in my name, sit before them and say:",
+		"Hard eyes, hard minds, see the ducks walk, see the geese meander, see them lead their chicks on their way. Are they not a sight? Though they lead, doth not they that follow walk even as they? Have ye not walked this way since your own youth? Though ye lead ye are a sight; the ways of habit, a musing and a source of laughter.",
+		"When a man walketh to, do they not even scatter and waddle away, parting before the greater force?",
+		"Even soon do I bring my word to pass, and ye shall part before the storm of the LORD. Give little attention to my words, ye hard-hearted? So shall the nations and peoples give little attention to it for the convulsions that shall be upon them. Each shall fear at his own fence and cast not an eye to Jerusalem.",
+		"Because ye sought the land to take comfort in it, and because the heathen proclaimed to you that land was the jewel of my covenant, hear ye this word from the LORD: I appoint you to 70 years of captivity again, captivity and desolation in your own land, captivity which ye expounders of false laws could not extract [ie. determine] because of your hard hearts, and because ye sought land and not the LORD. Because ye sought a god of your creation I have given you no place to call upon.",
+		"Thus saith the LORD; Thy fence hath kept thee from my laws, but the storm of the LORD shall hurl his mighty dart, and he shall pierce the siyyag, and the balustrade shall cave in at that point; and though it encircleth, all of it shall cave in and bow toward that point.",
+		"The LORD shall not be a carnival to the nations, nor shall his mercy be made market for the comment of fools. But the LORD shall be spoken of with awe, and when his word cometh to pass he shall be praised, for better is the residue than the sauce, saith the LORD.",
+	},
+	// Chapter 64
+	{
+		"COME to reason, saith the LORD. O house of Israel, Come gather your wits, and consider the LORD your God, that he is a mighty shield and an everlasting hero. Consider what hath transpired unto you for so long. If the LORD bring upon you sore travail, a scattering, and a chopfallen countenance, if I can bring upon you evil cannot I bring upon you all the good and blessings that I swear to bring upon you? Just repent, turn from your hard hearts, and believe in the LORD.",
+		"If ye will not, but if ye remain stubborn, and pride yourselves in your stiff necks, behold, I warn unto you of the fox’s coming. Surely, he cometh to your tender vines. Doth the fox live within a vineyard or outside of the vineyard?— in the unkempt lands, in the unfurrowed lands the fox doth make his home. It is a brutish place where man stumbleth and where no foot is sure but the fox’s. The men of those places are brutish, and their minds not cunning. Their counsel is from stocks, and they seek counsel of vain statutes. They make themselves gods in order to sanctify the counsel of their own conceit.",
+		"Forasmuch as ye have sought the counsel of the American, ye have let down the fence and nurtured the fox. Hearken, Israel, if the land be the eye, is Israel not blind without the apple? Nevertheless, O ye sons of Aaron, ye minister only at the urging of the nations. Ye build and cultivate and cause to return fallow the desolate heritages which I made fertile; and no man counseleth the LORD’s part. America hath urged thee, O Israel, but mine hand hath forebearn that I should not let it be built. Why is this so?",
+		"Even because the behest of the foolish is vanity, foolishness of a people consumed with their own imaginations. Your sins and hard hearts remain. Therefore fear constricteth your heart; it constricteth your borders. Your tent is howled upon and fluttered by mighty winds; and ye seek the counsel of a merchant’s passing caravan. Yet again, ye will not seek the counsel of the LORD.",
+		"The bullock and the horse shall tread in blood— the bull behind the plow and the horse before the cart. Ye shall sow and reap, and no grain shall be clean of blood. Ye shall thresh with weapons and gather with coffins. Languish and lament shall stir your bards’ tongues. The banker shall exchange with blood; and the builder and the stone mason shall hew with implements and fend themselves with them as weapons, until ye vomit the land from your souls because of its abhorrence; because blood and iniquity hath become abomination before you; and ye can feign tolerance no more, even because you have become detestable by reason of your unbelief.",
+		"Until ye acknowledge again the LORD your Redeemer shall this come betimes upon you; and the LORD shall take you away from the land wherein ye dwell. Are there not many, many more sons of Isaac in the nations that I can bring? For your land was neither womb nor breast. Anguish was the womb that brought you forth to your land, and despair your pap. The German was your midwife; America your wet-nurse. What are they before the LORD of hosts?— even the instruments of a dreadful God.",
+		"For all mine hand hath made this: sowing and harvest, springtime and summer, flood and winter. I declared with laws the bounds of righteousness, and I spoke by faith the ordinances of holy things; and man corrupteth the way thereof. From start to finish, seed time and threshing, man’s desires are natural and primitive. There is none who hath declared righteousness before me; there is none who executeth judgment. After the similitude of beasts and man made he his gods; out of the vanity of his mind he hath broken my precepts. I am the LORD. I cannot be formed in stone, nor can man form me in his mind.",
+		"There are no bounds placed upon the limit of man’s presumptions aside from my law, and there is no wisdom that proceedeth except from faith in me. Each corrupteth his neighbour. The land is soiled with blood; it poureth out from the mind. It defileth the land. And why doth each man slip thereon?— even because his eyes burn with mischief against his fellow. He counseleth toleration that he may lay in wait for blood with him who delighteth in it. Judgment is brought hastily for nought, and for things of weight there is no counsel but wait, that ye can upset the measure, making the pim great and the talent small, the shekel justice, and the poor a prize. The orphan and the widow are considered for a write-off, and their deliverance is expedience.",
+		"Come, saith the LORD, though your sins be a river crimson, I shall make them fragrant oil, clear and distilled. Though your hearts be hard, I shall make them soft and pliable, and your mind of understanding. Come, enquire of the LORD. Come, trust in me, saith the LORD, for no disaster cometh nigh unto me, and I shall not have fickle attention and passing feelings for thee, O Israel.",
+		"O American that seeketh flesh, Thou seekest such wealth as that fadeth, and treasures that are of so much dung. Wherefore braggest thou, O Chaldean, over the Hebrew? Am I not the LORD that made them both, and that divided the Hebrew from thy river? Wherefore braggest thou Japheth over Shem? whose flesh is the same. Incline not unto the American, nor entreaty him with a wink that magnifieth thy flesh.",
+		"The LORD is thy boast, O Judaea, and he is the name that setteth thy forehead apart and maketh it holy. A fire driveth away the fox, but today’s sour grape protecteth the vine for another season’s sweet harvest. Stay ye within the vineyard, for the LORD’s foot is surer than the fox, and his cunning is better than a lion and his grip unrelenting like a badger.",
+		"Hearken attentively, for this is of the LORD: Thus saith the LORD, So do I make thy harvest a bitter one, and thy grape fit for the spitting out of it. Though I lift up thy skirt and offend America with thy nakedness, yet shall it be only for a season.",
+		"But I lift up the skirt of America and until the end I shall make them a proverb unto all nations. For I lift up their skirt to thee now, that thou mayest know what kind of lovers thy baseness hath taken to thee.",
+		"The nations do not consider, America doth not lay it to heart concerning my word. They shall say in that day “Israel is not worthy.” But the LORD shall answer them with a swift voice: Then it is not mercy. Hear now and pay heed, O giddy nation: Thou art wicked and yet I have not sent thy feet quickly to the dust. Thou art not worthy, yet thou hast tasted long of my mercy. Doth not the keeper of the king’s forest have mercy upon the tender sapling? Yet when the great oak is mighty but diseased and rotten, doth he not anon cut it down to make light for the sapling to grow? How much quicker shall it be done when the king himself seeth the forest and declareth and commandeth straightway that it should be so ordered? Behold, I cut down a mighty oak so that a tender sapling can grow.",
+		"In times of trouble shalt thou build, O Jerusalem, and thy array of battle a swaddling cloth. Thine enemies shall snarl, and yet shall thy labourers not fear their enemies, for I have enlarged thine house, and I have restored Edom as a bulwark, and I have replaced Moab as gates are replaced and as moats are redug; and they shall know I am with them.",
+		"Hear ye the word of the LORD, O seed of Jacob: Thus saith the LORD your God, I have brought me down many a conqueror, and many an empire have I buried in the dust. Have I done this for my amusement, saith the LORD? Or did not the wickedness and pride of those nations come before me?",
+		"Therefore thus saith the LORD, I pull the lobe and speak loudly: See how I disturb thee. Hearken, give attention and prepare for the day. America shall not deliver you, but I shall deliver them to convulsion, and they shall run when no one is chasing them. At a cough they shall be dissolusion. Out of one I shall make many, and the time of their passing they shall not see or consider.",
+		"Their cities are as heaps of fall leaves, of passing decay that rotteth, should it be left long if it heapeth. They are the gathering of bright colours; but they are brittle, and water only causeth them to rot. There is no regeneration for a leaf cast from the branch. Fire and wind are the only solution; so shall I burn some in heaps, what man hath heapt; and so shall I scatter some that a congregation of them rot not the soil at the time of rain, and the watering of the labourer.",
+		"Howl ye for the fine buildings; they shall come down slowly at their own hand, and with a yawn shall she depart from among nations, from being Queen of nations; for I have heard the cry of the black man and the red man wherewith they have cried unto me.",
+		"So shall I do unto that mighty people, for their skirt is a filthy one, and their houses of prayer whitewashed pens, and the land is soiled with bloodguiltness. I shall give their lands quietly to others that they loath. As they openly cheated, so shall those that come after subtly steal. For merchants rule over them and they think my blessings are in wealth and usury.",
+		"Woe unto that nation, for they kill and eat their children to sustain their old age. Their babies have no graves, but their cry cometh up unto me.",
+		"As they bled their womb, so shall I bleed their heritage, and unto a mightier people will I give their land.",
+		"They have removed life for convenience; I shall remove the convenience of life. I send them the bug. See a wonder: by a speck the Earth is destroyed; against the multitude of tininess can no army fight. The LORD hath spoken.",
+	},
+	// Chapter 65
+	{
+		"THE burden of America. Speak unto that nation, unto America, speak woes, prepare thou to take up lamentations, for great doth she fancy herself amongst the nations. Yet I know ye are clay and iron, and money is in what you trust.",
+		"O thou mighty land of the eagle, I shall make thee a land of the seagull, a place of nesting and thy shores as a mother’s apron unto thee, thou wilt not travel far from; thy wings clipped and not able to endure the flights of the ocean. The cormorant shall vex thee. The crane shall step on thine eggs. As none delighteth in the squawk of thy beak, so shall none care to stand under the path of thy flight. Thou makest noise and castest thou a shadow, but thou art no bird of prey; and the longer thou circlest the more they underneath feel thy waste, and sigh again at the cost of thine indecision.",
+		"Whom shalt thou deliver, and who trusteth in thee anymore? Strip Israel of its armor, lay out her soldiers naked in the sun, yet shall I, even I, saith the LORD, smite their enemies at the day of their turning.",
+		"And it shall come to pass in that day, saith the LORD, that there shall be a howling at your shores and weepings upon your mountains; thy sorrows as the flow of thy great river Mississippi. The majesty of thy mountains shall be sackcloth, ashes the mantel of thy prairies.",
+		"Forasmuch as thou sayest I bless thee because of thy wealth, so shall I make it plain that I curse thee, for thou makest merchandise of justice, and at the call for swift punishment thou brokerest law. Thou makest them bankrupt who defend themselves, and he who hath no money hath no advocate. Thou declarest the process and not the outcome to be justice. For a thing of nought thou lettest men be accused, and findest them guilty of suspicion because of the demands of the prudery of gainsayers and gainseekers. Seedy grandeur motivateth the arrogant, and for money thou wilt mull over a word to bring the innocent to book. The feet of thy constables are set to earn thee money, and the innocent and meek are ravished at the same time.",
+		"Behold, I have seen it, and I will repay according to thy measure; according to the smirk of thy hauteur. Thou art without knowledge. Canst thou elect it, O ignorant nation? Thou desirest only auctioneers to rule thee, and the oratory of the tradesman is eloquence unto thee. They stand at thy gates, thy beautiful gates wherein entereth the wealth of Cathay, the fineries of Europe, West Indies goods, the weavings of Britain, the carvings of the Latins, and the gadgets of Japan. Hear them bark: “Goods for all! Burn incense to enterprise! Is she not clever! Fineries, silks, gold, oil, yea, rum and sugar of the islands, the tool and the craftsmen… and flesh for the lording over by success— is not God with us?”",
+		"Therefore, saith the LORD, I shall send the piper, and he shall entrance thee from thy pulpit and lead thee from thy gates with a merry jig. Thou art foolish, O America. Thou shalt play them a tune and make them to follow thee. But thou knowest not where thou goest. The servant shall sit on the horse and play the pipe, and thy leaders shall hold the tail and dance.",
+		"Everything ye call a conspiracy I will make a conspiracy unto you. Everyone’s home shall ye make a fortress and your neighbour shall be your tyrant. The world shall pick your wealth. For when ye try and defend yourselves, even your leaders shall pull at the horse’s tail and upset your defense.",
+		"Thou hast made thy walls strong and thy gates a glittering with jewels, ears as unto pomegranates, eyes as unto rubies, and hearts as unto sapphires, cold and blue. Yet thy gates through which thou makest merchandise of the world shall be desolate. No man can bring them down. Hast thou not even magnified them unto God? Nevertheless, thou shalt return from thy jig, and there weep at the decay; in the cleft of thine elbow deep weeping; arms shall hide thine eyes, hide them from the desolation because the LORD caused them to decay; and the weeds thereupon shall embolden the predator and the small nation.",
+		"How thou magnifiest thy law! Yet it is not as old even as a new family, and thine orchards have not been planted long enough to yield their first clean fruit. What say ye of Precedence? —shall it make bribery not bribery; and extortion, is it any different? How the complacent braggeth! How the wine vat bubbleth with languish! What is left after one purgeth the winepress of the pulp? Bitterness is left, as a heart that is sewn with salt. No more can a good thing grow, or a tender shoot reach for the sun.",
+		"So is thy harvest, O foolish nation. I shall purge thy winepresses and lay bare thy vineyards. Long shall thy presses dry before a new sprig reacheth for the sun and a new vintner maketh wine in them from a new harvest.",
+		"Why seek ye such a companion, O my people, and why do ye rest assured in a people afar off? Their feet are quick, but their heel is weak.",
+		"Why do ye walk the wayside with such a companion when I plainly set before you the end of his ways? He who maketh all the ways of justice, chancery; and the law becometh dubious and dispute, that he might give weight to his spleen and its whims— even he is an abomination to my soul. Even as such shall America persecute thy seed that sojourneth. With loophole shall she overlook their oppression. Without leaders shall each town throw stones at thee.",
+		"Hear a gentle sound from afar? A subtle purr from America. They make bold their purr; yet now at the rebuke of the LORD their conceit only causeth them to mull. In any consideration they give themselves the best, and wisdom is tortured in the marketplace for the merriment of fools. They offer no introspection, and no man doeth anything but vent. The moment passeth, the evening falleth, a gentle purr resumeth, and barely doth a belch recall the day’s excitement.",
+		"Swiftly came entropy upon France, likewise upon Russia; so too upon China— the servant sat upon the horse and the princes walked on foot, the thing which I hate in mine heart, saith the LORD. Though swiftly it came upon them, slowly shall it come upon you. As a creeping fog in the night shall it come, and ye shall not be delivered after three generations except by division.",
+		"Thou art a wondrous flower, O America. Thy roots spread far, and it taketh much nourishment to maintain the beauty of thy petals. But when the ground is all roots, wherewith shall it be nourished? The great flower dieth and becometh the nourishment for seeds dropped by birds. The flowers that spring forth shall grow from thee. Thy flesh shall sustain five flowers and then they shall cast their seed and there shall be a garden.",
+		"The LORD hath sworn in his wrath. He shall not relent. Thou thinkest thyself so new, so original; but doth he not see the same sons of flesh as thy fathers? As he waited upon the times of the Amorites, as he surveyed Sodom, as he endured Egypt, as he judged Rome, as he humbled the Ottomans, so hath he seen thy ways, O foolish nation.",
+		"From beginning to end they have come before him. He lifted up thy skirt, but thou only saidst “I am now blind because of my lifted skirt and see not my nakedness.” Thou hast no knowledge, and thinkest that thou canst fool the Almighty?",
+		"O people of glass! Thou knewest thy nakedness was revealed, thy shame seen of the world. Thou hast fooled no one but thyself. As thou wilt not hearken to thine ears so that thine eyes may be opened, so thou canst not fathom thy end hath come before me, saith the LORD.",
+		"Why art thou also so blind, O Israel? —because thou trustest not in me, proclaimeth the LORD. What victories hast thou won? How hath America removed thine enemies from thy borders all these years? They make pretty their speech, but even the nations wince at its recital.",
+		"Thou hast lost land; thou hast lost faith. Thou waitest like a fat child to be served of the nations, and they have only robbed thee, and now they hate thee. The same that delivereth thee from bondage art thou then in bondage to.",
+		"When they had power they did not hearken unto my spirit, but burnt Japan with fire, which thing I commanded them not. I gave their secrets to others, and in fear did I give that generation life. Fear turned to foolishness, and foolishness turned to lies. For one tooth they took ten, for the lives of 1000 took they 100,000.",
+		"Behold, saith the LORD, weepings and howlings unto thee. I declare not joy. I repay according to thy ways. Oh the howl of the great city upon the river River and the bareness of thy columns, for that day shall come, and thou shalt not punish the Ishmaelite.",
+	},
+	// Chapter 66
+	{
+		"AND the word of the LORD came unto me, saying, The LORD will not relent upon the matter, even upon his issue with Judaea, for he hath an issue with the land, even Judah and Jerusalem, Samaria and Galilee, and the coasts of Gaza and Lebanon. He hath made it plain and engravened it upon their idols by night, that the dawn may reveal the LORD’s sacrilege of your dumb idols. The indignant brow he will break, and he will declare his mockery by the power of his doings. He hath an issue with the West Bank, with the East and with the North and South. Jerusalem is in desolation and no man layeth it to heart. There is bloodguiltness, and no man considereth.",
+		"Ye turn aside your faces from the merchandise of Gog, and the affliction of the maidens of Magog, in that they are caused to be made harlots. Therefore I have turned my face away from thine indenturing to America, and thou shalt be indentured for a season as the harlots of Magog, the merchandise of Gog and Damascus. But thy bed shall not cause thee to earn thy redemption. I, the LORD, shall redeem thee. I shall redeem thee from the indenture wherewith the maidens of Magog have been indentured, for thou lovest thy whoredoms and seekest not to be free of them. I shall smite Damascus in battle, for it is the head of a serpent that doth coil through aTyre and Zidon, bBerytus, and unto cAleppo. Its tongue dangleth out, and the venom on its tip is dAmman.",
+		"(The oracle of aRiyadh) Behold, doth Israel not know that the gopher is deadly in battle? unsetting the warhorse in its charge. Behold then ye fine men of Arabia and their embroidered trim of golden thread and their flowing linen of the finest cotton of the East. No covenant with Riyadh shall stand, for the LORD overturneth the counsel of sly men, and bringeth to nought the pacts of those whose purpose is underhanded. Fear not Arabia or her handsome princes clad in flowing garments, for when Damascus calleth for the light of day, in the day of battle, the House of Saud shall not bear it, as a burrowing creature cannot bear the light of day. So shall the LORD God overturn the House of Saud and all the princes thereof, and bring to nought the counsel of the beautiful men of the east and the priests of Mecca.",
+		"The word of the LORD came unto me, saying, Speak unto Israel, saying: Thou shalt not abhor the Egyptians, for I shall break the rod of Ishmael through them, and as cracks in a dam shall Islam break from Egypt, and the cracks shall spread unto Turkey. They shall rejoice in what they had before, and shall say unto the nations: Are we not a crown of the nations? Is not Greece our stepchild and Rome our admirer?",
+		"Shall the West brag over us? Is the West not our stepchild? We shall go to the West, to our stepchild. The desert shall teach us nothing, but we shall encroach upon the desert. Alexandria was mother and Constantinople our nanny.",
+		"Thou shalt also not abhor the Kurd, for they were thy kinsmen, and I brought them out of the Chaldees at the same time as thy fathers.",
+		"The oracle of Tel Aviv. She is new but languisheth. She is new and she magnifieth herself against Jerusalem. Thou shalt be brought down, thou pup, and the ways thou hast made look new shall be revealed as decrepit. It shall come to pass in the days that the LORD’s mount shall be magnified, when my people dwell safely; even in that day thou shalt be a valley and thy ways a stagnant bog that goeth no place and corrupteth the ground upon which it sitteth.",
+		"(The oracle of Iran) O thou land of the Aryan that treadeth on Media’s heel; thou despoiler of Persia and scorner of aFarsi, because thou enquirest of the watchmen when thou thyself seest, thou shalt go no where. To the dust, to the dust, go thy ways. Be thou sand; thy ways peeling stone.",
+		"And the word of the LORD came unto me, saying: When Israel doth not hearken unto my words for a season, for even now do their enemies encompass them and seek the land, speak unto them, saying: I have wasted Israel. I have punished them with sore reproach and with a heavy hand all these years; why will ye hearken not unto my words?",
+		"Ah, can it be that even I the LORD have forgotten? Thou hast made me; yea, that is the truth of it. I have heard thy philosophers and thy wise men debate it. Have them declare my goings forth unto you, if it be that they know me. Let them even now speak of what is at the threshold, for my words are nigh. Run to them during the day of my wrath, if thou canst find them. Ye foolish hypocrites, look ye now at your enemies encompassing you. When the defense bell is rung for you to rush into the walled cities shall ye cry unto me and proclaim “Great is the LORD for smiting Israel” or “I am a man blessed that the sword falleth upon me!”",
+		"Shout that at the nations and see if they mock you. If ye had my spirit, ye would be abhorred of your ways, and cry, “Just is the LORD for smiting me.” I have spoken, O Israel, and my words are sound and my words are life. They are honey to an empty belly, and they gush forth the ways of life and are water in a dry place.",
+		"Behold, how I desire to speak peaceably. Hear ye praise in Sinai, and rejoicings in Hebron. The wilderness of Sin is an habitation; the place of sojourn a city. The LORD hath enlarged the house of Israel. Negev and Zin are irrigated. He maketh a desert to bloom and the bob to plumb many houses. Shiloh, ring Shiloh, the LORD of hosts setteth a table in the desert. He gathereth armies to dwell in far lands.",
+		"Come, children of they who drank at the rock, acknowledge thy guilt. Turn from thy sins. Consider now thy lot, for thou art encompassed by thine enemies and they seek thy life, thy land and all that which is thine. I have spoken, O Israel, but thou wilt not incline thine ear. Jerusalem dimmeth its light. Judah closeth the curtain; and Ephraim boasteth from afar with false faith. Is it more painful for thee to turn than it is to endure the wrath of God?",
+		"Awake! Let thy reason return. Riyadh grinneth as a fat cat behind his shomahg. He careth not for the Palestinian. He seeth a plump canary. Damascus thinketh of battle, and Amman by reason of gain again longeth for thee, and Cairo forever waiteth as a maiden to greet them as one greeteth the merchants of Bosrah.",
+		"Thus saith the LORD, this shall not be. They are beasts about thee, and as a man leadeth beasts to water so shall they come at my beckoning, but not drink. Their counsel will not stand, nor their conspiracy triumph.",
+		"But hear ye this word, saith the LORD, this Israel hath taken upon her the ways of a shrew; and a shrew caught by her neighbours with her lovers, to whom must she turn for help? She durst not call her husband. She playeth the whore, and enticeth by treaty and by pledge to deliver her. Thou dost indeed speak softly into the ear of America to deliver thee from thine enemies.",
+		"Though I chastise her, it is to correct her and not to destroy her. I the LORD declare unto you before it shall happen. Send your armies back to the sun, ye of Arabia, hung shoulders and with sorrow on their faces. Damascus rebuild and fill thy time with contemplation. Riyadh, see a princeling become a king; a crown to come forth from Medina. Baghdad cast off thy brutish heads. I have declared: Israel is my chosen, saith the LORD. See Amman repent and Egypt seek peace, and make ye peace. Come and worship all ye nations. Grind your weapons into medicine, and your pride, transform it into humility. I declare unto you before it happeneth that ye may seek me and know that I have spoken and that there is no God but me, saith the LORD.",
+		"And thou, O shrewish Israel, brag not, for thou didst bring the American upon thee not as a wife but as an harlot, and they shall despise thee for this after a time. Thou spokest softly into their ears. Thou wast sure in thine imagination that they should deliver thee, and thou luredst them to go up after and against thine enemies.",
+		"Yet I shall confound them before thine enemies. They shall ravage the serpent’s head but be driven home by a flea. Thy pledge shall be broken, thy wealth no longer barter.",
+		"O America, repent of thy bitterness, for thou canst close thy loopholes. I shall not hold thou guiltless in that day when thou lookest the other way.",
+		"Turn unto me, O Israel, and I will fatten thy soul. Thou shalt swoon in the ways of my spirit and thou shalt delight to love thy brethren. Go out from thy flesh unto all mankind and cleanse the land of thy sins. Have mercy and declare thou justice, and I shall make thee to delight in the land. Thy belly shall be full of the new wine and thy palate shall feast upon the cream and honey, and thou shalt forever dwell with the LORD.",
+	},
+	// Chapter 67
+	{
+		"SHALL ye chide with the LORD, O house of Israel? Shall you become like the Jews of Jeroboam in his sight? they who became indistinguishable from Canaanites; and in such foolishness ye have spoken like unto the Canaanites of whose ways ye have become enamored again: “He is a phobic for not leaving me to my ways. A phobic! A phobic! He declareth there is wrong! A phobic!” Rather, turn ye one and all from his sins. Be not as they who say “There is no good or evil.” He that believeth in no good or evil shall be reserved for destruction; and a season that will not turn such as he shall be as a body that fighteth not cancer. He shall destroy the whole body; and the LORD shall consign your time to the fire. Behold, such a time the LORD now putteth before you. Shall ye choose to do right before the LORD and correct all your doings, or shall ye say: “There is no right. The nations have spoken truth; Wine is truth. It maketh one merry. We shall drink of the wine of the nations. What is calamity if we care not that it cometh?”",
+		"Sober yourselves early, saith the LORD, for such a numbing will the LORD remove from you; and there is no fear like sudden fear. It sobereth the soul when it is too late. Instead of the fear of the LORD, ye shall have sudden terror because ye walked in the ways of the nations.",
+		"Because he breathed his soul into Adam that he should live was he wroth with your fathers more than because of their vulgar sins. For ye know from whence cometh the fountain of your souls; that it is the LORD. Yet moreover he did give you his words, and he did declare them before the entire congregation his laws, that ye should know life and death, good and evil, left and right; that ye should live. Ye knew to love the LORD your God with all your heart, but ye would not. Therefore, O ye sons of Israel, are your sins grievous because ye reject the LORD your God and would not believe and declare his ways to the nations that they may repent. His own power brought salvation unto the nations, and no man shall make levity of the ways of the LORD again and thereupon not reap destruction. The LORD shall not let the canker grow until it destroyeth the whole body.",
+		"What think ye shall be your fate if ye now moreover become as the nations in their sins and the heathen in their backsliding?",
+		"Thus saith the LORD, he who inhabiteth eternity and changeth not: In days past I smote thee and scattered thee amongst the nations. I gave thee up to foolishness and the preaching of minutiae, minhag and gezeirah . I gave thee over to obstinacy. Instead of repenting, thou heapedst to thyself teachers justifying thy ways, and saying: “It is a blessing from the LORD to be persecuted.” Who can turn such as these? See what a heavy hand I had upon thy fathers because of their unbelief. But they went not after the lewdness of the nations, the immorality and decadence of barbarians in which ye now trespass, even in detestable things: homosexuality, adultery, extortion, uneven weights, conceit and strife, piercings and tattoos, thievery and every abominable state of the heart. Now ye have gone to the right and ye have gone to the left, but no man courseth true. What more are ye worthy of than they, and why should it not come upon you?",
+	},
+	// Chapter 68
+	{
+		"GO, I have thought it, my Spirit hath conceived it, my hand is now even forming the time: Make thou to know and make thou to understand, give them ears to hear and a soul that seeketh the deep things of God; for they enquire, and I shall not harden my heart against them as at the former time.",
+		"Give unto yourselves a span of rest, from Passover unto Pentecost, even for these 50 days give yourselves over to prayer and fasting. In the year of the 70th hebdomad of the Gentiles sustain yourselves with one another for these 50 days. Let him who worketh abstain, and let your congregations and those of substance amongst you lend support for those 50 days, that all Israel may abstain from their routines and incline their hearts unto the LORD their God, with deep prayer and fastings.",
+		"Give ear unto the sum of thy ways, O Israel, and consider if there hath been a time like unto this before? Have the words of thine elders come to pass or explained unto thee all that thine heart hath perplexed over, over all that thou hast kept in thine heart, and what hath happened unto thee and thy fathers, lo, these bottomless years of thy second captivity?",
+		"Then give ear, and incline your hearts unto the LORD, the God of Israel, and soften your hearts before me that I may pass amongst you in those 50 days and not harden my heart against your stiff necks and your foolish minds.",
+		"Let the dullness be removed from your minds, and let your foolishness flee from you as day vanquisheth night. In the 70th hebdomad, in the final of the hebdomad, let the veil be lifted.",
+		"Thou shalt measure the sum of thy ways before thee, and thou shalt hearken unto my servants the prophets of old. Thou shalt read them by books, and thou shalt above all bow thyself to the ground in prayer. Thou shalt pray unto the LORD thy God that he enlighten thee, that he will place thy sin before thy face that thou be no more deceived with thyself, and thou shalt hearken unto his words at last.",
+		"Whether thou be in the synagogue or outside the camp; whether thou hast taken a wife of the nations, or an husband of the nations, thou shalt leave off thy work, and thou shalt give thyself to pray and fasting before the LORD. Thou shalt meditate upon his ways, and thou shalt ask of him, “Are these things so?” Be thou humble, for the LORD thy God is a mighty God, and there is none before him.",
+		"If thou hast children by thy wedlock with the nations, thou shalt bring them with thee. If thou hast children by whoredoms with the nations, thou shalt bring them with thee. I do seek out all the sons of Isaac, and each shall stand on his own. This shall apply to all those born of any Jew, for I do call back my people, the seed of Isaac. Let him who is an Hebrew not call himself not a Jew.",
+		"Trust not in the vanity of thy ways, nor in those that teach thee that thou canst make of the LORD thy God what thou wilt. Nor take advantage of the span, to revel and cast aside thy sincere heart and make it a time of play and holiday. For, see, I speak clearly unto thee. Can the world bear it? Can the world withstand the glory of a sudden thing? Then understand, the LORD thy God causeth a wonder: slowly, slowly, day by day, Jew by Jew. The dawn shall rise and as a scattered army shall Israel trickle back, waves here, streams there, over the hills, with their shields, with their young, with their things, and with their households, so shall they come back from their desert journey to the dawn light of the LORD their God over the peak of the mountains.",
+		"I set in Zion a lodestone. And it shall draw my people, metal from amongst metal, wrought and pure, for I have wrought me my name, and for this sake I shall call ye back, lest the nations say of all that hath been “It was not of the LORD.”",
+		"See the desert in the dawn light. See the things move about: a line here, a group there, babes upon the shoulder. Like ants see them slowly come. See them rise up the incline, see them mount toward the top, over the peak to a new dawn. See them come home unto the LORD, the God of Israel.",
+		"Forsake your desert journey, my people. Neither ship nor horse, neither thing of the air or under the sea, shall bring you back unto me. Ye shall each one come of a way you have not known; and that way is I, saith the Lord.",
+	},
+	// Chapter 69
+	{
+		"SEND forth a message to Edom: Prepare ye to be reborn. Relay it to your kindred, Moab: Prepare to be built up. The walls bow out from Jordan to your borders, O Ammon. Israel swelleth with the early harvest. For I the LORD give ye each one this word as comfort, for a time appointed hath come, and a longing is satisfied. Jericho is an open gate; Jabbock doth flood from Jordan’s trickle. Dan is secure. Rueben is restored. Timnah’s skirt is enlarged. Hear ye a sound in Anathoth; her fallen stones stir. Succoth beyond Jabesh-gilead bloometh. Pelusium open thy gates, for Beersheba’s house expandeth. Mount Carmel rejoiceth with Tabor; Gilboa and Ebal look off to embrace yonder Hermon and even the mount of Lebanon. Tell ye all in Zoan, I cause to flourish the desolate heritage. O thou whole Phoenicia, cast ye off Islam’s yoke. Amman, thou shalt not afflict the children of Israel. My spirit cometh unto Israel. It rebuketh the cynic. It courseth in the hearts of men. Lay it in store against the day of their turning that it might enliven them:",
+		"I shall bring low the walls of thy cities, O Zion, and all thy far away outposts; all thine encampments shall have their walls leveled. I shall bring low the mountains first, that all they which are outside the camp may see the sunrise first. The babes shall speak. Prepare ye to nurture the little ones. Whithersoever thou dwellest prepare for the babes to knock on thy door. Marshal the troops. Bring down the walls. Let the sunshine shine on those within. Awaken them that sleep, ye rulers. The army of Judah hath arrived! We are made like unto the wicked of Israel, we who were princes are sent back to our fathers’ plows and our women are barren! Babes come upon the shoulders of them who are not their fathers. They all sing out “Our God reigneth!” An hundred generations cometh to the dust and in our place there are sucklings.",
+		"Mount up over the rubble of their walls, my children. Inhabit their cities. Come back, Jacob. The LORD hath done a good work. Thy swaddling cry stirreth my bowels with passion. The final battle hath passed.",
+		"Lo, here I am, saith the LORD. Call upon me again, O daughter of Zion. I am not far from thee, and my salvation is my breath upon thee. It shall cause thee to stir and to awake. Thy sleep hath been as unto an hibernation.",
+	},
+	// Chapter 70
+	{
+		"ARISE, arise, shake off the dust of battle, the dust of thy humility, the ashes of thy disgrace. Behold thine enemy fleeth across thy borders. His feet are intertwined and he tumbleth hence. Thine enemies are cast beyond the river and I give them into the hands of Edom and Moab and Ammon. Under the rubble of thy walls is buried an hundred generations of ignorance, and it shall be buried forever. I have declared unto thee beforehand that this day shall come, saith the LORD. Awake, it is time to awake! It is time for the healing of thy wounds. I shall no more wound thee, my beloved. Let joy form thy features. Let it cause thee to chuckle in awe of a wondrous moment.",
+		"For the day of understanding hath come, and the cloud of battle hath lifted to reveal folded hands upon knees. The LORD seeth thee now, and he will not harden his heart. Lift up thy head from thy humility, and rejoice! Wash and be thou clean. Put on thy robes of white, sing and rejoice. Feel life in thy veins, and bless the name of the LORD. Behold the repentant are as the river rocks, smooth and polished and without number. Thy people repent, my Lord, and this moment is for them. Thou hast taken them from the dust of battle and set them aright.",
+		"As a river thy spirit breaketh forth from the breach and sweepeth over Zion. The hard and jagged rocks are made cool and smooth. The tree branches stand up, the grass groweth again, and the fruit loseth its bitterness. Our harvest is at last sweet. We no longer walk upon the hot and jagged rocks. Our wounds are unbound and the scar fadeth, each and every one. If a man should let sunlight on it, it fadeth. If he should wash it in the river, it melteth away.",
+		"Behold, our God hath come unto us. Zion is blessed by the breath of his mouth. Thou awakenest us from our deep slumber with a jolt, but quickly thou embracest us in thy tender arms. Salvation! Shout it abroad! Salvation of our God hath come! I have seen thee turn Israel. I know thy word is good and it shall come to pass. Thou hast healed us and, behold, the nations come to gawk. Let them come, saith the LORD, and let them seek the healing wherewith I have healed thee, O beloved Zion. Let them join the multitude and walk in his steps and see my wonders again.",
+		"Indeed, a wonder of wonders, O my God, thou hast done a wondrous thing. With mine eyes I see thy salvation, that goodly structure, more brilliant than the blue of the summer sky, more soothing than the pink of twilight. Blessed are they who see thee as thou walkest the paths of Zion. Hallelujah, Hallelujah, Hallelujah, hear all nature rejoice. We have seen thy salvation. Our eyes are opened and our hearts have become supple.",
+		"Hearken unto my words and live, saith the LORD. Yea, hearken and live anew. I have abundantly shed grace like a rain. The dust no more afflicteth thine eyes. No more doth it bite thy taste. Look up and taste the sweet dew. The dirt and grime of a hundred battles melteth from thee. It is no more thy tears that fall to the ground. Ye are clean again before me, my people.",
+		"Go ye one and all to the place of wisdom, to the solemn moment. Go ye to Jerusalem. Weep ye for joy in the path thereto. I shall free Moriah of the nations. Violence shall not hold onto my holy mount and it shall not be tread by force, nor shall it be freed by multitudes. But by a lad shalt thou speak to them, and my word shall cause the nations to decamp. Come ye and build. O Come ye and hear the heavens set you a fine tune. Sing, thou Israel, weep and sing, for, behold, salvation hath come of thy God, thy stony heart is gone! Build ye, O Jerusalem, build ye a light, build ye and lay the path of the LORD’s highway. It cleaveth the murky waters; it is a solid road to Jerusalem amidst the mucky ground. Let the nations come and seek forgiveness. Let mercy flow out as a river, for there is no God but our God, and the ends of the Earth shall once again behold his salvation in Israel.",
+		"Cry not anymore. Comfort yourselves with my forgiveness. They that have sinned I have cut off, and they came into the pit wherein their own feet did lead them. But for thee, my beloved, I have filled in the pit that no more should enter therein, for my salvation hath come.",
+	},
+	// Chapter 71
+	{
+		"INDEED, a wonder of wonders hast thou done in all ages and for all times, O my God, thou glory of all times and seasons— my rock and my lot. Thou hast taken upon a face that we might see thee. Behold, I see thee and call thee Ishi. Thou speakest from a clear and sure mouth, and thou art not silent as unto stone. What fools we have been in our hardness! Pass us over with thy mercy, yet come quickly unto us. Look not at our sin and at the foolishness of our spirits. Shall we resist the knowledge of God? At such a moment shall our pride make hard our understanding and dampen our souls? In thy humiliation there is wisdom, in our gratitude there is understanding. Behold, is anything too difficult for God? Is anything beyond his measure?",
+		"Is it such a wonder that he should walk among us? Open the portals of thine heart, O Israel, God is God and his word hath come to pass. We have not been left in the cave of our shame. Come out into the light, saith the LORD unto thee. Come out!",
+		"Is the LORD not to be sought more than marble and the finest of goodly stone? Hear them shout without. The LORD is amongst us! Come out! Gold moldeth into any form, buildings are lifeless. Come out! Can ye not hear? Come out from the retreats of your dispersion. Have ye not seen what hath been done in Zion? Come ye to Zion, O all ye sons of Isaac, with your aged, with your bairns, with your servants, with your whole households. March on, march on. The report is true. God liveth. Yea, our God reigneth, and he calleth for all his sons to return. Return, return unto me, saith the LORD.",
+		"Hear, O ye nations, my people come out, my people come hither. Hinder them not in their way. For surely I am the LORD, the king of all the Earth, the dread Lord; and I call unto my sons; I reach for my daughters. What is there that I will not do to call back my children? I am a roaring lion, yea, I am a ferocious lion against mine enemies. I tear and I rend asunder to protect my brood.",
+		"Wonder of wonders, saith my soul. Wonder of wonders moveth my tongue. Who is like unto the LORD our God? I have seen the great King, he who is BEING. I am speechless. There are no words. Groanings of my soul cannot form them. Behold, burnings in mine heart cannot materialize words. What a wonder that he should breathe into man his spirit! Yea, I live because of the LORD, because of his burning fire. No heart can bear the compassion of his ways. I see no son of flesh who can stand before an ounce of his glory.",
+		"I have never seen beauty like this, like unto the LORD our God. Consider, O man, his doings, for eye cannot behold nor can heart appreciate his visage. At the healing of our wound, we do see him. At the gentle hour, we do hear his call. The LORD hath drawn his portrait and hath made me the frame. From beginning unto end, I have been written on by his hand, that all the Earth shall see and understand his glory.",
+		"Behold, behold, my face created all that existeth, worlds of worlds, cosmos and all therein. What face shall ye give me, and what features will you give my doings? Ye reviled my appearance, but the wonderful acts of God— who cannot see them? What is a tabernacle to you if it cannot speak? A face that cannot speak is no better than stone. Why seek ye mine image and yet hearken not unto my ways? With my mouth I speak of wondrous things, and with my doings I draw my face. Open thy soul, O son of man, and see me clearly. Cast thine eyes to the throng of repentance, and therein see the heart of thy God. Rejoice in his acts. Draw nigh unto his glory.",
+	},
+	// Chapter 72
+	{
+		"YO, all ye sons of flesh, all ye who seek rest, come with me to Jerusalem, to the house of the LORD our God, the great King. Ye thin and wasted become a fatling. Ye starving, be ye satisfied at a banquet. Ye maimed mount up like a joyous calf. Ye babes become princes. The LORD doth reign. How wonderful are they who joined the throng. Come, all ye Gentiles. Let your armies be conquered. Come all ye meek and subdue nations!",
+		"Drink at the fount of his throne. A river doth come out of Zion. The LORD hath sweetened it and anointed it with his kiss. His lips have flavored it with the words of his mouth. Come ye all, come ye all to repentance. Cast off your idols, for the LORD is our bridegroom. Many, yea, though many be gathered to his bridal chamber, only the single shall go in. Cast off all the idols, throw them down and be thou virgin again, O Israel, and be thou yoked forever, O daughter Zion, to the King, the great King.",
+		"The ground is tilled not, neither doth a man lay hold of a plow anymore. But the harvest groweth before our eyes. Fruit fatteneth upon the tree. Flowers blossom and are as numerous as drops of rain, the peaceful rain of spring. Springs burst forth, sweet waters. Lo, there is peace, and no man striveth with his neighbour. Each man careth for his fellow, and all lay hold of justice. No man extorteth from his brother. No more is there the cry of the orphan, and the widow and the spinster have children to abundance. If there is a cry, lo, the LORD comforteth as a rushing whirlwind. There is plenty to abundance, for, behold, I create a new heart in my people, and a new body will I fashion. Where are thy scars, O man? Where is the place of pain? It shall be no more. The abode of fear and its dark recesses are vanquished. Worry is gone, covetousness is vanished. Evil is no more and selfishness is as a scab dried and blown away. Worlds await thee. My world hath come. The dwelling place of the LORD is come; my par",
+	},
+	// Chapter 73
+	{
+		"LOOK up, O Israel, look up, look up unto the clouds driven by a strong wind. So the time presseth upon thee. Look up, O foolish people. What man can lay hold of a cloud or cast a rope around it? Run to thy vats, open thy barrels and prepare for the latter rain as before. My spirit shall be with thee, my Jacob, my beloved that delighteth in me, and I shall drive out thine enemies before thy face. With the bow and the sling shall thy young warriors defeat men of iron and their great rods. Thy young men will not faint, nor grow weary. Thine enemies shall flee and thou shalt pursue them to thy borders.",
+		"For my compassion is toward thee, O Israel, and my mercy made manifest. Thy sin is etched in lead. The sin of my people is engraven deeply in a thick slab, saith the LORD. Though thou buriest it, corrosion cannot remove the inscription thereon. So hath thy sin been uncovered time and time again, and brought thee accusation, and accusation misery and desolation. Only I can raise the slab from the earth and blot out thine iniquity before me. I have wrought me a great name, and my sword hath circumcised unto me a people, and they shall be blessed of the LORD forever.",
+		"And the peoples of the Earth shall say he is blessed of the LORD who believed and who hearkened before the day of the LORD’s chastisement upon Israel.",
+		"Thy days of being wounded draw to an end, and it is darkest before the dawn. The third day arriveth at an inauspicious moment. Prepare ye to be healed, O Israel. Rise again, O foolish nation. I say unto thee, Arise! Look up, O Israel, look up, for my words are at the door and my salvation draweth nigh. Now I say unto thee, Arise, and thou shalt live again; and the nations again shall see my salvation.",
+		"Hear now these words while yet thou may. For surely I have spoken again unto thee, O Israel. Surely as a crack of thunder and as a tremendous rumble I shall stir from my place and purge Israel for my great namesake. Turn unto me, O Israel, and tarry not.",
+	},
+}
+
+const corpusRevision = "084032073430"
+
+const corpusVerses = 736
@@ -0,0 +1,65 @@
+// Command gen compiles the chapter JSON files into corpus_gen.go so that the
+// Book of Ben corpus is embedded pre-parsed and nothing is read at startup.
+//
+// Run it with `go generate ./internal/data`.
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const totalChapters = 73
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/data/gen; DO NOT EDIT.\n")
+	buf.WriteString("// Source: internal/data/chapters/chapter*.json\n\n")
+	buf.WriteString("package data\n\n")
+	buf.WriteString("var corpus = [totalChapters][]string{\n")
+
+	total := 0
+	hash := sha256.New()
+	for chapterNum := 1; chapterNum <= totalChapters; chapterNum++ {
+		chapterPath := filepath.Join("chapters", fmt.Sprintf("chapter%d.json", chapterNum))
+		content, err := os.ReadFile(chapterPath)
+		if err != nil {
+			log.Fatalf("Failed to read chapter%d.json: %v", chapterNum, err)
+		}
+
+		var verses []string
+		if err := json.Unmarshal(content, &verses); err != nil {
+			log.Fatalf("Failed to unmarshal chapter%d.json: %v", chapterNum, err)
+		}
+
+		fmt.Fprintf(&buf, "\t// Chapter %d\n\t{\n", chapterNum)
+		for i, verse := range verses {
+			fmt.Fprintf(&buf, "\t\t%s,\n", strconv.Quote(verse))
+			fmt.Fprintf(hash, "%d:%d\t%s\n", chapterNum, i+1, verse)
+		}
+		buf.WriteString("\t},\n")
+		total += len(verses)
+	}
+	buf.WriteString("}\n\n")
+
+	// The revision identifies the corpus content independently of the build
+	fmt.Fprintf(&buf, "const corpusRevision = %q\n\n", hex.EncodeToString(hash.Sum(nil))[:12])
+	fmt.Fprintf(&buf, "const corpusVerses = %d\n", total)
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format generated corpus: %v", err)
+	}
+	if err := os.WriteFile("corpus_gen.go", source, 0o644); err != nil {
+		log.Fatalf("Failed to write corpus_gen.go: %v", err)
+	}
+	log.Printf("Generated corpus_gen.go: %d chapters, %d verses", totalChapters, total)
+}
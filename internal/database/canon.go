@@ -0,0 +1,37 @@
+package database
+
+// CanonicalOrder is the USFM book order of the Protestant canon. Books that
+// are not listed, such as the Book of Ben, follow it in ID order.
+var CanonicalOrder = []string{
+	"GEN", "EXO", "LEV", "NUM", "DEU", "JOS", "JDG", "RUT", "1SA", "2SA",
+	"1KI", "2KI", "1CH", "2CH", "EZR", "NEH", "EST", "JOB", "PSA", "PRO",
+	"ECC", "SNG", "ISA", "JER", "LAM", "EZK", "DAN", "HOS", "JOL", "AMO",
+	"OBA", "JON", "MIC", "NAM", "HAB", "ZEP", "HAG", "ZEC", "MAL",
+	"MAT", "MRK", "LUK", "JHN", "ACT", "ROM", "1CO", "2CO", "GAL", "EPH",
+	"PHP", "COL", "1TH", "2TH", "1TI", "2TI", "TIT", "PHM", "HEB", "JAS",
+	"1PE", "2PE", "1JN", "2JN", "3JN", "JUD", "REV",
+}
+
+var canonicalIndex = func() map[string]int {
+	index := make(map[string]int, len(CanonicalOrder))
+	for i, id := range CanonicalOrder {
+		index[id] = i
+	}
+	return index
+}()
+
+// CanonicalIndex returns the position of a book in CanonicalOrder, and
+// whether it is listed there
+func CanonicalIndex(bookID string) (int, bool) {
+	i, ok := canonicalIndex[bookID]
+	return i, ok
+}
+
+// BookOrder ranks a book for sorting: its canonical position, or
+// len(CanonicalOrder) for books outside the canon, which then sort by ID
+func BookOrder(bookID string) int {
+	if i, ok := canonicalIndex[bookID]; ok {
+		return i
+	}
+	return len(CanonicalOrder)
+}
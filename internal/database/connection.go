@@ -3,56 +3,117 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 var (
+	mu          sync.Mutex
+	dbConfig    config.DatabaseConfig
 	mongoClient *mongo.Client
 	database    *mongo.Database
 )
 
-// InitMongoDB initializes the MongoDB connection
-func InitMongoDB(connectionString, databaseName string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// Configure records the connection settings without connecting.
+// The client is created on first use and then reused across requests and
+// Function Compute invocations until Close is called.
+func Configure(cfg config.DatabaseConfig) error {
+	if cfg.URI == "" {
+		return fmt.Errorf("database URI is not configured")
+	}
 
-	clientOptions := options.Client().ApplyURI(connectionString)
-	client, err := mongo.Connect(clientOptions)
-	if err != nil {
-		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	mu.Lock()
+	defer mu.Unlock()
+	dbConfig = cfg
+	return nil
+}
+
+// InitMongoDB initializes the MongoDB connection and verifies it with a ping
+func InitMongoDB(ctx context.Context, cfg config.DatabaseConfig) error {
+	ctx, span := tracing.Start(ctx, "database.InitMongoDB")
+	defer span.End()
+
+	if err := Configure(cfg); err != nil {
+		return err
+	}
+	if GetDatabase() == nil {
+		return fmt.Errorf("failed to connect to MongoDB")
 	}
 
 	// Test connection
-	if err := client.Ping(ctx, nil); err != nil {
+	if err := Ping(ctx); err != nil {
+		tracing.RecordError(span, err)
 		return fmt.Errorf("failed to ping MongoDB: %w", err)
 	}
 
-	mongoClient = client
-	database = client.Database(databaseName)
-
-	fmt.Println("Connected to MongoDB successfully")
+	slog.InfoContext(ctx, "connected to MongoDB", "database", cfg.Name)
 	return nil
 }
 
-// GetDatabase returns the database instance
+// GetDatabase returns the database instance, creating the client on first use.
+// It returns nil if the connection is not configured or the client cannot be created.
 func GetDatabase() *mongo.Database {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if database != nil {
+		return database
+	}
+	if dbConfig.URI == "" {
+		return nil
+	}
+
+	// mongo.Connect does not perform I/O; sockets are opened by the first operation
+	clientOptions := options.Client().
+		ApplyURI(dbConfig.URI).
+		SetConnectTimeout(dbConfig.ConnectTimeout).
+		SetServerSelectionTimeout(dbConfig.ConnectTimeout).
+		SetMonitor(tracing.MongoMonitor())
+	client, err := mongo.Connect(clientOptions)
+	if err != nil {
+		slog.Error("failed to create MongoDB client", "error", err)
+		return nil
+	}
+
+	mongoClient = client
+	database = client.Database(dbConfig.Name)
 	return database
 }
 
-// Close closes the MongoDB connection
+// Ping checks that MongoDB is reachable
+func Ping(ctx context.Context) error {
+	db := GetDatabase()
+	if db == nil {
+		return fmt.Errorf("database is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbConfig.ConnectTimeout)
+	defer cancel()
+	return db.Client().Ping(ctx, nil)
+}
+
+// Close closes the MongoDB connection. A later GetDatabase reconnects.
 func Close() error {
+	mu.Lock()
+	defer mu.Unlock()
+
 	if mongoClient != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		if err := mongoClient.Disconnect(ctx); err != nil {
+		client := mongoClient
+		mongoClient = nil
+		database = nil
+		if err := client.Disconnect(ctx); err != nil {
 			return fmt.Errorf("error disconnecting from MongoDB: %w", err)
 		}
-		log.Println("Disconnected from MongoDB")
+		slog.Info("disconnected from MongoDB")
 	}
 	return nil
 }
@@ -1,20 +1,65 @@
 package database
 
+import (
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
 // Verse represents a Bible verse in the database
 type Verse struct {
 	BookID        string `json:"book_id" bson:"book_id"`
-	TranslationID string `json:"translation_id" bson:"tranlation_id"`
+	TranslationID string `json:"translation_id" bson:"translation_id"`
 	BookName      string `json:"book_name" bson:"book_name"`
 	Chapter       int    `json:"chapter" bson:"chapter"`
 	Verse         int    `json:"verse" bson:"verse"`
 	Text          string `json:"text" bson:"text"`
+	// Footnotes are ordered by Offset
+	Footnotes []Footnote `json:"footnotes,omitempty" bson:"footnotes,omitempty"`
+}
+
+// Footnote types
+const (
+	FootnoteTranslator = "translator"
+	FootnoteTextual    = "textual"
+	FootnoteEditorial  = "editorial"
+)
+
+// FootnoteTypes lists every footnote type
+var FootnoteTypes = []string{FootnoteTranslator, FootnoteTextual, FootnoteEditorial}
+
+// Footnote is a note anchored in a verse's text. Offset counts the
+// characters (Unicode code points) of the text before the anchor.
+type Footnote struct {
+	Offset          int      `json:"offset" bson:"offset"`
+	Type            string   `json:"type" bson:"type"`
+	Text            string   `json:"text" bson:"text"`
+	CrossReferences []string `json:"cross_references,omitempty" bson:"cross_references,omitempty"`
+}
+
+// Equal reports whether f and g are the same footnote
+func (f Footnote) Equal(g Footnote) bool {
+	return f.Offset == g.Offset && f.Type == g.Type && f.Text == g.Text && slices.Equal(f.CrossReferences, g.CrossReferences)
 }
 
-// Translation represents a Bible translation
+// Translation represents a Bible translation with the metadata clients need
+// to render and attribute it. Language is a BCP 47 tag, Script an ISO 15924
+// code and Books the IDs of the books it contains.
 type Translation struct {
-	ID   string `json:"id" bson:"_id"`
-	Name string `json:"name" bson:"name"`
-	Note string `json:"note" bson:"note"`
+	ID            string   `json:"id" bson:"_id"`
+	Name          string   `json:"name" bson:"name"`
+	Note          string   `json:"note" bson:"note"`
+	Language      string   `json:"language,omitempty" bson:"language,omitempty"`
+	Script        string   `json:"script,omitempty" bson:"script,omitempty"`
+	Direction     string   `json:"direction,omitempty" bson:"direction,omitempty"`
+	Copyright     string   `json:"copyright,omitempty" bson:"copyright,omitempty"`
+	License       License  `json:"license" bson:"license"`
+	Attribution   string   `json:"attribution,omitempty" bson:"attribution,omitempty"`
+	Publisher     string   `json:"publisher,omitempty" bson:"publisher,omitempty"`
+	Year          int      `json:"year,omitempty" bson:"year,omitempty"`
+	Versification string   `json:"versification,omitempty" bson:"versification,omitempty"`
+	Books         []string `json:"books,omitempty" bson:"books,omitempty"`
 }
 
 // Book represents a Bible book
@@ -23,3 +68,72 @@ type Book struct {
 	Name     string `json:"name" bson:"name"`
 	Chapters int    `json:"chapters" bson:"chapters"`
 }
+
+// Verse revision operations
+const (
+	RevisionInsert = "insert"
+	RevisionUpdate = "update"
+	RevisionDelete = "delete"
+)
+
+// Revision records one change of a verse's text or footnotes. The old
+// fields are empty for inserts and the new fields are empty for deletes.
+type Revision struct {
+	ID            bson.ObjectID `json:"id" bson:"_id"`
+	TranslationID string        `json:"translation_id" bson:"translation_id"`
+	BookID        string        `json:"book_id" bson:"book_id"`
+	Chapter       int           `json:"chapter" bson:"chapter"`
+	Verse         int           `json:"verse" bson:"verse"`
+	Op            string        `json:"op" bson:"op"`
+	OldText       string        `json:"old_text" bson:"old_text"`
+	NewText       string        `json:"new_text" bson:"new_text"`
+	OldFootnotes  []Footnote    `json:"old_footnotes,omitempty" bson:"old_footnotes,omitempty"`
+	NewFootnotes  []Footnote    `json:"new_footnotes,omitempty" bson:"new_footnotes,omitempty"`
+	Editor        string        `json:"editor" bson:"editor"`
+	Reason        string        `json:"reason,omitempty" bson:"reason,omitempty"`
+	CreatedAt     time.Time     `json:"created_at" bson:"created_at"`
+}
+
+// NewRevision describes the change of a verse from before to after. A nil
+// before is an insert and a nil after a delete. It returns false if neither the text nor
+// the footnotes changed.
+func NewRevision(before, after *Verse, editor, reason string) (Revision, bool) {
+	op, verse := RevisionUpdate, after
+	switch {
+	case before == nil && after == nil:
+		return Revision{}, false
+	case before == nil:
+		op = RevisionInsert
+	case after == nil:
+		op, verse = RevisionDelete, before
+	case before.Text == after.Text && slices.EqualFunc(before.Footnotes, after.Footnotes, Footnote.Equal):
+		return Revision{}, false
+	}
+	revision := Revision{
+		ID:            bson.NewObjectID(),
+		TranslationID: verse.TranslationID,
+		BookID:        verse.BookID,
+		Chapter:       verse.Chapter,
+		Verse:         verse.Verse,
+		Op:            op,
+		Editor:        editor,
+		Reason:        reason,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if before != nil {
+		revision.OldText, revision.OldFootnotes = before.Text, before.Footnotes
+	}
+	if after != nil {
+		revision.NewText, revision.NewFootnotes = after.Text, after.Footnotes
+	}
+	return revision, true
+}
+
+// DailyVerse is a curated verse of the day. Date is "YYYY-MM-DD".
+type DailyVerse struct {
+	Date      string    `json:"date" bson:"_id"`
+	Reference string    `json:"reference" bson:"reference"`
+	Note      string    `json:"note,omitempty" bson:"note,omitempty"`
+	Editor    string    `json:"editor" bson:"editor"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
@@ -2,13 +2,28 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/tkdnbb/bookofben-api/internal/metrics"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// Verse write errors
+var (
+	ErrDuplicateVerse     = errors.New("verse already exists")
+	ErrVerseNotFound      = errors.New("verse not found")
+	ErrRevisionNotFound   = errors.New("revision not found")
+	ErrDailyVerseNotFound = errors.New("no curated verse for this date")
+)
+
+// RevisionsCollection stores the Revision of every verse change
+const RevisionsCollection = "verse_revisions"
+
 // Repository handles database operations
 type Repository struct {
 	db *mongo.Database
@@ -22,9 +37,11 @@ func NewRepository() *Repository {
 }
 
 // GetTranslation retrieves a translation by ID
-func (r *Repository) GetTranslation(translationID string) (*Translation, error) {
-	ctx := context.Background()
+func (r *Repository) GetTranslation(ctx context.Context, translationID string) (*Translation, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetTranslation")
+	defer span.End()
 	collection := r.db.Collection("translations")
+	defer metrics.ObserveMongo("translations", "findOne", time.Now())
 
 	var translation Translation
 	err := collection.FindOne(ctx, bson.M{"_id": translationID}).Decode(&translation)
@@ -36,9 +53,11 @@ func (r *Repository) GetTranslation(translationID string) (*Translation, error)
 }
 
 // GetBook retrieves a book by ID
-func (r *Repository) GetBook(bookID string) (*Book, error) {
-	ctx := context.Background()
+func (r *Repository) GetBook(ctx context.Context, bookID string) (*Book, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetBook")
+	defer span.End()
 	collection := r.db.Collection("books")
+	defer metrics.ObserveMongo("books", "findOne", time.Now())
 
 	var book Book
 	err := collection.FindOne(ctx, bson.M{"_id": bookID}).Decode(&book)
@@ -50,9 +69,11 @@ func (r *Repository) GetBook(bookID string) (*Book, error) {
 }
 
 // GetVerses retrieves verses by book, chapter, and optionally verse number
-func (r *Repository) GetVerses(bookID string, chapter, verse int) ([]Verse, error) {
-	ctx := context.Background()
+func (r *Repository) GetVerses(ctx context.Context, bookID string, chapter, verse int) ([]Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetVerses")
+	defer span.End()
 	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "find", time.Now())
 
 	filter := bson.M{"book_id": bookID, "chapter": chapter}
 	if verse > 0 {
@@ -74,10 +95,87 @@ func (r *Repository) GetVerses(bookID string, chapter, verse int) ([]Verse, erro
 	return verses, nil
 }
 
+// GetVerseRange retrieves verses of a chapter between startVerse and endVerse inclusive.
+// A startVerse of 0 returns the whole chapter; an endVerse of 0 returns a single verse.
+// An empty translationID matches every translation.
+func (r *Repository) GetVerseRange(ctx context.Context, translationID, bookID string, chapter, startVerse, endVerse int) ([]Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetVerseRange")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "find", time.Now())
+
+	filter := bson.M{"book_id": bookID, "chapter": chapter}
+	if translationID != "" {
+		filter["translation_id"] = translationID
+	}
+	if startVerse > 0 {
+		if endVerse > 0 && endVerse != startVerse {
+			filter["verse"] = bson.M{"$gte": startVerse, "$lte": endVerse}
+		} else {
+			filter["verse"] = startVerse
+		}
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "verse", Value: 1}})
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find verses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var verses []Verse
+	if err = cursor.All(ctx, &verses); err != nil {
+		return nil, fmt.Errorf("failed to decode verses: %w", err)
+	}
+
+	return verses, nil
+}
+
+// GetVersesAcross retrieves the verses of a book from chapter:startVerse to
+// endChapter:endVerse inclusive, in chapter and verse order. An empty
+// translationID matches every translation.
+func (r *Repository) GetVersesAcross(ctx context.Context, translationID, bookID string, chapter, startVerse, endChapter, endVerse int) ([]Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetVersesAcross")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "find", time.Now())
+
+	filter := bson.M{"book_id": bookID}
+	if translationID != "" {
+		filter["translation_id"] = translationID
+	}
+	if chapter == endChapter {
+		filter["chapter"] = chapter
+		filter["verse"] = bson.M{"$gte": startVerse, "$lte": endVerse}
+	} else {
+		filter["$or"] = bson.A{
+			bson.M{"chapter": chapter, "verse": bson.M{"$gte": startVerse}},
+			bson.M{"chapter": bson.M{"$gt": chapter, "$lt": endChapter}},
+			bson.M{"chapter": endChapter, "verse": bson.M{"$lte": endVerse}},
+		}
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "chapter", Value: 1}, {Key: "verse", Value: 1}})
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find verses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var verses []Verse
+	if err = cursor.All(ctx, &verses); err != nil {
+		return nil, fmt.Errorf("failed to decode verses: %w", err)
+	}
+
+	return verses, nil
+}
+
 // GetAllTranslations retrieves all translations
-func (r *Repository) GetAllTranslations() ([]Translation, error) {
-	ctx := context.Background()
+func (r *Repository) GetAllTranslations(ctx context.Context) ([]Translation, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetAllTranslations")
+	defer span.End()
 	collection := r.db.Collection("translations")
+	defer metrics.ObserveMongo("translations", "find", time.Now())
 
 	cursor, err := collection.Find(ctx, bson.M{})
 	if err != nil {
@@ -94,9 +192,11 @@ func (r *Repository) GetAllTranslations() ([]Translation, error) {
 }
 
 // GetAllBooks retrieves all books
-func (r *Repository) GetAllBooks() ([]Book, error) {
-	ctx := context.Background()
+func (r *Repository) GetAllBooks(ctx context.Context) ([]Book, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetAllBooks")
+	defer span.End()
 	collection := r.db.Collection("books")
+	defer metrics.ObserveMongo("books", "find", time.Now())
 
 	cursor, err := collection.Find(ctx, bson.M{})
 	if err != nil {
@@ -112,10 +212,294 @@ func (r *Repository) GetAllBooks() ([]Book, error) {
 	return books, nil
 }
 
+// InsertTranslation normalizes, validates and adds a translation
+func (r *Repository) InsertTranslation(ctx context.Context, translation Translation) error {
+	ctx, span := tracing.Start(ctx, "Repository.InsertTranslation")
+	defer span.End()
+	collection := r.db.Collection("translations")
+	defer metrics.ObserveMongo("translations", "insertOne", time.Now())
+
+	translation.Normalize()
+	if err := translation.Validate(); err != nil {
+		return err
+	}
+	if _, err := collection.InsertOne(ctx, translation); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("translation %q already exists", translation.ID)
+		}
+		return fmt.Errorf("failed to insert translation: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceTranslation normalizes, validates and replaces the metadata of an
+// existing translation
+func (r *Repository) ReplaceTranslation(ctx context.Context, translation Translation) error {
+	ctx, span := tracing.Start(ctx, "Repository.ReplaceTranslation")
+	defer span.End()
+	collection := r.db.Collection("translations")
+	defer metrics.ObserveMongo("translations", "replaceOne", time.Now())
+
+	translation.Normalize()
+	if err := translation.Validate(); err != nil {
+		return err
+	}
+	result, err := collection.ReplaceOne(ctx, bson.M{"_id": translation.ID}, translation)
+	if err != nil {
+		return fmt.Errorf("failed to replace translation: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("translation %q: %w", translation.ID, mongo.ErrNoDocuments)
+	}
+	return nil
+}
+
+// DeleteTranslation removes a translation; with verses it also removes its verses.
+// It returns the number of verses removed.
+func (r *Repository) DeleteTranslation(ctx context.Context, translationID string, verses bool) (int64, error) {
+	ctx, span := tracing.Start(ctx, "Repository.DeleteTranslation")
+	defer span.End()
+	collection := r.db.Collection("translations")
+	defer metrics.ObserveMongo("translations", "deleteOne", time.Now())
+
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": translationID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete translation: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return 0, fmt.Errorf("translation %q: %w", translationID, mongo.ErrNoDocuments)
+	}
+	if !verses {
+		return 0, nil
+	}
+
+	deleted, err := r.db.Collection("verses").DeleteMany(ctx, bson.M{"translation_id": translationID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete verses: %w", err)
+	}
+	return deleted.DeletedCount, nil
+}
+
+// InsertBook adds a book
+func (r *Repository) InsertBook(ctx context.Context, book Book) error {
+	ctx, span := tracing.Start(ctx, "Repository.InsertBook")
+	defer span.End()
+	collection := r.db.Collection("books")
+	defer metrics.ObserveMongo("books", "insertOne", time.Now())
+
+	if _, err := collection.InsertOne(ctx, book); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("book %q already exists", book.ID)
+		}
+		return fmt.Errorf("failed to insert book: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBook removes a book; with verses it also removes its verses.
+// It returns the number of verses removed.
+func (r *Repository) DeleteBook(ctx context.Context, bookID string, verses bool) (int64, error) {
+	ctx, span := tracing.Start(ctx, "Repository.DeleteBook")
+	defer span.End()
+	collection := r.db.Collection("books")
+	defer metrics.ObserveMongo("books", "deleteOne", time.Now())
+
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": bookID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete book: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return 0, fmt.Errorf("book %q: %w", bookID, mongo.ErrNoDocuments)
+	}
+	if !verses {
+		return 0, nil
+	}
+
+	deleted, err := r.db.Collection("verses").DeleteMany(ctx, bson.M{"book_id": bookID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete verses: %w", err)
+	}
+	return deleted.DeletedCount, nil
+}
+
+// verseKey selects one verse
+func verseKey(translationID, bookID string, chapter, verse int) bson.M {
+	return bson.M{"translation_id": translationID, "book_id": bookID, "chapter": chapter, "verse": verse}
+}
+
+// InsertVerses inserts verses in one unordered bulk write.
+// The returned slice holds the error of every verse, nil if it was inserted.
+func (r *Repository) InsertVerses(ctx context.Context, verses []Verse) ([]error, error) {
+	ctx, span := tracing.Start(ctx, "Repository.InsertVerses")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "bulkInsert", time.Now())
+
+	errs := make([]error, len(verses))
+	if len(verses) == 0 {
+		return errs, nil
+	}
+
+	writes := make([]mongo.WriteModel, len(verses))
+	for i, verse := range verses {
+		writes[i] = mongo.NewInsertOneModel().SetDocument(verse)
+	}
+
+	_, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	switch {
+	case err == nil:
+	case errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil:
+		for _, writeErr := range bulkErr.WriteErrors {
+			if mongo.IsDuplicateKeyError(writeErr.WriteError) {
+				errs[writeErr.Index] = ErrDuplicateVerse
+			} else {
+				errs[writeErr.Index] = fmt.Errorf("failed to insert verse: %s", writeErr.Message)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("failed to insert verses: %w", err)
+	}
+
+	return errs, nil
+}
+
+// UpsertVerse replaces a verse or inserts it if missing.
+// It returns the previous verse, or nil if it was inserted.
+func (r *Repository) UpsertVerse(ctx context.Context, verse Verse) (*Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.UpsertVerse")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "findOneAndReplace", time.Now())
+
+	var previous Verse
+	err := collection.FindOneAndReplace(ctx,
+		verseKey(verse.TranslationID, verse.BookID, verse.Chapter, verse.Verse),
+		verse,
+		options.FindOneAndReplace().SetUpsert(true).SetReturnDocument(options.Before),
+	).Decode(&previous)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert verse: %w", err)
+	}
+
+	return &previous, nil
+}
+
+// UpdateVerse sets fields of an existing verse and returns the previous verse
+func (r *Repository) UpdateVerse(ctx context.Context, translationID, bookID string, chapter, verse int, fields bson.M) (*Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.UpdateVerse")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "findOneAndUpdate", time.Now())
+
+	var previous Verse
+	err := collection.FindOneAndUpdate(ctx,
+		verseKey(translationID, bookID, chapter, verse),
+		bson.M{"$set": fields},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before),
+	).Decode(&previous)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrVerseNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update verse: %w", err)
+	}
+
+	return &previous, nil
+}
+
+// DeleteVerse removes a verse and returns it
+func (r *Repository) DeleteVerse(ctx context.Context, translationID, bookID string, chapter, verse int) (*Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.DeleteVerse")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "findOneAndDelete", time.Now())
+
+	var previous Verse
+	err := collection.FindOneAndDelete(ctx, verseKey(translationID, bookID, chapter, verse)).Decode(&previous)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrVerseNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete verse: %w", err)
+	}
+
+	return &previous, nil
+}
+
+// CountChapterVerses counts the stored verses of a chapter in a translation
+func (r *Repository) CountChapterVerses(ctx context.Context, translationID, bookID string, chapter int) (int64, error) {
+	ctx, span := tracing.Start(ctx, "Repository.CountChapterVerses")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "count", time.Now())
+
+	count, err := collection.CountDocuments(ctx, bson.M{"translation_id": translationID, "book_id": bookID, "chapter": chapter})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count verses: %w", err)
+	}
+
+	return count, nil
+}
+
+// ChapterVerseCounts returns the number of stored verses of every chapter of
+// a book in a translation, keyed by chapter
+func (r *Repository) ChapterVerseCounts(ctx context.Context, translationID, bookID string) (map[int]int, error) {
+	ctx, span := tracing.Start(ctx, "Repository.ChapterVerseCounts")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "aggregate", time.Now())
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"translation_id": translationID, "book_id": bookID}}},
+		{{Key: "$group", Value: bson.M{"_id": "$chapter", "verses": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count chapter verses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Chapter int `bson:"_id"`
+		Verses  int `bson:"verses"`
+	}
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode chapter verse counts: %w", err)
+	}
+
+	counts := make(map[int]int, len(groups))
+	for _, g := range groups {
+		counts[g.Chapter] = g.Verses
+	}
+	return counts, nil
+}
+
+// CountBookVerses counts the stored verses of a book
+func (r *Repository) CountBookVerses(ctx context.Context, bookID string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "Repository.CountBookVerses")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "count", time.Now())
+
+	count, err := collection.CountDocuments(ctx, bson.M{"book_id": bookID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count verses: %w", err)
+	}
+
+	return count, nil
+}
+
 // InsertVerse inserts a new verse
-func (r *Repository) InsertVerse(verse Verse) error {
-	ctx := context.Background()
+func (r *Repository) InsertVerse(ctx context.Context, verse Verse) error {
+	ctx, span := tracing.Start(ctx, "Repository.InsertVerse")
+	defer span.End()
 	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "insertOne", time.Now())
 
 	_, err := collection.InsertOne(ctx, verse)
 	if err != nil {
@@ -126,9 +510,11 @@ func (r *Repository) InsertVerse(verse Verse) error {
 }
 
 // SearchVerses searches for verses containing the query string
-func (r *Repository) SearchVerses(query string) ([]Verse, error) {
-	ctx := context.Background()
+func (r *Repository) SearchVerses(ctx context.Context, query string) ([]Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.SearchVerses")
+	defer span.End()
 	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "search", time.Now())
 
 	filter := bson.M{"text": bson.M{"$regex": query, "$options": "i"}}
 	cursor, err := collection.Find(ctx, filter)
@@ -144,3 +530,232 @@ func (r *Repository) SearchVerses(query string) ([]Verse, error) {
 
 	return verses, nil
 }
+
+// InsertRevisions records verse revisions
+func (r *Repository) InsertRevisions(ctx context.Context, revisions []Revision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+	ctx, span := tracing.Start(ctx, "Repository.InsertRevisions")
+	defer span.End()
+	collection := r.db.Collection(RevisionsCollection)
+	defer metrics.ObserveMongo(RevisionsCollection, "insertMany", time.Now())
+
+	if _, err := collection.InsertMany(ctx, revisions); err != nil {
+		return fmt.Errorf("failed to record revisions: %w", err)
+	}
+
+	return nil
+}
+
+// ListRevisions returns the revisions of a verse, newest first
+func (r *Repository) ListRevisions(ctx context.Context, translationID, bookID string, chapter, verse int, limit int64) ([]Revision, error) {
+	ctx, span := tracing.Start(ctx, "Repository.ListRevisions")
+	defer span.End()
+	collection := r.db.Collection(RevisionsCollection)
+	defer metrics.ObserveMongo(RevisionsCollection, "find", time.Now())
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cursor, err := collection.Find(ctx, verseKey(translationID, bookID, chapter, verse), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []Revision{}
+	if err = cursor.All(ctx, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to decode revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetRevision retrieves a revision by ID
+func (r *Repository) GetRevision(ctx context.Context, id bson.ObjectID) (*Revision, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetRevision")
+	defer span.End()
+	collection := r.db.Collection(RevisionsCollection)
+	defer metrics.ObserveMongo(RevisionsCollection, "findOne", time.Now())
+
+	var revision Revision
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&revision)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return &revision, nil
+}
+
+// GetVerse retrieves one verse of a translation
+func (r *Repository) GetVerse(ctx context.Context, translationID, bookID string, chapter, verse int) (*Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.GetVerse")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "findOne", time.Now())
+
+	var v Verse
+	err := collection.FindOne(ctx, verseKey(translationID, bookID, chapter, verse)).Decode(&v)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrVerseNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verse: %w", err)
+	}
+
+	return &v, nil
+}
+
+// ListDailyVerses returns the curated verses from one date to another, inclusive
+func (r *Repository) ListDailyVerses(ctx context.Context, from, to string) ([]DailyVerse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.ListDailyVerses")
+	defer span.End()
+	collection := r.db.Collection("daily_verses")
+	defer metrics.ObserveMongo("daily_verses", "find", time.Now())
+
+	filter := bson.M{"_id": bson.M{"$gte": from, "$lte": to}}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find daily verses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	verses := []DailyVerse{}
+	if err = cursor.All(ctx, &verses); err != nil {
+		return nil, fmt.Errorf("failed to decode daily verses: %w", err)
+	}
+
+	return verses, nil
+}
+
+// SaveDailyVerse creates or replaces the curated verse of a date
+func (r *Repository) SaveDailyVerse(ctx context.Context, verse DailyVerse) error {
+	ctx, span := tracing.Start(ctx, "Repository.SaveDailyVerse")
+	defer span.End()
+	collection := r.db.Collection("daily_verses")
+	defer metrics.ObserveMongo("daily_verses", "replaceOne", time.Now())
+
+	_, err := collection.ReplaceOne(ctx, bson.M{"_id": verse.Date}, verse, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save daily verse: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteDailyVerse removes the curated verse of a date
+func (r *Repository) DeleteDailyVerse(ctx context.Context, date string) error {
+	ctx, span := tracing.Start(ctx, "Repository.DeleteDailyVerse")
+	defer span.End()
+	collection := r.db.Collection("daily_verses")
+	defer metrics.ObserveMongo("daily_verses", "deleteOne", time.Now())
+
+	result, err := collection.DeleteOne(ctx, bson.M{"_id": date})
+	if err != nil {
+		return fmt.Errorf("failed to delete daily verse: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrDailyVerseNotFound
+	}
+
+	return nil
+}
+
+// VerseFilter restricts verse queries; zero fields match everything
+type VerseFilter struct {
+	TranslationID string
+	BookIDs       []string
+	ChapterFrom   int
+	ChapterTo     int
+}
+
+func (f VerseFilter) query() bson.M {
+	filter := bson.M{}
+	if f.TranslationID != "" {
+		filter["translation_id"] = f.TranslationID
+	}
+	if len(f.BookIDs) > 0 {
+		filter["book_id"] = bson.M{"$in": f.BookIDs}
+	}
+	chapter := bson.M{}
+	if f.ChapterFrom > 0 {
+		chapter["$gte"] = f.ChapterFrom
+	}
+	if f.ChapterTo > 0 {
+		chapter["$lte"] = f.ChapterTo
+	}
+	if len(chapter) > 0 {
+		filter["chapter"] = chapter
+	}
+	return filter
+}
+
+// CountVerses counts the verses matching f
+func (r *Repository) CountVerses(ctx context.Context, f VerseFilter) (int64, error) {
+	ctx, span := tracing.Start(ctx, "Repository.CountVerses")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "count", time.Now())
+
+	count, err := collection.CountDocuments(ctx, f.query())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count verses: %w", err)
+	}
+
+	return count, nil
+}
+
+// NthVerse returns the verse at position n, counting from 0, of the verses
+// matching f in book, chapter and verse order
+func (r *Repository) NthVerse(ctx context.Context, f VerseFilter, n int64) (*Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.NthVerse")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "findOne", time.Now())
+
+	opts := options.FindOne().
+		SetSort(bson.D{{Key: "book_id", Value: 1}, {Key: "chapter", Value: 1}, {Key: "verse", Value: 1}}).
+		SetSkip(n)
+	var verse Verse
+	err := collection.FindOne(ctx, f.query(), opts).Decode(&verse)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrVerseNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find verse: %w", err)
+	}
+
+	return &verse, nil
+}
+
+// SampleVerse returns a verse matching f picked uniformly at random by $sample
+func (r *Repository) SampleVerse(ctx context.Context, f VerseFilter) (*Verse, error) {
+	ctx, span := tracing.Start(ctx, "Repository.SampleVerse")
+	defer span.End()
+	collection := r.db.Collection("verses")
+	defer metrics.ObserveMongo("verses", "aggregate", time.Now())
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: f.query()}},
+		{{Key: "$sample", Value: bson.M{"size": 1}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample verses: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var verses []Verse
+	if err = cursor.All(ctx, &verses); err != nil {
+		return nil, fmt.Errorf("failed to decode sampled verse: %w", err)
+	}
+	if len(verses) == 0 {
+		return nil, ErrVerseNotFound
+	}
+
+	return &verses[0], nil
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+	"golang.org/x/text/language"
+)
+
+// Text directions
+const (
+	DirectionLTR = "ltr"
+	DirectionRTL = "rtl"
+)
+
+// ErrInvalidTranslation is returned for translation metadata that fails Validate
+var ErrInvalidTranslation = errors.New("invalid translation")
+
+// License describes the terms a translation is published under. ID is an
+// SPDX identifier or "public-domain"; Text is the full license text.
+type License struct {
+	ID                  string `json:"id,omitempty" bson:"id,omitempty"`
+	Name                string `json:"name,omitempty" bson:"name,omitempty"`
+	URL                 string `json:"url,omitempty" bson:"url,omitempty"`
+	Text                string `json:"text,omitempty" bson:"text,omitempty"`
+	AttributionRequired bool   `json:"attribution_required" bson:"attribution_required"`
+}
+
+// knownLicenses are licenses whose attribution requirement is fixed
+var knownLicenses = map[string]License{
+	"public-domain": {Name: "Public Domain"},
+	"CC0-1.0":       {Name: "Creative Commons Zero v1.0 Universal", URL: "https://creativecommons.org/publicdomain/zero/1.0/"},
+	"CC-BY-4.0":     {Name: "Creative Commons Attribution 4.0", URL: "https://creativecommons.org/licenses/by/4.0/", AttributionRequired: true},
+	"CC-BY-SA-4.0":  {Name: "Creative Commons Attribution ShareAlike 4.0", URL: "https://creativecommons.org/licenses/by-sa/4.0/", AttributionRequired: true},
+	"CC-BY-ND-4.0":  {Name: "Creative Commons Attribution NoDerivatives 4.0", URL: "https://creativecommons.org/licenses/by-nd/4.0/", AttributionRequired: true},
+	"CC-BY-NC-4.0":  {Name: "Creative Commons Attribution NonCommercial 4.0", URL: "https://creativecommons.org/licenses/by-nc/4.0/", AttributionRequired: true},
+}
+
+// rtlScripts are the ISO 15924 scripts written right to left
+var rtlScripts = []string{"Adlm", "Arab", "Hebr", "Mand", "Nkoo", "Rohg", "Samr", "Syrc", "Thaa"}
+
+// Normalize fills in what the metadata implies: the canonical language tag,
+// the script and direction of the language, and the name, URL and
+// attribution requirement of a known license
+func (t *Translation) Normalize() {
+	if tag, err := language.Parse(t.Language); err == nil {
+		t.Language = tag.String()
+		if script, confidence := tag.Script(); t.Script == "" && confidence != language.No {
+			t.Script = script.String()
+		}
+	}
+	if t.Direction == "" && t.Script != "" {
+		t.Direction = DirectionLTR
+		if slices.Contains(rtlScripts, t.Script) {
+			t.Direction = DirectionRTL
+		}
+	}
+	if known, ok := knownLicenses[t.License.ID]; ok {
+		if t.License.Name == "" {
+			t.License.Name = known.Name
+		}
+		if t.License.URL == "" {
+			t.License.URL = known.URL
+		}
+		t.License.AttributionRequired = t.License.AttributionRequired || known.AttributionRequired
+	}
+}
+
+// Validate checks the metadata, and that a license requiring attribution
+// comes with the attribution to show
+func (t *Translation) Validate() error {
+	var problems []string
+	if t.ID == "" || t.Name == "" {
+		problems = append(problems, "id and name are required")
+	}
+	if t.Language != "" {
+		if _, err := language.Parse(t.Language); err != nil {
+			problems = append(problems, fmt.Sprintf("language %q is not a BCP 47 tag", t.Language))
+		}
+	}
+	if t.Script != "" {
+		if _, err := language.ParseScript(t.Script); err != nil {
+			problems = append(problems, fmt.Sprintf("script %q is not an ISO 15924 code", t.Script))
+		}
+	}
+	if t.Direction != "" && t.Direction != DirectionLTR && t.Direction != DirectionRTL {
+		problems = append(problems, "direction must be ltr or rtl")
+	}
+	if t.Versification != "" && !versification.Known(t.Versification) {
+		problems = append(problems, fmt.Sprintf("versification must be one of %s", strings.Join(versification.Names(), ", ")))
+	}
+	if t.Year < 0 {
+		problems = append(problems, "year must not be negative")
+	}
+	if t.License.AttributionRequired && strings.TrimSpace(t.Attribution) == "" {
+		problems = append(problems, fmt.Sprintf("license %s requires an attribution", t.License.ID))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w %q: %s", ErrInvalidTranslation, t.ID, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// MatchesLanguage reports whether the translation's language is tag or a
+// more specific form of it: "zh" matches "zh-Hant" but not "zho"
+func (t *Translation) MatchesLanguage(tag string) bool {
+	return strings.EqualFold(t.Language, tag) || len(t.Language) > len(tag) && strings.EqualFold(t.Language[:len(tag)+1], tag+"-")
+}
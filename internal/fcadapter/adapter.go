@@ -0,0 +1,178 @@
+package fcadapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// NewRequest translates an HTTP trigger event into an *http.Request.
+// Multi-value headers and query parameters are kept, the query string is
+// properly escaped, base64 bodies are decoded and the source IP becomes
+// RemoteAddr, the client address seen by the logs and the rate limiter.
+func NewRequest(ctx context.Context, e *Event) (*http.Request, error) {
+	u, err := requestURL(e)
+	if err != nil {
+		return nil, err
+	}
+
+	body := []byte(e.Body)
+	if e.IsBase64Encoded {
+		body, err = base64.StdEncoding.DecodeString(e.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, e.Method(), "/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.URL = u
+	req.RequestURI = u.RequestURI()
+
+	if proto := e.RequestContext.HTTP.Protocol; proto != "" {
+		if major, minor, ok := http.ParseHTTPVersion(proto); ok {
+			req.Proto, req.ProtoMajor, req.ProtoMinor = proto, major, minor
+		}
+	}
+
+	for key, values := range e.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if len(e.Cookies) > 0 {
+		req.Header.Add("Cookie", strings.Join(e.Cookies, "; "))
+	}
+	if req.Header.Get("User-Agent") == "" && e.RequestContext.HTTP.UserAgent != "" {
+		req.Header.Set("User-Agent", e.RequestContext.HTTP.UserAgent)
+	}
+
+	req.Host = req.Header.Get("Host")
+	if req.Host == "" {
+		req.Host = e.RequestContext.DomainName
+	}
+	req.Header.Del("Host")
+
+	req.RemoteAddr = e.RequestContext.HTTP.SourceIP
+
+	return req, nil
+}
+
+// requestURL builds the request URL from the raw and decoded paths and the query parameters
+func requestURL(e *Event) (*url.URL, error) {
+	raw := e.RawPath
+	if raw == "" {
+		// The legacy path field carries the path as sent by the client
+		raw = e.Path
+	}
+	decoded := e.RequestContext.HTTP.Path
+
+	if raw != "" {
+		unescaped, err := url.PathUnescape(raw)
+		if err != nil {
+			if decoded == "" {
+				return nil, fmt.Errorf("invalid request path %q: %w", raw, err)
+			}
+			raw = ""
+		} else if decoded == "" {
+			decoded = unescaped
+		} else if unescaped != decoded {
+			// Disagreeing paths: trust the decoded one
+			raw = ""
+		}
+	}
+	if decoded == "" {
+		decoded = "/"
+	}
+
+	u := &url.URL{Path: decoded}
+	if raw != "" && raw != decoded {
+		u.RawPath = raw
+	}
+
+	query := url.Values{}
+	for key, values := range e.QueryParameters {
+		for _, value := range values {
+			query.Add(key, value)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u, nil
+}
+
+// NewResponse converts a recorded handler response into an HTTP trigger response.
+// Bodies that are not text are base64 encoded.
+func NewResponse(rec *httptest.ResponseRecorder) *Response {
+	response := &Response{
+		StatusCode: rec.Code,
+		Headers:    make(map[string]string, len(rec.Header())),
+	}
+
+	for key, values := range rec.Header() {
+		if len(values) == 0 {
+			continue
+		}
+		// Cookies may contain commas, so they are never joined
+		if http.CanonicalHeaderKey(key) == "Set-Cookie" {
+			response.Cookies = append(response.Cookies, values...)
+			continue
+		}
+		response.Headers[key] = strings.Join(values, ", ")
+	}
+
+	body := rec.Body.Bytes()
+	if isText(rec.Header().Get("Content-Type"), body) {
+		response.Body = string(body)
+	} else {
+		response.Body = base64.StdEncoding.EncodeToString(body)
+		response.IsBase64Encoded = true
+	}
+
+	return response
+}
+
+// ErrorResponse builds a JSON error response
+func ErrorResponse(status int, message string) *Response {
+	return &Response{
+		StatusCode: status,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       fmt.Sprintf(`{"error": %q}`, message),
+	}
+}
+
+// isText reports whether a body can be returned without base64 encoding
+func isText(contentType string, body []byte) bool {
+	if !utf8.Valid(body) {
+		return false
+	}
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "+json"),
+		strings.HasSuffix(mediaType, "+xml"),
+		mediaType == "application/json",
+		mediaType == "application/xml",
+		mediaType == "application/javascript",
+		mediaType == "application/x-www-form-urlencoded":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,315 @@
+package fcadapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+func loadEvent(t *testing.T, name string) *Event {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	var e Event
+	if err := json.Unmarshal(content, &e); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+	return &e
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		method     string
+		path       string
+		rawPath    string
+		query      map[string][]string
+		headers    map[string][]string
+		body       string
+		host       string
+		remoteAddr string
+		proto      string
+	}{
+		{
+			fixture: "get_passage_encoded.json",
+			method:  "GET",
+			path:    "/john 3:16",
+			rawPath: "/john%203:16",
+			query:   map[string][]string{"translation": {"cuv"}},
+			headers: map[string][]string{
+				"Accept":     {"application/json"},
+				"User-Agent": {"curl/8.4.0"},
+			},
+			host:       "bookofben-api-abcdefghij.ap-southeast-1.fcapp.run",
+			remoteAddr: "203.0.113.10",
+			proto:      "HTTP/1.1",
+		},
+		{
+			fixture: "chinese_reference.json",
+			method:  "GET",
+			path:    "/約翰福音 3:16",
+			rawPath: "/%E7%B4%84%E7%BF%B0%E7%A6%8F%E9%9F%B3%203:16",
+			query: map[string][]string{
+				"translation": {"cuv"},
+				"note":        {"神愛世人 & 永生"},
+			},
+			headers: map[string][]string{
+				"Accept-Language": {"zh-TW"},
+				"User-Agent":      {"Mozilla/5.0"},
+			},
+			host:       "bookofben-api-abcdefghij.ap-southeast-1.fcapp.run",
+			remoteAddr: "198.51.100.23",
+			proto:      "HTTP/1.1",
+		},
+		{
+			fixture: "multi_value.json",
+			method:  "GET",
+			path:    "/api/search",
+			query: map[string][]string{
+				"q":    {"the LORD would"},
+				"book": {"BEN", "JHN"},
+			},
+			headers: map[string][]string{
+				"Accept":          {"application/json", "text/plain"},
+				"X-Forwarded-For": {"198.51.100.7, 10.0.0.1"},
+			},
+			remoteAddr: "10.0.0.1",
+			proto:      "HTTP/2.0",
+		},
+		{
+			fixture: "base64_post.json",
+			method:  "POST",
+			path:    "/api/verses",
+			query:   map[string][]string{},
+			headers: map[string][]string{
+				"Content-Type":  {"application/json; charset=utf-8"},
+				"Authorization": {"Bearer secret"},
+				"Cookie":        {"session=abc123; theme=dark"},
+			},
+			body:       `{"book_id":"BEN","chapter":1,"verse":1,"text":"中文"}`,
+			remoteAddr: "192.0.2.44",
+			proto:      "HTTP/1.1",
+		},
+		{
+			fixture: "legacy.json",
+			method:  "GET",
+			path:    "/the book of jachanan ben kathryn 1",
+			rawPath: "/the%20book%20of%20jachanan%20ben%20kathryn%201",
+			query:   map[string][]string{"translation": {"en"}},
+			headers: map[string][]string{"Accept": {"application/json"}},
+			proto:   "HTTP/1.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			req, err := NewRequest(context.Background(), loadEvent(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+
+			if req.Method != tt.method {
+				t.Errorf("method = %q, want %q", req.Method, tt.method)
+			}
+			if req.URL.Path != tt.path {
+				t.Errorf("path = %q, want %q", req.URL.Path, tt.path)
+			}
+			if req.URL.RawPath != tt.rawPath {
+				t.Errorf("raw path = %q, want %q", req.URL.RawPath, tt.rawPath)
+			}
+			if got := map[string][]string(req.URL.Query()); !reflect.DeepEqual(got, tt.query) {
+				t.Errorf("query = %v, want %v", got, tt.query)
+			}
+			for key, want := range tt.headers {
+				if got := req.Header.Values(key); !reflect.DeepEqual(got, want) {
+					t.Errorf("header %s = %v, want %v", key, got, want)
+				}
+			}
+			if req.Host != tt.host {
+				t.Errorf("host = %q, want %q", req.Host, tt.host)
+			}
+			if req.RemoteAddr != tt.remoteAddr {
+				t.Errorf("remote addr = %q, want %q", req.RemoteAddr, tt.remoteAddr)
+			}
+			if req.Proto != tt.proto {
+				t.Errorf("proto = %q, want %q", req.Proto, tt.proto)
+			}
+
+			body, _ := io.ReadAll(req.Body)
+			if string(body) != tt.body {
+				t.Errorf("body = %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestNewRequestRouting(t *testing.T) {
+	tests := []struct {
+		fixture   string
+		reference string
+		clientIP  string
+	}{
+		{fixture: "get_passage_encoded.json", reference: "john%203:16", clientIP: "203.0.113.10"},
+		{fixture: "chinese_reference.json", reference: "%E7%B4%84%E7%BF%B0%E7%A6%8F%E9%9F%B3%203:16", clientIP: "198.51.100.23"},
+		{fixture: "legacy.json", reference: "the%20book%20of%20jachanan%20ben%20kathryn%201"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			var reference, clientIP string
+			r := chi.NewRouter()
+			r.Use(middleware.RealIP)
+			r.Get("/{reference}", func(w http.ResponseWriter, r *http.Request) {
+				reference = chi.URLParam(r, "reference")
+				clientIP = r.RemoteAddr
+			})
+
+			req, err := NewRequest(context.Background(), loadEvent(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			if reference != tt.reference {
+				t.Errorf("reference = %q, want %q", reference, tt.reference)
+			}
+			if clientIP != tt.clientIP {
+				t.Errorf("client IP = %q, want %q", clientIP, tt.clientIP)
+			}
+		})
+	}
+}
+
+func TestNewRequestInvalidBase64(t *testing.T) {
+	e := loadEvent(t, "base64_post.json")
+	e.Body = "not base64!"
+
+	if _, err := NewRequest(context.Background(), e); err == nil {
+		t.Fatal("expected an error for an invalid base64 body")
+	}
+}
+
+func TestNewResponse(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a, 0xff, 0x00}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		cookies     []string
+		wantBase64  bool
+	}{
+		{name: "json", contentType: "application/json; charset=utf-8", body: []byte(`{"text":"神愛世人"}`)},
+		{name: "plain text", contentType: "text/plain; charset=utf-8", body: []byte("Bad request\n")},
+		{name: "binary", contentType: "image/png", body: png, wantBase64: true},
+		{name: "octet stream", contentType: "application/octet-stream", body: []byte("abc"), wantBase64: true},
+		{name: "cookies", contentType: "text/plain", body: []byte("ok"), cookies: []string{"a=1; Path=/", "b=2; Expires=Wed, 21 Oct 2026 07:28:00 GMT"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			rec.Header().Set("Content-Type", tt.contentType)
+			rec.Header().Add("Vary", "Origin")
+			rec.Header().Add("Vary", "Accept")
+			for _, cookie := range tt.cookies {
+				rec.Header().Add("Set-Cookie", cookie)
+			}
+			rec.WriteHeader(http.StatusCreated)
+			rec.Write(tt.body)
+
+			res := NewResponse(rec)
+
+			if res.StatusCode != http.StatusCreated {
+				t.Errorf("status = %d, want %d", res.StatusCode, http.StatusCreated)
+			}
+			if res.IsBase64Encoded != tt.wantBase64 {
+				t.Errorf("isBase64Encoded = %v, want %v", res.IsBase64Encoded, tt.wantBase64)
+			}
+			body := []byte(res.Body)
+			if res.IsBase64Encoded {
+				var err error
+				if body, err = base64.StdEncoding.DecodeString(res.Body); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+			}
+			if string(body) != string(tt.body) {
+				t.Errorf("body = %q, want %q", body, tt.body)
+			}
+			if got := res.Headers["Vary"]; got != "Origin, Accept" {
+				t.Errorf("Vary = %q, want %q", got, "Origin, Accept")
+			}
+			if !reflect.DeepEqual(res.Cookies, tt.cookies) {
+				t.Errorf("cookies = %v, want %v", res.Cookies, tt.cookies)
+			}
+		})
+	}
+}
+
+func TestFromHTTPRequestRoundTrip(t *testing.T) {
+	body := []byte{0x00, 0xff, 0x10, 'B', 'E', 'N'}
+	original := httptest.NewRequest(http.MethodPost, "/%E7%B4%84%E7%BF%B0%E7%A6%8F%E9%9F%B3%203:16?q=a+b&q=c", bytes.NewReader(body))
+	original.Header.Set("Content-Type", "application/octet-stream")
+	original.Header.Add("Accept", "application/json")
+	original.Header.Add("Accept", "text/plain")
+	original.RemoteAddr = "192.0.2.1:4321"
+
+	event, err := FromHTTPRequest(original, "1-test")
+	if err != nil {
+		t.Fatalf("FromHTTPRequest: %v", err)
+	}
+	if !event.IsBase64Encoded {
+		t.Error("binary body should be base64 encoded")
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded Event
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	req, err := NewRequest(context.Background(), &decoded)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if req.Method != http.MethodPost {
+		t.Errorf("method = %q", req.Method)
+	}
+	if req.URL.Path != "/約翰福音 3:16" {
+		t.Errorf("path = %q", req.URL.Path)
+	}
+	if got := req.URL.Query()["q"]; !reflect.DeepEqual(got, []string{"a b", "c"}) {
+		t.Errorf("query q = %v", got)
+	}
+	if got := req.Header.Values("Accept"); !reflect.DeepEqual(got, []string{"application/json", "text/plain"}) {
+		t.Errorf("Accept = %v", got)
+	}
+	if req.Host != "example.com" {
+		t.Errorf("host = %q", req.Host)
+	}
+	if req.RemoteAddr != "192.0.2.1" {
+		t.Errorf("remote addr = %q", req.RemoteAddr)
+	}
+	got, _ := io.ReadAll(req.Body)
+	if !bytes.Equal(got, body) {
+		t.Errorf("body = %v, want %v", got, body)
+	}
+}
@@ -0,0 +1,90 @@
+package fcadapter
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// FromHTTPRequest builds the HTTP trigger event Function Compute would deliver for r.
+// It is the inverse of NewRequest and is used by the local emulator.
+func FromHTTPRequest(r *http.Request, requestID string) (*Event, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	now := time.Now().UTC()
+	e := &Event{
+		Version:         "v1",
+		RawPath:         r.URL.EscapedPath(),
+		Headers:         make(map[string]MultiValue, len(r.Header)+1),
+		QueryParameters: make(map[string]MultiValue),
+		RequestContext: RequestContext{
+			AccountID:    "local",
+			DomainName:   r.Host,
+			DomainPrefix: "local",
+			HTTP: HTTPContext{
+				Method:    r.Method,
+				Path:      r.URL.Path,
+				Protocol:  r.Proto,
+				SourceIP:  sourceIP(r.RemoteAddr),
+				UserAgent: r.UserAgent(),
+			},
+			RequestID: requestID,
+			Time:      now.Format(time.RFC3339),
+			TimeEpoch: strconv.FormatInt(now.UnixMilli(), 10),
+		},
+	}
+
+	for key, values := range r.Header {
+		e.Headers[key] = MultiValue(values)
+	}
+	if r.Host != "" {
+		e.Headers["Host"] = MultiValue{r.Host}
+	}
+	for key, values := range r.URL.Query() {
+		e.QueryParameters[key] = MultiValue(values)
+	}
+
+	if isText(r.Header.Get("Content-Type"), body) {
+		e.Body = string(body)
+	} else {
+		e.Body = base64.StdEncoding.EncodeToString(body)
+		e.IsBase64Encoded = true
+	}
+
+	return e, nil
+}
+
+// WriteResponse writes an HTTP trigger response to w
+func WriteResponse(w http.ResponseWriter, res *Response) error {
+	body := []byte(res.Body)
+	if res.IsBase64Encoded {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(res.Body); err != nil {
+			return fmt.Errorf("failed to decode base64 response body: %w", err)
+		}
+	}
+
+	for key, value := range res.Headers {
+		w.Header().Set(key, value)
+	}
+	for _, cookie := range res.Cookies {
+		w.Header().Add("Set-Cookie", cookie)
+	}
+	w.WriteHeader(res.StatusCode)
+	_, err := w.Write(body)
+	return err
+}
+
+func sourceIP(remoteAddr string) string {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
@@ -0,0 +1,100 @@
+package fcadapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Event is the request payload delivered by a Function Compute HTTP trigger.
+// The legacy httpMethod/path fields of older event formats are accepted as well.
+type Event struct {
+	Version         string                `json:"version,omitempty"`
+	RawPath         string                `json:"rawPath,omitempty"`
+	HTTPMethod      string                `json:"httpMethod,omitempty"`
+	Path            string                `json:"path,omitempty"`
+	Headers         map[string]MultiValue `json:"headers,omitempty"`
+	QueryParameters map[string]MultiValue `json:"queryParameters,omitempty"`
+	Cookies         []string              `json:"cookies,omitempty"`
+	Body            string                `json:"body,omitempty"`
+	IsBase64Encoded bool                  `json:"isBase64Encoded"`
+	RequestContext  RequestContext        `json:"requestContext"`
+}
+
+// RequestContext describes the invocation of an HTTP trigger event
+type RequestContext struct {
+	AccountID    string      `json:"accountId,omitempty"`
+	DomainName   string      `json:"domainName,omitempty"`
+	DomainPrefix string      `json:"domainPrefix,omitempty"`
+	HTTP         HTTPContext `json:"http"`
+	RequestID    string      `json:"requestId,omitempty"`
+	Time         string      `json:"time,omitempty"`
+	TimeEpoch    string      `json:"timeEpoch,omitempty"`
+}
+
+// HTTPContext carries the HTTP details of the original request
+type HTTPContext struct {
+	Method    string `json:"method,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	SourceIP  string `json:"sourceIp,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+}
+
+// Response is the HTTP trigger response payload
+type Response struct {
+	StatusCode      int               `json:"statusCode"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Cookies         []string          `json:"cookies,omitempty"`
+	IsBase64Encoded bool              `json:"isBase64Encoded"`
+	Body            string            `json:"body"`
+}
+
+// MultiValue is a header or query value that arrives either as a single
+// string or as a list of strings
+type MultiValue []string
+
+// UnmarshalJSON accepts "value" as well as ["value1", "value2"]
+func (m *MultiValue) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*m = MultiValue{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("value must be a string or a list of strings: %w", err)
+	}
+	*m = list
+	return nil
+}
+
+// MarshalJSON writes a single value as a plain string
+func (m MultiValue) MarshalJSON() ([]byte, error) {
+	if len(m) == 1 {
+		return json.Marshal(m[0])
+	}
+	return json.Marshal([]string(m))
+}
+
+// Method returns the HTTP method of the event
+func (e *Event) Method() string {
+	if e.RequestContext.HTTP.Method != "" {
+		return strings.ToUpper(e.RequestContext.HTTP.Method)
+	}
+	if e.HTTPMethod != "" {
+		return strings.ToUpper(e.HTTPMethod)
+	}
+	return "GET"
+}
+
+// Header returns the first value of the named header, matched case-insensitively
+func (e *Event) Header(name string) string {
+	for key, values := range e.Headers {
+		if strings.EqualFold(key, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,157 @@
+package fcapp
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+
+	"github.com/aliyun/fc-runtime-go-sdk/fccontext"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/fcadapter"
+	"github.com/tkdnbb/bookofben-api/internal/health"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/routes"
+	"github.com/tkdnbb/bookofben-api/internal/startup"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	router          http.Handler
+	shutdownTracing = func(context.Context) error { return nil }
+	coldStart       atomic.Bool
+	logLevel        = new(slog.LevelVar)
+)
+
+// fcLogger returns a structured logger bridged into the fccontext logger of ctx
+func fcLogger(ctx context.Context) *slog.Logger {
+	fctx, ok := fccontext.FromContext(ctx)
+	if !ok {
+		return slog.Default()
+	}
+	return logging.NewFCLogger(fctx.GetLogger(), logLevel).With(slog.String("fc_request_id", fctx.RequestID))
+}
+
+// HandleRequest serves one HTTP trigger invocation
+func HandleRequest(ctx context.Context, event []byte) (*fcadapter.Response, error) {
+	fctx, _ := fccontext.FromContext(ctx)
+	logger := fcLogger(ctx)
+	ctx = logging.WithLogger(ctx, logger)
+
+	// 解析事件
+	var fcEvent fcadapter.Event
+	if err := json.Unmarshal(event, &fcEvent); err != nil {
+		logger.Error("error parsing event", "error", err)
+		return fcadapter.ErrorResponse(http.StatusBadRequest, "Invalid request format"), nil
+	}
+
+	// 创建 HTTP 请求
+	req, err := fcadapter.NewRequest(ctx, &fcEvent)
+	if err != nil {
+		logger.Error("error creating HTTP request", "error", err)
+		return fcadapter.ErrorResponse(http.StatusBadRequest, "Invalid request format"), nil
+	}
+
+	logger.Debug("processing request", "method", req.Method, "path", req.URL.Path)
+
+	// 从请求头中恢复链路上下文
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header))
+	ctx, span := tracing.Start(ctx, "fc.invoke",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.FaaSTriggerHTTP,
+			semconv.FaaSInvocationID(fctx.RequestID),
+			semconv.FaaSColdstart(coldStart.Swap(false)),
+		),
+	)
+	defer span.End()
+	req = req.WithContext(ctx)
+
+	// 复用函数计算的请求 ID，使两种部署方式的日志可以关联
+	if req.Header.Get(middleware.RequestIDHeader) == "" {
+		req.Header.Set(middleware.RequestIDHeader, fctx.RequestID)
+	}
+
+	// 创建响应记录器
+	w := httptest.NewRecorder()
+
+	// 处理请求
+	router.ServeHTTP(w, req)
+
+	// 构建函数计算响应
+	return fcadapter.NewResponse(w), nil
+}
+
+// Initialize is the instance initializer hook
+func Initialize(ctx context.Context) {
+	startup.Begin()
+
+	// 加载配置
+	done := startup.Track("config")
+	cfg, err := config.Load("")
+	done()
+	if err != nil {
+		fcLogger(ctx).Error("failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	logLevel.Set(logging.ParseLevel(cfg.Log.Level))
+
+	logger := fcLogger(ctx)
+	slog.SetDefault(logger)
+	ctx = logging.WithLogger(ctx, logger)
+
+	logger.Info("initializing Bible API Server", "config", cfg.Redacted())
+	coldStart.Store(true)
+
+	// 初始化链路追踪
+	done = startup.Track("tracing")
+	shutdown, err := tracing.Setup(ctx, "bookofben-api-fc", cfg.Tracing.Exporter)
+	if err != nil {
+		logger.Error("error initializing tracing", "error", err)
+	} else {
+		shutdownTracing = shutdown
+	}
+	done()
+
+	ctx, span := tracing.Start(ctx, "fc.initialize", trace.WithAttributes(semconv.FaaSColdstart(true)))
+	defer span.End()
+
+	// 初始化路由；数据库连接在第一次查询时建立并在调用之间复用
+	done = startup.Track("routes")
+	router = routes.SetupRoutes(ctx, cfg)
+	done()
+
+	startup.Finish(logger)
+	health.SetReady(true)
+	logger.Info("Bible API Server initialized")
+}
+
+// PreStop is the instance pre-stop hook
+func PreStop(ctx context.Context) {
+	logger := fcLogger(ctx)
+
+	logger.Info("shutting down Bible API Server")
+	health.SetReady(false)
+
+	// 关闭数据库连接
+	if err := routes.CloseDatabase(); err != nil {
+		logger.Error("error closing database", "error", err)
+	} else {
+		logger.Info("database connection closed")
+	}
+
+	// 导出剩余的 span
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error("error flushing traces", "error", err)
+	}
+
+	logger.Info("Bible API Server shutdown completed")
+}
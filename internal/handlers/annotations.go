@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/annotations"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// AnnotationHandler handles the caller's bookmarks, highlights and notes
+type AnnotationHandler struct {
+	service *services.AnnotationService
+}
+
+// NewAnnotationHandler creates a new AnnotationHandler instance
+func NewAnnotationHandler(service *services.AnnotationService) *AnnotationHandler {
+	return &AnnotationHandler{service: service}
+}
+
+// ListBookmarks handles GET /api/me/bookmarks?reference=&folder=&tag=
+func (h *AnnotationHandler) ListBookmarks(w http.ResponseWriter, r *http.Request) {
+	filter, ok := h.filter(w, r)
+	if !ok {
+		return
+	}
+	filter.Folder = r.URL.Query().Get("folder")
+	filter.Tag = r.URL.Query().Get("tag")
+	bookmarks, err := h.service.ListBookmarks(r.Context(), filter)
+	h.respond(w, r, http.StatusOK, bookmarks, err)
+}
+
+// Folders handles GET /api/me/bookmarks/folders
+func (h *AnnotationHandler) Folders(w http.ResponseWriter, r *http.Request) {
+	folders, err := h.service.Folders(r.Context())
+	h.respond(w, r, http.StatusOK, folders, err)
+}
+
+// CreateBookmark handles POST /api/me/bookmarks
+func (h *AnnotationHandler) CreateBookmark(w http.ResponseWriter, r *http.Request) {
+	var request models.BookmarkRequest
+	if !decodeAnnotation(w, r, &request) {
+		return
+	}
+	bookmark, err := h.service.CreateBookmark(r.Context(), request)
+	h.respond(w, r, http.StatusCreated, bookmark, err)
+}
+
+// UpdateBookmark handles PATCH /api/me/bookmarks/{id}
+func (h *AnnotationHandler) UpdateBookmark(w http.ResponseWriter, r *http.Request) {
+	var request models.BookmarkRequest
+	if !decodeAnnotation(w, r, &request) {
+		return
+	}
+	bookmark, err := h.service.UpdateBookmark(r.Context(), chi.URLParam(r, "id"), request)
+	h.respond(w, r, http.StatusOK, bookmark, err)
+}
+
+// DeleteBookmark handles DELETE /api/me/bookmarks/{id}
+func (h *AnnotationHandler) DeleteBookmark(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, http.StatusNoContent, nil, h.service.DeleteBookmark(r.Context(), chi.URLParam(r, "id")))
+}
+
+// ListHighlights handles GET /api/me/highlights?reference=
+func (h *AnnotationHandler) ListHighlights(w http.ResponseWriter, r *http.Request) {
+	filter, ok := h.filter(w, r)
+	if !ok {
+		return
+	}
+	highlights, err := h.service.ListHighlights(r.Context(), filter)
+	h.respond(w, r, http.StatusOK, highlights, err)
+}
+
+// CreateHighlight handles POST /api/me/highlights
+func (h *AnnotationHandler) CreateHighlight(w http.ResponseWriter, r *http.Request) {
+	var request models.HighlightRequest
+	if !decodeAnnotation(w, r, &request) {
+		return
+	}
+	highlight, err := h.service.CreateHighlight(r.Context(), request)
+	h.respond(w, r, http.StatusCreated, highlight, err)
+}
+
+// UpdateHighlight handles PATCH /api/me/highlights/{id}
+func (h *AnnotationHandler) UpdateHighlight(w http.ResponseWriter, r *http.Request) {
+	var request models.HighlightRequest
+	if !decodeAnnotation(w, r, &request) {
+		return
+	}
+	highlight, err := h.service.UpdateHighlight(r.Context(), chi.URLParam(r, "id"), request)
+	h.respond(w, r, http.StatusOK, highlight, err)
+}
+
+// DeleteHighlight handles DELETE /api/me/highlights/{id}
+func (h *AnnotationHandler) DeleteHighlight(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, http.StatusNoContent, nil, h.service.DeleteHighlight(r.Context(), chi.URLParam(r, "id")))
+}
+
+// ListNotes handles GET /api/me/notes?reference=
+func (h *AnnotationHandler) ListNotes(w http.ResponseWriter, r *http.Request) {
+	filter, ok := h.filter(w, r)
+	if !ok {
+		return
+	}
+	notes, err := h.service.ListNotes(r.Context(), filter)
+	h.respond(w, r, http.StatusOK, notes, err)
+}
+
+// CreateNote handles POST /api/me/notes
+func (h *AnnotationHandler) CreateNote(w http.ResponseWriter, r *http.Request) {
+	var request models.NoteRequest
+	if !decodeAnnotation(w, r, &request) {
+		return
+	}
+	note, err := h.service.CreateNote(r.Context(), request)
+	h.respond(w, r, http.StatusCreated, note, err)
+}
+
+// UpdateNote handles PATCH /api/me/notes/{id}
+func (h *AnnotationHandler) UpdateNote(w http.ResponseWriter, r *http.Request) {
+	var request models.NoteRequest
+	if !decodeAnnotation(w, r, &request) {
+		return
+	}
+	note, err := h.service.UpdateNote(r.Context(), chi.URLParam(r, "id"), request)
+	h.respond(w, r, http.StatusOK, note, err)
+}
+
+// DeleteNote handles DELETE /api/me/notes/{id}
+func (h *AnnotationHandler) DeleteNote(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, http.StatusNoContent, nil, h.service.DeleteNote(r.Context(), chi.URLParam(r, "id")))
+}
+
+func (h *AnnotationHandler) filter(w http.ResponseWriter, r *http.Request) (annotations.Filter, bool) {
+	filter, err := h.service.Filter(r.URL.Query().Get("reference"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return filter, false
+	}
+	return filter, true
+}
+
+func decodeAnnotation(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (h *AnnotationHandler) respond(w http.ResponseWriter, r *http.Request, status int, v any, err error) {
+	switch {
+	case err == nil && status == http.StatusNoContent:
+		w.WriteHeader(status)
+	case err == nil:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+	case errors.Is(err, services.ErrAnnotationNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, services.ErrInvalidAnnotation):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logging.FromContext(r.Context()).Error("annotation request failed", "error", err)
+		http.Error(w, "Annotation request failed", http.StatusInternalServerError)
+	}
+}
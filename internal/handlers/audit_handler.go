@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+)
+
+// maxAuditLimit bounds the number of entries of one response
+const maxAuditLimit = 1000
+
+// AuditHandler serves the audit log
+type AuditHandler struct {
+	store *audit.Store
+}
+
+// NewAuditHandler creates a new AuditHandler instance
+func NewAuditHandler(store *audit.Store) *AuditHandler {
+	return &AuditHandler{store: store}
+}
+
+// List handles GET /api/audit?actor=&target_type=&target_id=&since=&limit=
+func (h *AuditHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := audit.Filter{
+		Actor:      query.Get("actor"),
+		TargetType: query.Get("target_type"),
+		TargetID:   query.Get("target_id"),
+	}
+	if since := query.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			http.Error(w, "since must be an RFC 3339 time", http.StatusBadRequest)
+			return
+		}
+		filter.Since = t
+	}
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 || n > maxAuditLimit {
+			http.Error(w, "limit must be between 1 and 1000", http.StatusBadRequest)
+			return
+		}
+		filter.Limit = int64(n)
+	}
+
+	entries, err := h.store.List(r.Context(), filter)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to list audit entries", "error", err)
+		http.Error(w, "Failed to list audit entries", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(entries)
+}
@@ -2,45 +2,144 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
 	"github.com/tkdnbb/bookofben-api/internal/models"
 	"github.com/tkdnbb/bookofben-api/internal/services"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // BibleHandler handles HTTP requests for Bible API
 type BibleHandler struct {
-	service *services.BibleService
+	service     *services.BibleService
+	annotations *services.AnnotationService
+	crossrefs   *services.CrossReferenceService
 }
 
 // NewBibleHandler creates a new BibleHandler instance
-func NewBibleHandler() *BibleHandler {
+func NewBibleHandler(service *services.BibleService, annotations *services.AnnotationService, crossrefs *services.CrossReferenceService) *BibleHandler {
 	return &BibleHandler{
-		service: services.NewBibleService(),
+		service:     service,
+		annotations: annotations,
+		crossrefs:   crossrefs,
 	}
 }
 
-// GetBiblePassage handles GET /{reference}
+// WantsAnnotations reports whether a passage request asks for the caller's annotations
+func WantsAnnotations(r *http.Request) bool {
+	return r.URL.Query().Get("annotations") == "true"
+}
+
+// GetBiblePassage handles GET /{reference}?translation=&versification=&annotations=true&crossrefs=true.
+// The reference is numbered in the versification scheme given, kjv by default.
+// With crossrefs the filters of GET /api/crossrefs/{reference} apply.
 func (h *BibleHandler) GetBiblePassage(w http.ResponseWriter, r *http.Request) {
 	// Decode URL parameter
 	reference, _ := url.QueryUnescape(chi.URLParam(r, "reference"))
 	translation := r.URL.Query().Get("translation")
+	withCrossReferences := r.URL.Query().Get("crossrefs") == "true"
+	crossrefOpts, err := crossReferenceOptions(r)
+	if withCrossReferences && err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	response, err := h.service.GetPassage(reference, translation)
-	if err != nil {
+	response, err := h.service.GetPassageIn(r.Context(), reference, translation, r.URL.Query().Get("versification"))
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrAttributionMissing):
+		// A misconfigured translation, not a bad request: its text must not be served unattributed
+		logging.FromContext(r.Context()).Error("refusing to serve passage", "error", err)
+		http.Error(w, "translation is unavailable", http.StatusInternalServerError)
+		return
+	case errors.Is(err, services.ErrNoVerses):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, services.ErrInvalidReference),
+		errors.Is(err, services.ErrBookNotFound),
+		errors.Is(err, services.ErrTranslationNotFound),
+		errors.Is(err, services.ErrUnknownVersification):
+		tracing.RecordError(trace.SpanFromContext(r.Context()), err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
+	default:
+		logging.FromContext(r.Context()).Error("failed to get passage", "error", err)
+		http.Error(w, "Failed to get passage", http.StatusInternalServerError)
+		return
+	}
+
+	// Applied to the caller's copy after the cache lookup so annotations are never cached
+	if WantsAnnotations(r) {
+		if err := h.annotations.Overlay(r.Context(), response); err != nil {
+			logging.FromContext(r.Context()).Error("failed to overlay annotations", "error", err)
+			http.Error(w, "Failed to load annotations", http.StatusInternalServerError)
+			return
+		}
+	}
+	if withCrossReferences {
+		if err := h.crossrefs.Embed(r.Context(), response, crossrefOpts); err != nil {
+			writeCrossReferenceError(w, r, err)
+			return
+		}
 	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	json.NewEncoder(w).Encode(response)
 }
 
-// GetTranslations handles GET /api/translations
+// GetParallel handles GET /api/parallel/{reference}?translations=kjv,cuv&versification=
+func (h *BibleHandler) GetParallel(w http.ResponseWriter, r *http.Request) {
+	reference, _ := url.QueryUnescape(chi.URLParam(r, "reference"))
+	var translations []string
+	for _, id := range strings.Split(r.URL.Query().Get("translations"), ",") {
+		if id = strings.TrimSpace(id); id != "" && !slices.Contains(translations, id) {
+			translations = append(translations, id)
+		}
+	}
+
+	parallel, err := h.service.GetParallel(r.Context(), reference, translations, r.URL.Query().Get("versification"))
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrNoVerses):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, services.ErrInvalidParallel),
+		errors.Is(err, services.ErrUnknownVersification),
+		errors.Is(err, services.ErrInvalidReference),
+		errors.Is(err, services.ErrBookNotFound),
+		errors.Is(err, services.ErrTranslationNotFound):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	default:
+		logging.FromContext(r.Context()).Error("failed to build parallel view", "error", err)
+		http.Error(w, "Failed to build parallel view", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(parallel)
+}
+
+// GetTranslations handles GET /api/translations?language=
 func (h *BibleHandler) GetTranslations(w http.ResponseWriter, r *http.Request) {
-	translations := h.service.GetTranslations()
+	translations, err := h.service.GetTranslations(r.Context(), r.URL.Query().Get("language"))
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidLanguage):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	default:
+		logging.FromContext(r.Context()).Error("failed to list translations", "error", err)
+		http.Error(w, "Failed to list translations", http.StatusInternalServerError)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	json.NewEncoder(w).Encode(translations)
@@ -48,12 +147,30 @@ func (h *BibleHandler) GetTranslations(w http.ResponseWriter, r *http.Request) {
 
 // GetBooks handles GET /api/books
 func (h *BibleHandler) GetBooks(w http.ResponseWriter, r *http.Request) {
-	books := h.service.GetBooks()
+	books := h.service.GetBooks(r.Context())
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	json.NewEncoder(w).Encode(books)
 }
 
+// GetBookOutline handles GET /api/books/{book}/outline?translation=
+func (h *BibleHandler) GetBookOutline(w http.ResponseWriter, r *http.Request) {
+	outline, err := h.service.GetBookOutline(r.Context(), chi.URLParam(r, "book"), r.URL.Query().Get("translation"))
+	if errors.Is(err, services.ErrBookNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		tracing.RecordError(trace.SpanFromContext(r.Context()), err)
+		logging.FromContext(r.Context()).Error("failed to build book outline", "error", err)
+		http.Error(w, "Failed to build book outline", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(outline)
+}
+
 // AddVerse handles POST /api/verses
 func (h *BibleHandler) AddVerse(w http.ResponseWriter, r *http.Request) {
 	var verse models.Verse
@@ -62,15 +179,14 @@ func (h *BibleHandler) AddVerse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 验证必填字段
-	if verse.BookID == "" || verse.Text == "" || verse.Chapter <= 0 || verse.Verse <= 0 {
-		http.Error(w, "Missing required fields: book_id, text, chapter, verse", http.StatusBadRequest)
-		return
+	// 未指定翻译时沿用默认翻译
+	if verse.TranslationID == "" {
+		verse.TranslationID = "en"
 	}
 
-	err := h.service.AddVerse(verse)
+	err := h.service.AddVerse(r.Context(), verse)
 	if err != nil {
-		http.Error(w, "Failed to insert verse", http.StatusInternalServerError)
+		h.writeVerseError(w, r, err, "failed to insert verse")
 		return
 	}
 
@@ -86,8 +202,9 @@ func (h *BibleHandler) SearchVerses(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	verses, err := h.service.SearchVerses(query)
+	verses, err := h.service.SearchVerses(r.Context(), query)
 	if err != nil {
+		logging.FromContext(r.Context()).Error("search failed", "query", query, "error", err)
 		http.Error(w, "Search failed", http.StatusInternalServerError)
 		return
 	}
@@ -101,3 +218,11 @@ func (h *BibleHandler) SearchVerses(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	json.NewEncoder(w).Encode(response)
 }
+
+// GetCacheStats handles GET /api/cache/stats
+func (h *BibleHandler) GetCacheStats(w http.ResponseWriter, r *http.Request) {
+	stats := h.service.CacheStats()
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(stats)
+}
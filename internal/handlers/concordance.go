@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// ConcordanceHandler handles concordance lookups
+type ConcordanceHandler struct {
+	service *services.ConcordanceService
+}
+
+// NewConcordanceHandler creates a new ConcordanceHandler instance
+func NewConcordanceHandler(service *services.ConcordanceService) *ConcordanceHandler {
+	return &ConcordanceHandler{service: service}
+}
+
+// Occurrences handles GET /api/concordance/words/{word}?translation=&lemma=&book=&offset=&limit=
+func (h *ConcordanceHandler) Occurrences(w http.ResponseWriter, r *http.Request) {
+	opts, err := concordanceOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	word, _ := url.PathUnescape(chi.URLParam(r, "word"))
+
+	occurrences, err := h.service.Occurrences(r.Context(), word, opts)
+	if err != nil {
+		writeConcordanceError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(occurrences)
+}
+
+// Counts handles GET /api/concordance/words/{word}/counts?translation=&lemma=&book=
+func (h *ConcordanceHandler) Counts(w http.ResponseWriter, r *http.Request) {
+	opts, err := concordanceOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	word, _ := url.PathUnescape(chi.URLParam(r, "word"))
+
+	counts, err := h.service.Counts(r.Context(), word, opts)
+	if err != nil {
+		writeConcordanceError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(counts)
+}
+
+// Frequencies handles GET /api/concordance/frequencies?translation=&book=&lemma=&include_stopwords=&limit=
+func (h *ConcordanceHandler) Frequencies(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := services.FrequencyOptions{
+		Translation:      query.Get("translation"),
+		Book:             query.Get("book"),
+		Lemma:            query.Get("lemma") == "true",
+		IncludeStopwords: query.Get("include_stopwords") == "true",
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || limit < 1 || limit > services.MaxFrequencies {
+			http.Error(w, "limit must be between 1 and "+strconv.Itoa(services.MaxFrequencies), http.StatusBadRequest)
+			return
+		}
+		opts.Limit = limit
+	}
+
+	frequencies, err := h.service.Frequencies(r.Context(), opts)
+	if err != nil {
+		writeConcordanceError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(frequencies)
+}
+
+// concordanceOptions reads the filters and page of a word lookup
+func concordanceOptions(r *http.Request) (services.ConcordanceOptions, error) {
+	query := r.URL.Query()
+	opts := services.ConcordanceOptions{
+		Translation: query.Get("translation"),
+		Lemma:       query.Get("lemma") == "true",
+		Book:        query.Get("book"),
+	}
+	if v := query.Get("offset"); v != "" {
+		offset, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || offset < 0 {
+			return opts, errors.New("offset must be a non-negative number")
+		}
+		opts.Offset = offset
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || limit < 1 || limit > services.MaxOccurrences {
+			return opts, errors.New("limit must be between 1 and " + strconv.Itoa(services.MaxOccurrences))
+		}
+		opts.Limit = limit
+	}
+	return opts, nil
+}
+
+func writeConcordanceError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidWord),
+		errors.Is(err, services.ErrTranslationNotFound):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logging.FromContext(r.Context()).Error("concordance lookup failed", "error", err)
+		http.Error(w, "Failed to search the concordance", http.StatusInternalServerError)
+	}
+}
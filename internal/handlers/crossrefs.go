@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// CrossReferenceHandler handles cross-reference lookups
+type CrossReferenceHandler struct {
+	service *services.CrossReferenceService
+}
+
+// NewCrossReferenceHandler creates a new CrossReferenceHandler instance
+func NewCrossReferenceHandler(service *services.CrossReferenceService) *CrossReferenceHandler {
+	return &CrossReferenceHandler{service: service}
+}
+
+// List handles GET /api/crossrefs/{reference}?type=&dataset=&min_weight=&limit=
+func (h *CrossReferenceHandler) List(w http.ResponseWriter, r *http.Request) {
+	opts, err := crossReferenceOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	reference, _ := url.QueryUnescape(chi.URLParam(r, "reference"))
+
+	refs, err := h.service.ForPassage(r.Context(), reference, opts)
+	if err != nil {
+		writeCrossReferenceError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(refs)
+}
+
+// crossReferenceOptions reads the cross-reference filters of a request
+func crossReferenceOptions(r *http.Request) (services.CrossReferenceOptions, error) {
+	query := r.URL.Query()
+	opts := services.CrossReferenceOptions{Type: query.Get("type"), Dataset: query.Get("dataset")}
+	if v := query.Get("min_weight"); v != "" {
+		weight, err := strconv.Atoi(v)
+		if err != nil {
+			return opts, errors.New("min_weight must be a number")
+		}
+		opts.MinWeight = &weight
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || limit < 1 || limit > services.MaxCrossReferences {
+			return opts, errors.New("limit must be between 1 and " + strconv.Itoa(services.MaxCrossReferences))
+		}
+		opts.Limit = limit
+	}
+	return opts, nil
+}
+
+func writeCrossReferenceError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidReference),
+		errors.Is(err, services.ErrBookNotFound),
+		errors.Is(err, services.ErrInvalidCrossReferenceType):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logging.FromContext(r.Context()).Error("cross-reference lookup failed", "error", err)
+		http.Error(w, "Failed to load cross-references", http.StatusInternalServerError)
+	}
+}
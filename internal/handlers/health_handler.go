@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// HealthHandler handles probe and version requests
+type HealthHandler struct {
+	service *services.HealthService
+	started time.Time
+}
+
+// NewHealthHandler creates a new HealthHandler instance
+func NewHealthHandler(service *services.HealthService) *HealthHandler {
+	return &HealthHandler{service: service, started: time.Now()}
+}
+
+// Liveness handles GET /healthz. It only reports that the process is serving.
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"status":         "ok",
+		"uptime_seconds": int64(time.Since(h.started).Seconds()),
+	})
+}
+
+// Readiness handles GET /readyz. It responds 503 when a critical dependency
+// fails and explains which one in the body.
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := h.service.Readiness(r.Context())
+
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, report)
+}
+
+// Version handles GET /version
+func (h *HealthHandler) Version(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.service.Version(r.Context()))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// GetRandomPassage handles GET /api/random?translation=&book=&testament=&chapters=&count=&seed=
+// book takes comma separated book IDs and chapters a range such as "3-10".
+func (h *BibleHandler) GetRandomPassage(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	opts := services.RandomOptions{
+		Translation: query.Get("translation"),
+		Testament:   strings.ToLower(query.Get("testament")),
+		Seed:        query.Get("seed"),
+	}
+	if books := query.Get("book"); books != "" {
+		for _, id := range strings.Split(books, ",") {
+			opts.Books = append(opts.Books, strings.ToUpper(strings.TrimSpace(id)))
+		}
+	}
+	if chapters := query.Get("chapters"); chapters != "" {
+		from, to, isRange := strings.Cut(chapters, "-")
+		var err1, err2 error
+		opts.ChapterFrom, err1 = strconv.Atoi(from)
+		opts.ChapterTo = opts.ChapterFrom
+		if isRange {
+			opts.ChapterTo, err2 = strconv.Atoi(to)
+		}
+		if err1 != nil || err2 != nil || opts.ChapterFrom < 1 || opts.ChapterTo < opts.ChapterFrom {
+			http.Error(w, "chapters must be a chapter or a range such as 3-10", http.StatusBadRequest)
+			return
+		}
+	}
+	if count := query.Get("count"); count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 1 {
+			http.Error(w, services.ErrInvalidCount.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Count = n
+	}
+
+	passage, err := h.service.RandomPassage(r.Context(), opts)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrNoMatchingVerses):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, services.ErrInvalidCount),
+		errors.Is(err, services.ErrInvalidTestament),
+		errors.Is(err, services.ErrTranslationNotFound),
+		errors.Is(err, services.ErrBookNotFound):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	default:
+		logging.FromContext(r.Context()).Error("failed to pick a random passage", "error", err)
+		http.Error(w, "Failed to pick a random passage", http.StatusInternalServerError)
+		return
+	}
+
+	// Only seeded picks are repeatable
+	if opts.Seed == "" {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(passage)
+}
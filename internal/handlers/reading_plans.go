@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// ReadingPlanHandler handles reading plans and enrollments
+type ReadingPlanHandler struct {
+	service *services.ReadingPlanService
+}
+
+// NewReadingPlanHandler creates a new ReadingPlanHandler instance
+func NewReadingPlanHandler(service *services.ReadingPlanService) *ReadingPlanHandler {
+	return &ReadingPlanHandler{service: service}
+}
+
+// List handles GET /api/plans
+func (h *ReadingPlanHandler) List(w http.ResponseWriter, r *http.Request) {
+	plans, err := h.service.ListPlans(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusOK, plans)
+}
+
+// Get handles GET /api/plans/{id}
+func (h *ReadingPlanHandler) Get(w http.ResponseWriter, r *http.Request) {
+	plan, err := h.service.GetPlan(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusOK, plan)
+}
+
+// Create handles POST /api/plans
+func (h *ReadingPlanHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request models.PlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	plan, err := h.service.CreatePlan(r.Context(), request)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusCreated, plan)
+}
+
+// Delete handles DELETE /api/plans/{id}
+func (h *ReadingPlanHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeletePlan(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Enroll handles POST /api/plans/{id}/enrollment
+func (h *ReadingPlanHandler) Enroll(w http.ResponseWriter, r *http.Request) {
+	var request models.EnrollRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	enrollment, err := h.service.Enroll(r.Context(), chi.URLParam(r, "id"), request)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusCreated, enrollment)
+}
+
+// Unenroll handles DELETE /api/plans/{id}/enrollment
+func (h *ReadingPlanHandler) Unenroll(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.Unenroll(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Enrollments handles GET /api/me/plans
+func (h *ReadingPlanHandler) Enrollments(w http.ResponseWriter, r *http.Request) {
+	enrollments, err := h.service.Enrollments(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusOK, enrollments)
+}
+
+// Progress handles GET /api/plans/{id}/progress
+func (h *ReadingPlanHandler) Progress(w http.ResponseWriter, r *http.Request) {
+	progress, err := h.service.Progress(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusOK, progress)
+}
+
+// Today handles GET /api/plans/{id}/today?translation=
+func (h *ReadingPlanHandler) Today(w http.ResponseWriter, r *http.Request) {
+	h.readings(w, r, 0)
+}
+
+// Day handles GET /api/plans/{id}/days/{day}?translation=
+func (h *ReadingPlanHandler) Day(w http.ResponseWriter, r *http.Request) {
+	day, ok := planDay(w, r)
+	if !ok {
+		return
+	}
+	h.readings(w, r, day)
+}
+
+func (h *ReadingPlanHandler) readings(w http.ResponseWriter, r *http.Request, day int) {
+	readings, err := h.service.Readings(r.Context(), chi.URLParam(r, "id"), day, r.URL.Query().Get("translation"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusOK, readings)
+}
+
+// Complete handles PUT /api/plans/{id}/days/{day}/complete
+func (h *ReadingPlanHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	h.setCompleted(w, r, true)
+}
+
+// Uncomplete handles DELETE /api/plans/{id}/days/{day}/complete
+func (h *ReadingPlanHandler) Uncomplete(w http.ResponseWriter, r *http.Request) {
+	h.setCompleted(w, r, false)
+}
+
+func (h *ReadingPlanHandler) setCompleted(w http.ResponseWriter, r *http.Request, complete bool) {
+	day, ok := planDay(w, r)
+	if !ok {
+		return
+	}
+	progress, err := h.service.SetCompleted(r.Context(), chi.URLParam(r, "id"), day, complete)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writePlanJSON(w, http.StatusOK, progress)
+}
+
+func planDay(w http.ResponseWriter, r *http.Request) (int, bool) {
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil || day < 1 {
+		http.Error(w, "day must be a positive number", http.StatusBadRequest)
+		return 0, false
+	}
+	return day, true
+}
+
+func writePlanJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func (h *ReadingPlanHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrPlanNotFound), errors.Is(err, services.ErrInvalidPlanDay):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, services.ErrPlanExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, services.ErrInvalidPlan),
+		errors.Is(err, services.ErrInvalidGenerator),
+		errors.Is(err, services.ErrInvalidDate),
+		errors.Is(err, services.ErrInvalidTimeZone),
+		errors.Is(err, services.ErrTranslationNotFound),
+		errors.Is(err, services.ErrNoVerses):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logging.FromContext(r.Context()).Error("reading plan request failed", "error", err)
+		http.Error(w, "Reading plan request failed", http.StatusInternalServerError)
+	}
+}
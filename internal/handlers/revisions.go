@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+)
+
+// VerseHistory handles GET /api/verses/{translation}/{book}/{chapter}/{verse}/revisions?limit=
+func (h *BibleHandler) VerseHistory(w http.ResponseWriter, r *http.Request) {
+	key, ok := verseFromPath(w, r)
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	revisions, err := h.service.VerseHistory(r.Context(), key.TranslationID, key.BookID, key.Chapter, key.Verse, limit)
+	if err != nil {
+		h.writeVerseError(w, r, err, "failed to list revisions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(revisions)
+}
+
+// DiffRevisions handles GET /api/revisions/diff?from=<id>[&to=<id>]
+// Without "to" the revision is compared with the current text.
+func (h *BibleHandler) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	from := r.URL.Query().Get("from")
+	if from == "" {
+		http.Error(w, "Query parameter 'from' is required", http.StatusBadRequest)
+		return
+	}
+
+	diff, err := h.service.DiffRevisions(r.Context(), from, r.URL.Query().Get("to"))
+	if err != nil {
+		h.writeVerseError(w, r, err, "failed to diff revisions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(diff)
+}
+
+// RevertRevision handles POST /api/revisions/{id}/revert
+func (h *BibleHandler) RevertRevision(w http.ResponseWriter, r *http.Request) {
+	var body models.RevertRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+	}
+
+	item, err := h.service.RevertVerse(r.Context(), chi.URLParam(r, "id"), body.Reason)
+	if err != nil {
+		h.writeVerseError(w, r, err, "failed to revert verse")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(item)
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxBatchBody bounds the size of a batch request body
+const maxBatchBody = 8 << 20
+
+// InsertVerses handles POST /api/verses/batch
+func (h *BibleHandler) InsertVerses(w http.ResponseWriter, r *http.Request) {
+	h.editVerses(w, r, services.OpInsert)
+}
+
+// UpsertVerses handles PUT /api/verses/batch
+func (h *BibleHandler) UpsertVerses(w http.ResponseWriter, r *http.Request) {
+	h.editVerses(w, r, services.OpUpsert)
+}
+
+// UpdateVerses handles PATCH /api/verses/batch
+func (h *BibleHandler) UpdateVerses(w http.ResponseWriter, r *http.Request) {
+	h.editVerses(w, r, services.OpUpdate)
+}
+
+// DeleteVerses handles DELETE /api/verses/batch
+func (h *BibleHandler) DeleteVerses(w http.ResponseWriter, r *http.Request) {
+	h.editVerses(w, r, services.OpDelete)
+}
+
+// editVerses applies op to the verses of the request body. It responds 200
+// when every verse succeeded and 207 with per-item errors otherwise.
+func (h *BibleHandler) editVerses(w http.ResponseWriter, r *http.Request, op string) {
+	var request models.VerseBatchRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBatchBody)).Decode(&request); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if len(request.Verses) == 0 {
+		http.Error(w, "verses must not be empty", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.EditVerses(r.Context(), op, request.Verses, request.Reason)
+	if err != nil {
+		if errors.Is(err, services.ErrBatchTooLarge) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		tracing.RecordError(trace.SpanFromContext(r.Context()), err)
+		logging.FromContext(r.Context()).Error("failed to edit verses", "op", op, "error", err)
+		http.Error(w, "Failed to edit verses", http.StatusInternalServerError)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(result)
+}
+
+// UpdateVerse handles PUT /api/verses/{translation}/{book}/{chapter}/{verse}
+func (h *BibleHandler) UpdateVerse(w http.ResponseWriter, r *http.Request) {
+	key, ok := verseFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body models.VerseEditRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	key.Text, key.BookName, key.Footnotes = body.Text, body.BookName, body.Footnotes
+
+	h.editOne(w, r, services.OpUpdate, key, body.Reason)
+}
+
+// DeleteVerse handles DELETE /api/verses/{translation}/{book}/{chapter}/{verse}?reason=
+func (h *BibleHandler) DeleteVerse(w http.ResponseWriter, r *http.Request) {
+	key, ok := verseFromPath(w, r)
+	if !ok {
+		return
+	}
+	h.editOne(w, r, services.OpDelete, key, r.URL.Query().Get("reason"))
+}
+
+func (h *BibleHandler) editOne(w http.ResponseWriter, r *http.Request, op string, verse models.Verse, reason string) {
+	result, err := h.service.EditVerses(r.Context(), op, []models.Verse{verse}, reason)
+	if err == nil && result.Items[0].Status == services.StatusError {
+		err = &services.VerseError{Item: result.Items[0]}
+	}
+	if err != nil {
+		h.writeVerseError(w, r, err, "failed to edit verse")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(result.Items[0])
+}
+
+// writeVerseError maps a verse edit error to 400, 404, 409 or 500
+func (h *BibleHandler) writeVerseError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrRevisionNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, services.ErrRevisionMismatch):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var verseErr *services.VerseError
+	if !errors.As(err, &verseErr) {
+		tracing.RecordError(trace.SpanFromContext(r.Context()), err)
+		logging.FromContext(r.Context()).Error(message, "error", err)
+		http.Error(w, "Failed to edit verse", http.StatusInternalServerError)
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch verseErr.Item.Error {
+	case services.ErrVerseNotFound.Error():
+		status = http.StatusNotFound
+	case services.ErrDuplicateVerse.Error():
+		status = http.StatusConflict
+	}
+	http.Error(w, verseErr.Error(), status)
+}
+
+// verseFromPath reads the verse key from the URL
+func verseFromPath(w http.ResponseWriter, r *http.Request) (models.Verse, bool) {
+	chapter, err1 := strconv.Atoi(chi.URLParam(r, "chapter"))
+	verse, err2 := strconv.Atoi(chi.URLParam(r, "verse"))
+	if err1 != nil || err2 != nil {
+		http.Error(w, "chapter and verse must be numbers", http.StatusBadRequest)
+		return models.Verse{}, false
+	}
+	return models.Verse{
+		TranslationID: chi.URLParam(r, "translation"),
+		BookID:        chi.URLParam(r, "book"),
+		Chapter:       chapter,
+		Verse:         verse,
+	}, true
+}
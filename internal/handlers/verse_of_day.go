@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+)
+
+// VerseOfDayHandler handles the verse of the day endpoints
+type VerseOfDayHandler struct {
+	service *services.VerseOfDayService
+}
+
+// NewVerseOfDayHandler creates a new VerseOfDayHandler instance
+func NewVerseOfDayHandler(service *services.VerseOfDayService) *VerseOfDayHandler {
+	return &VerseOfDayHandler{service: service}
+}
+
+// Get handles GET /api/votd?date=&from=&to=&tz=&translation=
+// Without parameters it returns today's verse in the time zone tz. With from
+// and to it returns the verses of that range as an array.
+func (h *VerseOfDayHandler) Get(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	location, err := h.service.Location(query.Get("tz"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	from, to := query.Get("from"), query.Get("to")
+	single := from == "" && to == ""
+	if single {
+		from = query.Get("date")
+		if from == "" {
+			from = services.Today(location)
+		}
+		to = from
+	} else if from == "" || to == "" {
+		http.Error(w, "from and to must be given together", http.StatusBadRequest)
+		return
+	}
+
+	days, err := h.service.GetVersesOfDay(r.Context(), from, to, query.Get("translation"), location)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if single {
+		json.NewEncoder(w).Encode(days[0])
+		return
+	}
+	json.NewEncoder(w).Encode(days)
+}
+
+// ListCurated handles GET /api/votd/curated?from=&to=
+func (h *VerseOfDayHandler) ListCurated(w http.ResponseWriter, r *http.Request) {
+	from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		http.Error(w, "from and to are required", http.StatusBadRequest)
+		return
+	}
+
+	verses, err := h.service.ListCurated(r.Context(), from, to)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(verses)
+}
+
+// SetCurated handles PUT /api/votd/curated/{date}
+func (h *VerseOfDayHandler) SetCurated(w http.ResponseWriter, r *http.Request) {
+	var body models.DailyVerseRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if body.Reference == "" {
+		http.Error(w, "reference is required", http.StatusBadRequest)
+		return
+	}
+
+	verse, err := h.service.SetCurated(r.Context(), chi.URLParam(r, "date"), body.Reference, body.Note)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(verse)
+}
+
+// DeleteCurated handles DELETE /api/votd/curated/{date}
+func (h *VerseOfDayHandler) DeleteCurated(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteCurated(r.Context(), chi.URLParam(r, "date")); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *VerseOfDayHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, services.ErrDailyVerseNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, services.ErrInvalidDate),
+		errors.Is(err, services.ErrRangeTooLarge),
+		errors.Is(err, services.ErrInvalidReference),
+		errors.Is(err, services.ErrBookNotFound),
+		errors.Is(err, services.ErrTranslationNotFound),
+		errors.Is(err, services.ErrNoVerses):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		logging.FromContext(r.Context()).Error("verse of the day failed", "error", err)
+		http.Error(w, "Failed to get the verse of the day", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Status values of a check and of a report
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusFail     = "fail"
+)
+
+// checkTimeout bounds every single dependency check
+const checkTimeout = 2 * time.Second
+
+var ready atomic.Bool
+
+// SetReady marks whether the instance should receive traffic.
+// It is set once startup completes and cleared when shutdown begins.
+func SetReady(v bool) {
+	ready.Store(v)
+}
+
+// Ready reports whether the instance accepts traffic
+func Ready() bool {
+	return ready.Load()
+}
+
+// Check is one dependency of readiness.
+// A failing critical check makes the instance not ready; any other failing
+// check only degrades it.
+type Check struct {
+	Name     string
+	Critical bool
+	Run      func(ctx context.Context) error
+}
+
+// Result is the outcome of a check
+type Result struct {
+	Status   string  `json:"status"`
+	Critical bool    `json:"critical"`
+	Error    string  `json:"error,omitempty"`
+	Latency  float64 `json:"latency_ms"`
+}
+
+// Report is the outcome of all checks
+type Report struct {
+	Status string            `json:"status"`
+	Ready  bool              `json:"ready"`
+	Reason string            `json:"reason,omitempty"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Checker runs readiness checks
+type Checker struct {
+	checks []Check
+}
+
+// NewChecker creates a checker for checks
+func NewChecker(checks ...Check) *Checker {
+	return &Checker{checks: checks}
+}
+
+// Add registers another check
+func (c *Checker) Add(check Check) {
+	c.checks = append(c.checks, check)
+}
+
+// Run executes every check concurrently and summarizes the results
+func (c *Checker) Run(ctx context.Context) Report {
+	report := Report{
+		Status: StatusOK,
+		Ready:  true,
+		Checks: make(map[string]Result, len(c.checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range c.checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Run(ctx)
+			result := Result{
+				Status:   StatusOK,
+				Critical: check.Critical,
+				Latency:  float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				result.Status = StatusFail
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			report.Checks[check.Name] = result
+			mu.Unlock()
+		}(check)
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status != StatusFail {
+			continue
+		}
+		if result.Critical {
+			report.Status = StatusFail
+			report.Ready = false
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+
+	if !Ready() {
+		report.Status = StatusFail
+		report.Ready = false
+		report.Reason = "starting up or shutting down"
+	}
+
+	return report
+}
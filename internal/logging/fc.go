@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/aliyun/fc-runtime-go-sdk/fccontext"
+)
+
+// fcHandler renders records as JSON and forwards them to the Function Compute
+// logger, which prefixes every line with the FC request ID
+type fcHandler struct {
+	logger *fccontext.FcLogger
+	level  slog.Leveler
+	ops    []func(slog.Handler) slog.Handler
+}
+
+// NewFCLogger returns a structured logger bridged into the fccontext logger
+func NewFCLogger(logger *fccontext.FcLogger, level slog.Leveler) *slog.Logger {
+	return slog.New(&fcHandler{logger: logger, level: level})
+}
+
+func (h *fcHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *fcHandler) Handle(ctx context.Context, r slog.Record) error {
+	var buf bytes.Buffer
+	var inner slog.Handler = slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		Level: h.level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// The FC logger already writes the timestamp and level
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	for _, op := range h.ops {
+		inner = op(inner)
+	}
+	if err := inner.Handle(ctx, r); err != nil {
+		return err
+	}
+
+	line := strings.TrimSuffix(buf.String(), "\n")
+	switch {
+	case r.Level >= slog.LevelError:
+		h.logger.Error(line)
+	case r.Level >= slog.LevelWarn:
+		h.logger.Warn(line)
+	case r.Level >= slog.LevelInfo:
+		h.logger.Info(line)
+	default:
+		h.logger.Debug(line)
+	}
+	return nil
+}
+
+func (h *fcHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(inner slog.Handler) slog.Handler { return inner.WithAttrs(attrs) })
+}
+
+func (h *fcHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(inner slog.Handler) slog.Handler { return inner.WithGroup(name) })
+}
+
+func (h *fcHandler) with(op func(slog.Handler) slog.Handler) slog.Handler {
+	ops := make([]func(slog.Handler) slog.Handler, len(h.ops), len(h.ops)+1)
+	copy(ops, h.ops)
+	return &fcHandler{logger: h.logger, level: h.level, ops: append(ops, op)}
+}
@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type fieldsKey struct{}
+
+// requestFields holds identity fields that are only known after authentication
+type requestFields struct {
+	mu     sync.Mutex
+	userID string
+	apiKey string
+}
+
+// SetUser records the authenticated user on the request log line
+func SetUser(ctx context.Context, userID string) {
+	if f, ok := ctx.Value(fieldsKey{}).(*requestFields); ok {
+		f.mu.Lock()
+		f.userID = userID
+		f.mu.Unlock()
+	}
+}
+
+// SetAPIKey records the API key identifier on the request log line.
+// Never pass the secret itself.
+func SetAPIKey(ctx context.Context, keyID string) {
+	if f, ok := ctx.Value(fieldsKey{}).(*requestFields); ok {
+		f.mu.Lock()
+		f.apiKey = keyID
+		f.mu.Unlock()
+	}
+}
+
+// keyFingerprint returns a short, non reversible identifier for an API key
+func keyFingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:4])
+}
+
+// Middleware logs one structured line per request and exposes a request scoped
+// logger through FromContext. It must run after middleware.RequestID.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		logger := FromContext(r.Context()).With(slog.String("request_id", middleware.GetReqID(r.Context())))
+		if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+			logger = logger.With(slog.String("trace_id", sc.TraceID().String()))
+		}
+
+		fields := &requestFields{}
+		if key := r.Header.Get("X-API-Key"); key != "" {
+			fields.apiKey = keyFingerprint(key)
+		}
+
+		ctx := context.WithValue(WithLogger(r.Context(), logger), fieldsKey{}, fields)
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		route := ""
+		if rctx := chi.RouteContext(ctx); rctx != nil {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("route", route),
+			slog.Int("status", status),
+			slog.Int("bytes", ww.BytesWritten()),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("remote_ip", r.RemoteAddr),
+		}
+		fields.mu.Lock()
+		if fields.userID != "" {
+			attrs = append(attrs, slog.String("user_id", fields.userID))
+		}
+		if fields.apiKey != "" {
+			attrs = append(attrs, slog.String("api_key", fields.apiKey))
+		}
+		fields.mu.Unlock()
+
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		logger.LogAttrs(ctx, level, "request completed", attrs...)
+	})
+}
@@ -0,0 +1,172 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tkdnbb/bookofben-api/internal/cache"
+	"github.com/tkdnbb/bookofben-api/internal/startup"
+)
+
+const namespace = "bookofben"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, chi route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method and chi route pattern.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	mongoDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "mongo_operation_duration_seconds",
+		Help:      "MongoDB operation latency by collection and operation.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"collection", "operation"})
+
+	referenceParseFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reference_parse_failures_total",
+		Help:      "Passage references that could not be parsed, by reason.",
+	}, []string{"reason"})
+
+	startupPhase = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "startup_phase_seconds",
+		Help:      "Duration of the most recent run of each startup phase.",
+	}, []string{"phase"})
+
+	searchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "search_duration_seconds",
+		Help:      "Verse search latency, split by cache result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"cache"})
+)
+
+func init() {
+	startup.SetObserver(func(name string, d time.Duration) {
+		startupPhase.WithLabelValues(name).Set(d.Seconds())
+	})
+}
+
+// Handler returns the /metrics HTTP handler
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Middleware records request counts and latency labelled by the chi route pattern.
+// The raw path is never used as a label because references are unbounded.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			if pattern := rctx.RoutePattern(); pattern != "" {
+				route = pattern
+			}
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(status)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// ObserveMongo records the duration of a MongoDB operation started at start.
+// Typical use: defer metrics.ObserveMongo("verses", "find", time.Now())
+func ObserveMongo(collection, operation string, start time.Time) {
+	mongoDuration.WithLabelValues(collection, operation).Observe(time.Since(start).Seconds())
+}
+
+// ReferenceParseFailed counts a reference that failed to parse
+func ReferenceParseFailed(reason string) {
+	referenceParseFailures.WithLabelValues(reason).Inc()
+}
+
+// ObserveSearch records the latency of a verse search
+func ObserveSearch(cacheHit bool, start time.Time) {
+	result := "miss"
+	if cacheHit {
+		result = "hit"
+	}
+	searchDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
+
+// cacheCollector exports the counters of every registered cache
+type cacheCollector struct {
+	mu      sync.RWMutex
+	sources map[string]func() cache.Stats
+
+	hits      *prometheus.Desc
+	misses    *prometheus.Desc
+	evictions *prometheus.Desc
+	size      *prometheus.Desc
+	capacity  *prometheus.Desc
+}
+
+var caches = newCacheCollector()
+
+func newCacheCollector() *cacheCollector {
+	c := &cacheCollector{
+		sources:   make(map[string]func() cache.Stats),
+		hits:      prometheus.NewDesc(namespace+"_cache_hits_total", "Cache hits.", []string{"cache"}, nil),
+		misses:    prometheus.NewDesc(namespace+"_cache_misses_total", "Cache misses.", []string{"cache"}, nil),
+		evictions: prometheus.NewDesc(namespace+"_cache_evictions_total", "Entries evicted because the cache was full.", []string{"cache"}, nil),
+		size:      prometheus.NewDesc(namespace+"_cache_entries", "Entries currently cached.", []string{"cache"}, nil),
+		capacity:  prometheus.NewDesc(namespace+"_cache_capacity", "Maximum number of cached entries.", []string{"cache"}, nil),
+	}
+	prometheus.MustRegister(c)
+	return c
+}
+
+// RegisterCache exports the stats of a cache under name.
+// Registering the same name again replaces the previous source.
+func RegisterCache(name string, stats func() cache.Stats) {
+	caches.mu.Lock()
+	defer caches.mu.Unlock()
+	caches.sources[name] = stats
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.evictions
+	ch <- c.size
+	ch <- c.capacity
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for name, source := range c.sources {
+		s := source()
+		ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(s.Hits), name)
+		ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(s.Misses), name)
+		ch <- prometheus.MustNewConstMetric(c.evictions, prometheus.CounterValue, float64(s.Evictions), name)
+		ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, float64(s.Size), name)
+		ch <- prometheus.MustNewConstMetric(c.capacity, prometheus.GaugeValue, float64(s.Capacity), name)
+	}
+}
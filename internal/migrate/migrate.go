@@ -0,0 +1,395 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// Collection records the applied migrations, one document per version
+	Collection = "schema_migrations"
+	// lockCollection holds the lock document shared by all instances
+	lockCollection = "schema_migrations_lock"
+	lockID         = "migrations"
+)
+
+// Migration errors
+var (
+	// ErrIrreversible is returned when rolling back a migration without Down
+	ErrIrreversible = errors.New("migration cannot be rolled back")
+	// ErrLockLost is returned when the lock expired and another instance took
+	// it while fn was running
+	ErrLockLost = errors.New("lost the migration lock to another instance")
+)
+
+// Migration is one versioned change of the database
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+	// Down reverts Up; nil marks the migration irreversible
+	Down func(ctx context.Context, db *mongo.Database) error
+}
+
+// Record is the schema_migrations document of an applied migration
+type Record struct {
+	Version    int       `bson:"_id" json:"version"`
+	Name       string    `bson:"name" json:"name"`
+	AppliedAt  time.Time `bson:"applied_at" json:"applied_at"`
+	DurationMS int64     `bson:"duration_ms" json:"duration_ms"`
+}
+
+// Status describes a known migration and whether it is applied
+type Status struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+// Runner applies and rolls back migrations
+type Runner struct {
+	db         *mongo.Database
+	migrations []Migration
+	owner      string
+
+	// LockTTL is how long a lock survives a crashed holder
+	LockTTL time.Duration
+	// LockWait is how long to wait for another instance to release the lock
+	LockWait time.Duration
+}
+
+// New creates a runner for the registered migrations
+func New(db *mongo.Database) *Runner {
+	return NewWithMigrations(db, migrations)
+}
+
+// NewWithMigrations creates a runner for the given migrations
+func NewWithMigrations(db *mongo.Database, list []Migration) *Runner {
+	sorted := append([]Migration(nil), list...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	host, _ := os.Hostname()
+	return &Runner{
+		db:         db,
+		migrations: sorted,
+		owner:      host + "-" + strconv.Itoa(os.Getpid()) + "-" + bson.NewObjectID().Hex(),
+		LockTTL:    2 * time.Minute,
+		LockWait:   5 * time.Minute,
+	}
+}
+
+// Latest returns the highest known version
+func (r *Runner) Latest() int {
+	if len(r.migrations) == 0 {
+		return 0
+	}
+	return r.migrations[len(r.migrations)-1].Version
+}
+
+// Status lists every known migration and whether it is applied
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(r.migrations))
+	for _, m := range r.migrations {
+		status := Status{Version: m.Version, Name: m.Name}
+		if record, ok := applied[m.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = &record.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending returns the migrations that are not applied yet
+func (r *Runner) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range r.migrations {
+		if _, ok := applied[m.Version]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies pending migrations up to and including target; 0 means all.
+// It returns the versions applied.
+func (r *Runner) Up(ctx context.Context, target int) ([]int, error) {
+	ctx, span := tracing.Start(ctx, "migrate.Up")
+	defer span.End()
+
+	var done []int
+	err := r.WithLock(ctx, func(ctx context.Context) error {
+		pending, err := r.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range pending {
+			if target > 0 && m.Version > target {
+				break
+			}
+			if err := r.apply(ctx, m); err != nil {
+				return err
+			}
+			done = append(done, m.Version)
+		}
+		return nil
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+	}
+	return done, err
+}
+
+// Down rolls back the latest steps applied migrations.
+// It returns the versions rolled back.
+func (r *Runner) Down(ctx context.Context, steps int) ([]int, error) {
+	ctx, span := tracing.Start(ctx, "migrate.Down")
+	defer span.End()
+
+	var done []int
+	err := r.WithLock(ctx, func(ctx context.Context) error {
+		applied, err := r.appliedDesc(ctx)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < steps && i < len(applied); i++ {
+			if err := r.revert(ctx, applied[i]); err != nil {
+				return err
+			}
+			done = append(done, applied[i].Version)
+		}
+		return nil
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+	}
+	return done, err
+}
+
+// Redo rolls back the latest applied migration and applies it again.
+// It returns the version redone.
+func (r *Runner) Redo(ctx context.Context) (int, error) {
+	ctx, span := tracing.Start(ctx, "migrate.Redo")
+	defer span.End()
+
+	var version int
+	err := r.WithLock(ctx, func(ctx context.Context) error {
+		applied, err := r.appliedDesc(ctx)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			return errors.New("no applied migration to redo")
+		}
+		if err := r.revert(ctx, applied[0]); err != nil {
+			return err
+		}
+		version = applied[0].Version
+		return r.apply(ctx, applied[0])
+	})
+	if err != nil {
+		tracing.RecordError(span, err)
+	}
+	return version, err
+}
+
+func (r *Runner) apply(ctx context.Context, m Migration) error {
+	slog.InfoContext(ctx, "applying migration", "version", m.Version, "name", m.Name)
+
+	start := time.Now()
+	if err := m.Up(ctx, r.db); err != nil {
+		return fmt.Errorf("migration %d %s failed: %w", m.Version, m.Name, err)
+	}
+
+	record := Record{
+		Version:    m.Version,
+		Name:       m.Name,
+		AppliedAt:  time.Now().UTC(),
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if _, err := r.db.Collection(Collection).InsertOne(ctx, record); err != nil {
+		return fmt.Errorf("failed to record migration %d: %w", m.Version, err)
+	}
+	return nil
+}
+
+func (r *Runner) revert(ctx context.Context, m Migration) error {
+	if m.Down == nil {
+		return fmt.Errorf("migration %d %s: %w", m.Version, m.Name, ErrIrreversible)
+	}
+	slog.InfoContext(ctx, "rolling back migration", "version", m.Version, "name", m.Name)
+
+	if err := m.Down(ctx, r.db); err != nil {
+		return fmt.Errorf("rollback of migration %d %s failed: %w", m.Version, m.Name, err)
+	}
+	if _, err := r.db.Collection(Collection).DeleteOne(ctx, bson.M{"_id": m.Version}); err != nil {
+		return fmt.Errorf("failed to unrecord migration %d: %w", m.Version, err)
+	}
+	return nil
+}
+
+// applied loads the schema_migrations records by version
+func (r *Runner) applied(ctx context.Context) (map[int]Record, error) {
+	cursor, err := r.db.Collection(Collection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", Collection, err)
+	}
+	var records []Record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", Collection, err)
+	}
+
+	applied := make(map[int]Record, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// appliedDesc returns the applied known migrations, latest first
+func (r *Runner) appliedDesc(ctx context.Context) ([]Migration, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Migration
+	for i := len(r.migrations) - 1; i >= 0; i-- {
+		if _, ok := applied[r.migrations[i].Version]; ok {
+			list = append(list, r.migrations[i])
+		}
+	}
+	return list, nil
+}
+
+// WithLock runs fn while holding the migration lock. Other commands that
+// rewrite data, like the corpus sync, use it to serialize with migrations.
+// The lock document expires after LockTTL so a crashed holder cannot block
+// other instances forever; it is refreshed while fn runs. If another instance
+// takes over an expired lock, the context of fn is canceled and WithLock
+// returns ErrLockLost.
+func (r *Runner) WithLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := r.acquire(ctx); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	refreshed := make(chan struct{})
+	go func() {
+		defer close(refreshed)
+		ticker := time.NewTicker(r.LockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := r.refresh(ctx)
+				if errors.Is(err, ErrLockLost) {
+					slog.ErrorContext(ctx, "migration lock was taken by another instance; stopping")
+					cancel(ErrLockLost)
+					return
+				}
+				if err != nil {
+					slog.WarnContext(ctx, "failed to refresh migration lock", "error", err)
+				}
+			}
+		}
+	}()
+
+	err := fn(ctx)
+	cancel(nil)
+	<-refreshed
+	// A lost lock belongs to the other instance, so there is nothing to release
+	if errors.Is(context.Cause(ctx), ErrLockLost) {
+		if err == nil {
+			return ErrLockLost
+		}
+		return fmt.Errorf("%w: %w", ErrLockLost, err)
+	}
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer releaseCancel()
+	if _, releaseErr := r.db.Collection(lockCollection).DeleteOne(releaseCtx, bson.M{"_id": lockID, "owner": r.owner}); releaseErr != nil {
+		slog.WarnContext(ctx, "failed to release migration lock", "error", releaseErr)
+	}
+	return err
+}
+
+// acquire takes the lock if it is free, expired or already ours, waiting up to LockWait
+func (r *Runner) acquire(ctx context.Context) error {
+	deadline := time.Now().Add(r.LockWait)
+	collection := r.db.Collection(lockCollection)
+
+	for {
+		now := time.Now()
+		filter := bson.M{
+			"_id": lockID,
+			"$or": bson.A{
+				bson.M{"expires_at": bson.M{"$lt": now}},
+				bson.M{"owner": r.owner},
+			},
+		}
+		update := bson.M{"$set": bson.M{
+			"owner":       r.owner,
+			"acquired_at": now,
+			"expires_at":  now.Add(r.LockTTL),
+		}}
+
+		// A held lock does not match the filter, so the upsert collides on _id
+		_, err := collection.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+		if err == nil {
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for the migration lock", r.LockWait)
+		}
+		slog.InfoContext(ctx, "waiting for migration lock held by another instance")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// refresh extends the lock, returning ErrLockLost if it is no longer ours
+func (r *Runner) refresh(ctx context.Context) error {
+	result, err := r.db.Collection(lockCollection).UpdateOne(ctx,
+		bson.M{"_id": lockID, "owner": r.owner},
+		bson.M{"$set": bson.M{"expires_at": time.Now().Add(r.LockTTL)}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
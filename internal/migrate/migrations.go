@@ -0,0 +1,622 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/annotations"
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+	"github.com/tkdnbb/bookofben-api/internal/corpus"
+	"github.com/tkdnbb/bookofben-api/internal/crossrefs"
+	"github.com/tkdnbb/bookofben-api/internal/data"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/plans"
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// migrations are applied in version order. Never change or renumber a
+// migration that has been released; add a new one instead.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "create verse lookup index",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("verses").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "book_id", Value: 1}, {Key: "chapter", Value: 1}, {Key: "verse", Value: 1}},
+				Options: options.Index().SetName("book_chapter_verse"),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return db.Collection("verses").Indexes().DropOne(ctx, "book_chapter_verse")
+		},
+	},
+	{
+		Version: 2,
+		Name:    "seed translations",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return upsertByID(ctx, db.Collection("translations"), seedTranslations)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return deleteByID(ctx, db.Collection("translations"), seedTranslations)
+		},
+	},
+	{
+		Version: 3,
+		Name:    "seed books",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return upsertByID(ctx, db.Collection("books"), seedBooks)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return deleteByID(ctx, db.Collection("books"), seedBooks)
+		},
+	},
+	{
+		Version: 4,
+		Name:    "seed verses",
+		Up:      upSeedVerses,
+		Down:    downSeedVerses,
+	},
+	{
+		Version: 5,
+		Name:    "seed comments",
+		Up:      upSeedComments,
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("comments").DeleteMany(ctx, bson.M{"id": bson.M{"$in": bson.A{"1", "2"}}})
+			return err
+		},
+	},
+	{
+		Version: 6,
+		Name:    "fix translation_id field and make verses unique",
+		Up:      upUniqueVerses,
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return db.Collection("verses").Indexes().DropOne(ctx, "verse_unique")
+		},
+	},
+	{
+		Version: 7,
+		Name:    "create revision and audit log indexes",
+		Up:      upHistoryIndexes,
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			if err := db.Collection(database.RevisionsCollection).Indexes().DropOne(ctx, "verse_history"); err != nil {
+				return err
+			}
+			if err := db.Collection(audit.Collection).Indexes().DropOne(ctx, "created_at"); err != nil {
+				return err
+			}
+			return db.Collection(audit.Collection).Indexes().DropOne(ctx, "target")
+		},
+	},
+	{
+		Version: 8,
+		Name:    "index plan enrollments by user",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(plans.EnrollmentsCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}},
+				Options: options.Index().SetName("user_enrollments"),
+			})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return db.Collection(plans.EnrollmentsCollection).Indexes().DropOne(ctx, "user_enrollments")
+		},
+	},
+	{
+		Version: 9,
+		Name:    "index bookmarks, highlights and notes by user and passage",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for _, name := range annotationCollections {
+				_, err := db.Collection(name).Indexes().CreateOne(ctx, mongo.IndexModel{
+					Keys: bson.D{
+						{Key: "user_id", Value: 1},
+						{Key: "book_id", Value: 1},
+						{Key: "chapter", Value: 1},
+						{Key: "start_verse", Value: 1},
+					},
+					Options: options.Index().SetName("user_passage"),
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			for _, name := range annotationCollections {
+				if err := db.Collection(name).Indexes().DropOne(ctx, "user_passage"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version: 10,
+		Name:    "index cross-references by source verse and dataset",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(crossrefs.Collection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys: bson.D{
+						{Key: "source_book_id", Value: 1},
+						{Key: "source_chapter", Value: 1},
+						{Key: "source_verse", Value: 1},
+						{Key: "weight", Value: -1},
+					},
+					Options: options.Index().SetName("source"),
+				},
+				{
+					Keys:    bson.D{{Key: "dataset", Value: 1}},
+					Options: options.Index().SetName("dataset"),
+				},
+			})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			if err := db.Collection(crossrefs.Collection).Indexes().DropOne(ctx, "source"); err != nil {
+				return err
+			}
+			return db.Collection(crossrefs.Collection).Indexes().DropOne(ctx, "dataset")
+		},
+	},
+	{
+		Version: 11,
+		Name:    "move Book of Ben asides into footnotes",
+		Up:      upFootnotes,
+		Down:    downFootnotes,
+	},
+	{
+		Version: 12,
+		Name:    "add metadata to seeded translations",
+		Up:      upTranslationMetadata,
+		Down:    downTranslationMetadata,
+	},
+	{
+		Version: 13,
+		Name:    "number the Chinese Union Version in its own versification",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return setVersification(ctx, db, "cuv", versification.KJV, versification.CUV)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return setVersification(ctx, db, "cuv", versification.CUV, versification.KJV)
+		},
+	},
+	{
+		Version: 14,
+		Name:    "build the concordance",
+		Up:      upConcordance,
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return db.Collection(concordance.Collection).Drop(ctx)
+		},
+	},
+}
+
+var annotationCollections = []string{
+	annotations.BookmarksCollection, annotations.HighlightsCollection, annotations.NotesCollection,
+}
+
+var seedTranslations = []database.Translation{
+	{ID: "cuv", Name: "Chinese Union Version", Note: "Public Domain"},
+	{ID: "kjv", Name: "King James Version", Note: "Public Domain"},
+	{ID: "en", Name: "English Version", Note: "Public Domain"},
+}
+
+// seedTranslationMetadata is the metadata of the seeded translations, set
+// by migration 12 on top of the documents migration 2 wrote
+var seedTranslationMetadata = []database.Translation{
+	{
+		ID: "cuv", Language: "zh-Hant", Script: "Hant", Direction: database.DirectionLTR,
+		Copyright: "Public Domain", License: database.License{ID: "public-domain", Name: "Public Domain"},
+		Year: 1919, Versification: "kjv", Books: []string{"JHN"},
+	},
+	{
+		ID: "kjv", Language: "en", Script: "Latn", Direction: database.DirectionLTR,
+		Copyright: "Public Domain (Crown copyright in the United Kingdom)", License: database.License{ID: "public-domain", Name: "Public Domain"},
+		Year: 1611, Versification: "kjv", Books: []string{"GEN"},
+	},
+	{
+		ID: "en", Language: "en", Script: "Latn", Direction: database.DirectionLTR,
+		Copyright: "Public Domain", License: database.License{ID: "public-domain", Name: "Public Domain"},
+		Versification: "kjv", Books: []string{corpus.BookID},
+	},
+}
+
+// translationMetadataFields are the fields migration 12 sets
+var translationMetadataFields = []string{"language", "script", "direction", "copyright", "license", "year", "versification", "books"}
+
+var seedBooks = []database.Book{
+	{ID: "GEN", Name: "創世紀", Chapters: 50},
+	{ID: "MAT", Name: "馬太福音", Chapters: 28},
+	{ID: "JHN", Name: "約翰福音", Chapters: 21},
+	{ID: "BEN", Name: "The Book of Jachanan Ben Kathryn", Chapters: 73},
+}
+
+// identified is a document keyed by its _id
+type identified interface {
+	database.Translation | database.Book
+}
+
+func documentID[T identified](doc T) string {
+	switch d := any(doc).(type) {
+	case database.Translation:
+		return d.ID
+	case database.Book:
+		return d.ID
+	}
+	return ""
+}
+
+// upsertByID writes docs under their string _id. Documents written by the old
+// count-based seeding carry an ObjectID _id and are replaced.
+func upsertByID[T identified](ctx context.Context, collection *mongo.Collection, docs []T) error {
+	ids := make(bson.A, 0, len(docs))
+	writes := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		id := documentID(doc)
+		ids = append(ids, id)
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": id}).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+
+	legacy := bson.M{"_id": bson.M{"$type": "objectId"}, "id": bson.M{"$in": ids}}
+	if _, err := collection.DeleteMany(ctx, legacy); err != nil {
+		return fmt.Errorf("failed to remove legacy %s: %w", collection.Name(), err)
+	}
+	if _, err := collection.BulkWrite(ctx, writes); err != nil {
+		return fmt.Errorf("failed to write %s: %w", collection.Name(), err)
+	}
+	return nil
+}
+
+func deleteByID[T identified](ctx context.Context, collection *mongo.Collection, docs []T) error {
+	ids := make(bson.A, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, documentID(doc))
+	}
+	_, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	return err
+}
+
+// seedVerses returns the sample verses and the Book of Ben corpus
+func seedVerses() []models.Verse {
+	verses := []models.Verse{
+		// Genesis Chapter 1 (KJV)
+		{BookID: "GEN", TranslationID: "kjv", BookName: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning God created the heaven and the earth."},
+		{BookID: "GEN", TranslationID: "kjv", BookName: "Genesis", Chapter: 1, Verse: 2, Text: "And the earth was without form, and void; and darkness was upon the face of the deep. And the Spirit of God moved upon the face of the waters."},
+		{BookID: "GEN", TranslationID: "kjv", BookName: "Genesis", Chapter: 1, Verse: 3, Text: "And God said, Let there be light: and there was light."},
+		{BookID: "GEN", TranslationID: "kjv", BookName: "Genesis", Chapter: 1, Verse: 4, Text: "And God saw the light, that it was good: and God divided the light from the darkness."},
+		{BookID: "GEN", TranslationID: "kjv", BookName: "Genesis", Chapter: 1, Verse: 5, Text: "And God called the light Day, and the darkness he called Night. And the evening and the morning were the first day."},
+		// John 3:16 (Chinese)
+		{BookID: "JHN", TranslationID: "cuv", BookName: "約翰福音", Chapter: 3, Verse: 16, Text: "神愛世人，甚至將他的獨生子賜給他們，叫一切信他的，不至滅亡，反得永生。"},
+	}
+
+	// The Book of Jachanan Ben Kathryn
+	for chapterNum := 1; chapterNum <= data.GetTotalChapters(); chapterNum++ {
+		for i, verseText := range data.GetChapterVerses(chapterNum) {
+			verses = append(verses, models.Verse{
+				BookID:        "BEN",
+				BookName:      "The Book of Jachanan Ben Kathryn",
+				TranslationID: "en",
+				Chapter:       chapterNum,
+				Verse:         i + 1,
+				Text:          verseText,
+			})
+		}
+	}
+	return verses
+}
+
+func verseFilter(v models.Verse) bson.M {
+	return bson.M{"book_id": v.BookID, "translation_id": v.TranslationID, "chapter": v.Chapter, "verse": v.Verse}
+}
+
+// upSeedVerses upserts every seed verse, so databases seeded by the old
+// count-based seeding converge on the same content
+func upSeedVerses(ctx context.Context, db *mongo.Database) error {
+	verses := seedVerses()
+	writes := make([]mongo.WriteModel, 0, len(verses))
+	for _, v := range verses {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(verseFilter(v)).
+			SetReplacement(v).
+			SetUpsert(true))
+	}
+	if _, err := db.Collection("verses").BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to write verses: %w", err)
+	}
+	return nil
+}
+
+func downSeedVerses(ctx context.Context, db *mongo.Database) error {
+	verses := seedVerses()
+	writes := make([]mongo.WriteModel, 0, len(verses))
+	for _, v := range verses {
+		writes = append(writes, mongo.NewDeleteManyModel().SetFilter(verseFilter(v)))
+	}
+	_, err := db.Collection("verses").BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func upSeedComments(ctx context.Context, db *mongo.Database) error {
+	now := time.Now()
+	comments := []models.Comment{
+		{
+			ID:            "1",
+			Title:         "First Comment",
+			Content:       "This is a sample comment for Genesis 1:1.",
+			BookID:        "GEN",
+			Chapter:       1,
+			Verse:         1,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+			IsActive:      true,
+			UserID:        "user1",
+			Username:      "alice",
+			TranslationID: "kjv",
+		},
+		{
+			ID:            "2",
+			Title:         "Ben's a prophet",
+			Content:       "A comment on the Book of Ben, chapter 1.",
+			BookID:        "BEN",
+			Chapter:       1,
+			Verse:         1,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+			PinnedAmount:  100,
+			IsActive:      true,
+			UserID:        "user2",
+			Username:      "benfan",
+			TranslationID: "en",
+		},
+	}
+
+	collection := db.Collection("comments")
+	for _, comment := range comments {
+		// Keep comments that already exist, e.g. from the old seeding
+		_, err := collection.UpdateOne(ctx,
+			bson.M{"id": comment.ID},
+			bson.M{"$setOnInsert": comment},
+			options.UpdateOne().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write comment %s: %w", comment.ID, err)
+		}
+	}
+	return nil
+}
+
+// upUniqueVerses renames the misspelled tranlation_id field written by the
+// old POST /api/verses, removes duplicate verses and adds a unique index
+func upUniqueVerses(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("verses")
+
+	if _, err := collection.UpdateMany(ctx,
+		bson.M{"tranlation_id": bson.M{"$exists": true}, "translation_id": bson.M{"$exists": false}},
+		bson.M{"$rename": bson.M{"tranlation_id": "translation_id"}},
+	); err != nil {
+		return fmt.Errorf("failed to rename tranlation_id: %w", err)
+	}
+	if _, err := collection.UpdateMany(ctx,
+		bson.M{"tranlation_id": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"tranlation_id": ""}},
+	); err != nil {
+		return fmt.Errorf("failed to remove tranlation_id: %w", err)
+	}
+
+	// Keep the first stored copy of every duplicated verse
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "translation_id", Value: "$translation_id"},
+				{Key: "book_id", Value: "$book_id"},
+				{Key: "chapter", Value: "$chapter"},
+				{Key: "verse", Value: "$verse"},
+			}},
+			{Key: "ids", Value: bson.D{{Key: "$push", Value: "$_id"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find duplicate verses: %w", err)
+	}
+	var groups []struct {
+		IDs bson.A `bson:"ids"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return fmt.Errorf("failed to decode duplicate verses: %w", err)
+	}
+	for _, group := range groups {
+		if _, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": group.IDs[1:]}}); err != nil {
+			return fmt.Errorf("failed to remove duplicate verses: %w", err)
+		}
+	}
+
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "translation_id", Value: 1},
+			{Key: "book_id", Value: 1},
+			{Key: "chapter", Value: 1},
+			{Key: "verse", Value: 1},
+		},
+		Options: options.Index().SetName("verse_unique").SetUnique(true),
+	})
+	return err
+}
+
+// upHistoryIndexes indexes revisions by verse and the audit log by time and target
+func upHistoryIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection(database.RevisionsCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "translation_id", Value: 1},
+			{Key: "book_id", Value: 1},
+			{Key: "chapter", Value: 1},
+			{Key: "verse", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+		Options: options.Index().SetName("verse_history"),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection(audit.Collection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "target_type", Value: 1}, {Key: "target_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("target"),
+		},
+	})
+	return err
+}
+
+// benVerses selects the Book of Ben corpus verses
+var benVerses = bson.M{"book_id": corpus.BookID, "translation_id": corpus.TranslationID}
+
+// upFootnotes moves the bracketed asides of stored Book of Ben verses into
+// footnotes, as seeding and corpus sync now do
+func upFootnotes(ctx context.Context, db *mongo.Database) error {
+	return rewriteVerses(ctx, db, bson.M{"text": bson.M{"$regex": `\[`}}, func(v models.Verse) bson.M {
+		text, footnotes := corpus.ExtractFootnotes(v.Text)
+		if footnotes == nil {
+			return nil
+		}
+		return bson.M{"$set": bson.M{"text": text, "footnotes": footnotes}}
+	})
+}
+
+// downFootnotes puts footnotes back into the text in brackets
+func downFootnotes(ctx context.Context, db *mongo.Database) error {
+	return rewriteVerses(ctx, db, bson.M{"footnotes.0": bson.M{"$exists": true}}, func(v models.Verse) bson.M {
+		return bson.M{"$set": bson.M{"text": corpus.InlineFootnotes(v.Text, v.Footnotes)}, "$unset": bson.M{"footnotes": ""}}
+	})
+}
+
+// upTranslationMetadata sets the metadata of the seeded translations that
+// still exist, leaving the rest of each document alone
+func upTranslationMetadata(ctx context.Context, db *mongo.Database) error {
+	writes := make([]mongo.WriteModel, 0, len(seedTranslationMetadata))
+	for _, t := range seedTranslationMetadata {
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": t.ID}).SetUpdate(bson.M{"$set": bson.M{
+			"language":      t.Language,
+			"script":        t.Script,
+			"direction":     t.Direction,
+			"copyright":     t.Copyright,
+			"license":       t.License,
+			"year":          t.Year,
+			"versification": t.Versification,
+			"books":         t.Books,
+		}}))
+	}
+	if _, err := db.Collection("translations").BulkWrite(ctx, writes); err != nil {
+		return fmt.Errorf("failed to set translation metadata: %w", err)
+	}
+	return nil
+}
+
+// downTranslationMetadata removes the metadata of the seeded translations
+func downTranslationMetadata(ctx context.Context, db *mongo.Database) error {
+	ids := make(bson.A, 0, len(seedTranslationMetadata))
+	for _, t := range seedTranslationMetadata {
+		ids = append(ids, t.ID)
+	}
+	unset := bson.M{}
+	for _, field := range translationMetadataFields {
+		unset[field] = ""
+	}
+	if _, err := db.Collection("translations").UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$unset": unset}); err != nil {
+		return fmt.Errorf("failed to remove translation metadata: %w", err)
+	}
+	return nil
+}
+
+// setVersification moves a translation from one versification scheme to
+// another, unless an admin has already changed it
+func setVersification(ctx context.Context, db *mongo.Database, id, from, to string) error {
+	_, err := db.Collection("translations").UpdateOne(ctx,
+		bson.M{"_id": id, "versification": from}, bson.M{"$set": bson.M{"versification": to}})
+	if err != nil {
+		return fmt.Errorf("failed to set versification of %s: %w", id, err)
+	}
+	return nil
+}
+
+// rewriteVerses applies the update built by rewrite to every Book of Ben
+// verse matching filter; a nil update skips the verse
+func rewriteVerses(ctx context.Context, db *mongo.Database, filter bson.M, rewrite func(models.Verse) bson.M) error {
+	for key, value := range benVerses {
+		filter[key] = value
+	}
+	collection := db.Collection("verses")
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to find verses: %w", err)
+	}
+	var verses []struct {
+		ID           bson.ObjectID `bson:"_id"`
+		models.Verse `bson:",inline"`
+	}
+	if err := cursor.All(ctx, &verses); err != nil {
+		return fmt.Errorf("failed to decode verses: %w", err)
+	}
+
+	var writes []mongo.WriteModel
+	for _, v := range verses {
+		if update := rewrite(v.Verse); update != nil {
+			writes = append(writes, mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": v.ID}).SetUpdate(update))
+		}
+	}
+	if len(writes) == 0 {
+		return nil
+	}
+	if _, err := collection.BulkWrite(ctx, writes); err != nil {
+		return fmt.Errorf("failed to write verses: %w", err)
+	}
+	return nil
+}
+
+// upConcordance indexes the concordance for word and lemma lookups and
+// per-verse updates, then fills it from the verses collection
+func upConcordance(ctx context.Context, db *mongo.Database) error {
+	keys := func(field string) bson.D {
+		return bson.D{
+			{Key: "translation_id", Value: 1},
+			{Key: field, Value: 1},
+			{Key: "book_order", Value: 1},
+			{Key: "book_id", Value: 1},
+			{Key: "chapter", Value: 1},
+			{Key: "verse", Value: 1},
+		}
+	}
+	_, err := db.Collection(concordance.Collection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: keys("word"), Options: options.Index().SetName("word")},
+		{Keys: keys("lemma"), Options: options.Index().SetName("lemma")},
+		{
+			Keys: bson.D{
+				{Key: "translation_id", Value: 1},
+				{Key: "book_id", Value: 1},
+				{Key: "chapter", Value: 1},
+				{Key: "verse", Value: 1},
+			},
+			Options: options.Index().SetName("verse"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to index concordance: %w", err)
+	}
+	_, err = concordance.NewStore(db).Rebuild(ctx, "")
+	return err
+}
@@ -1,32 +1,212 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/annotations"
+)
 
 // Verse represents a single Bible verse
 type Verse struct {
-	BookID        string `json:"book_id" bson:"book_id"`
-	BookName      string `json:"book_name" bson:"book_name"`
-	Chapter       int    `json:"chapter" bson:"chapter"`
-	Verse         int    `json:"verse" bson:"verse"`
-	Text          string `json:"text" bson:"text"`
-	TranslationID string `json:"translation_id" bson:"translation_id"` // 默认为 "en"
+	BookID        string     `json:"book_id" bson:"book_id"`
+	BookName      string     `json:"book_name" bson:"book_name"`
+	Chapter       int        `json:"chapter" bson:"chapter"`
+	Verse         int        `json:"verse" bson:"verse"`
+	Text          string     `json:"text" bson:"text"`
+	TranslationID string     `json:"translation_id" bson:"translation_id"` // 默认为 "en"
+	Footnotes     []Footnote `json:"footnotes,omitempty" bson:"footnotes,omitempty"`
+}
+
+// Footnote is a note anchored in a verse's text after Offset characters
+// (Unicode code points). CrossReferences are references for GET /{reference}.
+type Footnote struct {
+	Offset          int      `json:"offset" bson:"offset"`
+	Type            string   `json:"type" bson:"type"` // "translator", "textual", "editorial"
+	Text            string   `json:"text" bson:"text"`
+	CrossReferences []string `json:"cross_references,omitempty" bson:"cross_references,omitempty"`
 }
 
 // BibleResponse represents the API response for Bible passages
 type BibleResponse struct {
-	Reference       string  `json:"reference"`
-	Verses          []Verse `json:"verses"`
-	Text            string  `json:"text"`
-	TranslationID   string  `json:"translation_id"`
-	TranslationName string  `json:"translation_name"`
-	TranslationNote string  `json:"translation_note"`
+	Reference       string   `json:"reference"`
+	Verses          []Verse  `json:"verses"`
+	Text            string   `json:"text"`
+	TranslationID   string   `json:"translation_id"`
+	TranslationName string   `json:"translation_name"`
+	TranslationNote string   `json:"translation_note"`
+	Language        string   `json:"language,omitempty"`
+	Direction       string   `json:"direction,omitempty"`
+	Copyright       string   `json:"copyright,omitempty"`
+	License         *License `json:"license,omitempty"`
+	Attribution     string   `json:"attribution,omitempty"` // always set when License requires it
+	Versification   string   `json:"versification,omitempty"`
+	// VersificationMapping is set when the reference was renumbered for the translation
+	VersificationMapping *VersificationMapping `json:"versification_mapping,omitempty"`
+	Navigation           *Navigation           `json:"navigation,omitempty"`
+	// Annotations are the caller's own; never cached
+	Annotations     *annotations.Overlay `json:"annotations,omitempty"`
+	CrossReferences []CrossReference     `json:"cross_references,omitempty"`
+}
+
+// VersificationMapping reports that a reference was renumbered from the
+// versification scheme of the request to that of the translation
+type VersificationMapping struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Reference string `json:"reference"` // the verses returned, e.g. "Malachi 3:19-24"
+}
+
+// ParallelResponse is a passage in several translations, in the order requested
+type ParallelResponse struct {
+	Reference     string          `json:"reference"`
+	Versification string          `json:"versification"` // the scheme of Reference
+	Passages      []BibleResponse `json:"passages"`
+}
+
+// ConcordanceOccurrence is a verse a word occurs in
+type ConcordanceOccurrence struct {
+	Reference string `json:"reference"` // e.g. "John 3:16", for GET /{reference}
+	BookID    string `json:"book_id"`
+	Chapter   int    `json:"chapter"`
+	Verse     int    `json:"verse"`
+	Word      string `json:"word"` // the form found, which differs from the query for lemmas
+	Count     int    `json:"count"`
+}
+
+// ConcordanceOccurrences is a page of the occurrences of a word or lemma
+type ConcordanceOccurrences struct {
+	Word          string                  `json:"word"`
+	Lemma         string                  `json:"lemma,omitempty"` // set when matching by lemma
+	TranslationID string                  `json:"translation_id"`
+	Total         int64                   `json:"total"`
+	Offset        int64                   `json:"offset"`
+	Limit         int64                   `json:"limit"`
+	NextOffset    *int64                  `json:"next_offset"` // null on the last page
+	Occurrences   []ConcordanceOccurrence `json:"occurrences"`
+}
+
+// ConcordanceCounts counts the occurrences of a word or lemma per book and chapter
+type ConcordanceCounts struct {
+	Word          string      `json:"word"`
+	Lemma         string      `json:"lemma,omitempty"`
+	TranslationID string      `json:"translation_id"`
+	Occurrences   int         `json:"occurrences"`
+	Verses        int         `json:"verses"`
+	Books         []BookCount `json:"books"`
+}
+
+// BookCount counts the occurrences of a word in a book
+type BookCount struct {
+	BookID      string         `json:"book_id"`
+	BookName    string         `json:"book_name"`
+	Occurrences int            `json:"occurrences"`
+	Verses      int            `json:"verses"`
+	Chapters    []ChapterCount `json:"chapters"`
+}
+
+// ChapterCount counts the occurrences of a word in a chapter
+type ChapterCount struct {
+	Chapter     int    `json:"chapter"`
+	Reference   string `json:"reference"`
+	Occurrences int    `json:"occurrences"`
+	Verses      int    `json:"verses"`
+}
+
+// WordFrequencies are the most frequent words of a translation or book
+type WordFrequencies struct {
+	TranslationID     string          `json:"translation_id"`
+	BookID            string          `json:"book_id,omitempty"`
+	Lemma             bool            `json:"lemma"`
+	StopwordsExcluded bool            `json:"stopwords_excluded"`
+	Words             []WordFrequency `json:"words"`
+}
+
+// WordFrequency is how often a word or lemma occurs, and in how many verses
+type WordFrequency struct {
+	Word        string `json:"word"`
+	Occurrences int    `json:"occurrences"`
+	Verses      int    `json:"verses"`
+}
+
+// CrossReference links a verse of a passage to a related verse range.
+// Books missing from the books collection are named by their ID.
+type CrossReference struct {
+	Source     string `json:"source"`    // the verse linked from, e.g. "John 3:16"
+	Reference  string `json:"reference"` // the range linked to, e.g. "Isaiah 53:5-6"
+	BookID     string `json:"book_id"`
+	Chapter    int    `json:"chapter"`
+	Verse      int    `json:"verse"`
+	EndChapter int    `json:"end_chapter"`
+	EndVerse   int    `json:"end_verse"`
+	Type       string `json:"type"` // "related", "parallel", "quotation", "allusion"
+	Weight     int    `json:"weight"`
+	Dataset    string `json:"dataset"`
+}
+
+// PassageCrossReferences are the cross-references of a passage
+type PassageCrossReferences struct {
+	Reference       string           `json:"reference"`
+	CrossReferences []CrossReference `json:"cross_references"`
+}
+
+// Navigation links a passage to the chapters around it
+type Navigation struct {
+	Previous      *PassageLink `json:"previous"` // null at the first chapter of the first book
+	Next          *PassageLink `json:"next"`     // null at the last chapter of the last book
+	ChapterVerses int          `json:"chapter_verses"`
+	BookChapters  int          `json:"book_chapters"`
+}
+
+// PassageLink points to a chapter; Reference can be passed to GET /{reference}
+// with Versification as ?versification=
+type PassageLink struct {
+	Reference     string `json:"reference"`
+	BookID        string `json:"book_id"`
+	BookName      string `json:"book_name"`
+	Chapter       int    `json:"chapter"`
+	Versification string `json:"versification"`
+}
+
+// BookOutline lists the verse count of every chapter of a book
+type BookOutline struct {
+	BookID        string           `json:"book_id"`
+	BookName      string           `json:"book_name"`
+	TranslationID string           `json:"translation_id"`
+	Chapters      []ChapterOutline `json:"chapters"`
+	TotalVerses   int              `json:"total_verses"`
+}
+
+// ChapterOutline is one chapter of a BookOutline
+type ChapterOutline struct {
+	Chapter   int    `json:"chapter"`
+	Verses    int    `json:"verses"`
+	Reference string `json:"reference"`
 }
 
-// Translation represents a Bible translation
+// Translation represents a Bible translation and its metadata
 type Translation struct {
-	ID   string `json:"id" bson:"id"`
-	Name string `json:"name" bson:"name"`
-	Note string `json:"note" bson:"note"`
+	ID            string   `json:"id" bson:"id"`
+	Name          string   `json:"name" bson:"name"`
+	Note          string   `json:"note" bson:"note"`
+	Language      string   `json:"language,omitempty"`  // BCP 47, e.g. "zh-Hant"
+	Script        string   `json:"script,omitempty"`    // ISO 15924, e.g. "Latn"
+	Direction     string   `json:"direction,omitempty"` // "ltr" or "rtl"
+	Copyright     string   `json:"copyright,omitempty"`
+	License       License  `json:"license"`
+	Attribution   string   `json:"attribution,omitempty"` // must be shown with the text when the license requires it
+	Publisher     string   `json:"publisher,omitempty"`
+	Year          int      `json:"year,omitempty"`
+	Versification string   `json:"versification,omitempty"`
+	Books         []string `json:"books,omitempty"`
+}
+
+// License describes the terms a translation is published under
+type License struct {
+	ID                  string `json:"id,omitempty"` // SPDX identifier or "public-domain"
+	Name                string `json:"name,omitempty"`
+	URL                 string `json:"url,omitempty"`
+	Text                string `json:"text,omitempty"`
+	AttributionRequired bool   `json:"attribution_required"`
 }
 
 // Book represents a Bible book
@@ -67,3 +247,169 @@ type Transaction struct {
 	CreatedAt   time.Time  `json:"created_at"`
 	CompletedAt *time.Time `json:"completed_at"` // 使用指针，因为可能为空
 }
+
+// VerseBatchRequest is the body of the batch verse editing endpoints
+type VerseBatchRequest struct {
+	Verses []Verse `json:"verses"`
+	Reason string  `json:"reason,omitempty"` // 修改原因
+}
+
+// VerseBatchItem reports the outcome of one verse of a batch
+type VerseBatchItem struct {
+	Index         int    `json:"index"`
+	BookID        string `json:"book_id"`
+	TranslationID string `json:"translation_id"`
+	Chapter       int    `json:"chapter"`
+	Verse         int    `json:"verse"`
+	Status        string `json:"status"` // "created", "updated", "deleted", "error"
+	Error         string `json:"error,omitempty"`
+}
+
+// VerseBatchResult is the response of the batch verse editing endpoints
+type VerseBatchResult struct {
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Items     []VerseBatchItem `json:"items"`
+}
+
+// VerseEditRequest is the body of the single verse endpoints
+type VerseEditRequest struct {
+	Text      string     `json:"text"`
+	BookName  string     `json:"book_name,omitempty"`
+	Footnotes []Footnote `json:"footnotes,omitempty"` // replace the stored footnotes when set
+	Reason    string     `json:"reason,omitempty"`
+}
+
+// Revision is one recorded change of a verse
+type Revision struct {
+	ID            string     `json:"id"`
+	TranslationID string     `json:"translation_id"`
+	BookID        string     `json:"book_id"`
+	Chapter       int        `json:"chapter"`
+	Verse         int        `json:"verse"`
+	Op            string     `json:"op"` // "insert", "update", "delete"
+	OldText       string     `json:"old_text"`
+	NewText       string     `json:"new_text"`
+	OldFootnotes  []Footnote `json:"old_footnotes,omitempty"`
+	NewFootnotes  []Footnote `json:"new_footnotes,omitempty"`
+	Editor        string     `json:"editor"`
+	Reason        string     `json:"reason,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// DiffSegment is a run of words that is equal, inserted or deleted
+type DiffSegment struct {
+	Op   string `json:"op"` // "equal", "insert", "delete"
+	Text string `json:"text"`
+}
+
+// RevisionDiff compares the text after two revisions of a verse.
+// To is nil when comparing against the current text.
+type RevisionDiff struct {
+	From     Revision      `json:"from"`
+	To       *Revision     `json:"to,omitempty"`
+	FromText string        `json:"from_text"`
+	ToText   string        `json:"to_text"`
+	Segments []DiffSegment `json:"segments"`
+}
+
+// RevertRequest is the body of the revert endpoint
+type RevertRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// VerseOfDay is the verse of one date
+type VerseOfDay struct {
+	Date     string         `json:"date"`   // YYYY-MM-DD in the requested time zone
+	Source   string         `json:"source"` // "curated" or "generated"
+	Note     string         `json:"note,omitempty"`
+	Passage  *BibleResponse `json:"passage"`
+	TimeZone string         `json:"time_zone"`
+}
+
+// DailyVerseRequest is the body for curating the verse of a date
+type DailyVerseRequest struct {
+	Reference string `json:"reference"`
+	Note      string `json:"note,omitempty"`
+}
+
+// RandomPassage is a randomly picked passage. Seed reproduces it when set.
+type RandomPassage struct {
+	*BibleResponse
+	Seed string `json:"seed,omitempty"`
+}
+
+// PlanRequest creates a reading plan from explicit days or a generator
+type PlanRequest struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Days        []PlanDay      `json:"days,omitempty"`
+	Generator   *PlanGenerator `json:"generator,omitempty"`
+}
+
+// PlanDay lists the references to read on one day of a plan
+type PlanDay struct {
+	Day      int      `json:"day"`
+	Readings []string `json:"readings"`
+}
+
+// PlanGenerator builds the days of a plan.
+// "chapters_per_day" reads PerDay chapters a day through Books in order;
+// "corpus" reads the whole Book of Ben in Days days.
+type PlanGenerator struct {
+	Type   string   `json:"type"`
+	Books  []string `json:"books,omitempty"`
+	PerDay int      `json:"per_day,omitempty"`
+	Days   int      `json:"days,omitempty"`
+}
+
+// EnrollRequest enrolls the caller in a plan
+type EnrollRequest struct {
+	StartDate string `json:"start_date,omitempty"` // YYYY-MM-DD, today if empty
+	TimeZone  string `json:"time_zone,omitempty"`  // IANA name, UTC if empty
+}
+
+// PlanProgress compares an enrollment with the calendar
+type PlanProgress struct {
+	PlanID     string `json:"plan_id"`
+	StartDate  string `json:"start_date"`
+	TimeZone   string `json:"time_zone"`
+	Today      string `json:"today"`
+	CurrentDay int    `json:"current_day"` // day of the plan scheduled for today; 0 before the start
+	TotalDays  int    `json:"total_days"`
+	Completed  []int  `json:"completed"`
+	Behind     []int  `json:"behind"` // scheduled before today and not completed
+	Ahead      int    `json:"ahead"`  // completed days scheduled after today
+	Status     string `json:"status"` // "not_started", "on_track", "behind", "ahead", "finished"
+}
+
+// PlanReadings are the passages of one day of a plan
+type PlanReadings struct {
+	PlanID    string          `json:"plan_id"`
+	Day       int             `json:"day"`
+	Date      string          `json:"date"`
+	Completed bool            `json:"completed"`
+	Passages  []BibleResponse `json:"passages"`
+}
+
+// BookmarkRequest creates or updates a bookmark. Nil fields are left
+// unchanged by an update.
+type BookmarkRequest struct {
+	Reference string    `json:"reference,omitempty"`
+	Folder    *string   `json:"folder,omitempty"`
+	Tags      *[]string `json:"tags,omitempty"`
+	Label     *string   `json:"label,omitempty"`
+}
+
+// HighlightRequest creates or updates a highlight
+type HighlightRequest struct {
+	Reference string `json:"reference,omitempty"`
+	Color     string `json:"color,omitempty"` // a palette name or #rrggbb
+}
+
+// NoteRequest creates or updates a note
+type NoteRequest struct {
+	Reference string  `json:"reference,omitempty"`
+	Text      *string `json:"text,omitempty"`
+}
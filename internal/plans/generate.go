@@ -0,0 +1,90 @@
+package plans
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Generator types accepted by the API
+const (
+	GeneratorChapters = "chapters_per_day"
+	GeneratorCorpus   = "corpus"
+)
+
+// ErrInvalidGenerator is returned for generator parameters that give no plan
+var ErrInvalidGenerator = errors.New("invalid generator")
+
+// Book is a book as the generators need it
+type Book struct {
+	Name     string
+	Chapters int
+}
+
+// ChaptersPerDay reads perDay chapters a day through books in order. The
+// last day may be shorter.
+func ChaptersPerDay(books []Book, perDay int) ([]Day, error) {
+	if perDay < 1 || len(books) == 0 {
+		return nil, fmt.Errorf("%w: at least one book and one chapter per day are required", ErrInvalidGenerator)
+	}
+
+	var days []Day
+	var current []string
+	for _, book := range books {
+		for chapter := 1; chapter <= book.Chapters; chapter++ {
+			current = append(current, fmt.Sprintf("%s %d", book.Name, chapter))
+			if len(current) == perDay {
+				days = append(days, Day{Day: len(days) + 1, Readings: current})
+				current = nil
+			}
+		}
+	}
+	if len(current) > 0 {
+		days = append(days, Day{Day: len(days) + 1, Readings: current})
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("%w: the books have no chapters", ErrInvalidGenerator)
+	}
+	return days, nil
+}
+
+// EvenVerses reads a book in n days with about the same number of verses
+// each day. chapterVerses holds the verse count of every chapter in order.
+// A day's reading is split into one reference per chapter it touches.
+func EvenVerses(bookName string, chapterVerses []int, n int) ([]Day, error) {
+	total := 0
+	for _, verses := range chapterVerses {
+		total += verses
+	}
+	if n < 1 || n > total {
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidGenerator, total)
+	}
+
+	days := make([]Day, n)
+	chapter, verse := 1, 1
+	for d := range days {
+		days[d].Day = d + 1
+		// Verses [d*total/n, (d+1)*total/n) of the book belong to day d
+		remaining := (d+1)*total/n - d*total/n
+		for remaining > 0 {
+			for chapterVerses[chapter-1] == 0 {
+				chapter++
+			}
+			available := chapterVerses[chapter-1] - verse + 1
+			take := min(available, remaining)
+			reference := fmt.Sprintf("%s %d:%d-%d", bookName, chapter, verse, verse+take-1)
+			if verse == 1 && take == chapterVerses[chapter-1] {
+				reference = fmt.Sprintf("%s %d", bookName, chapter)
+			} else if take == 1 {
+				reference = fmt.Sprintf("%s %d:%d", bookName, chapter, verse)
+			}
+			days[d].Readings = append(days[d].Readings, reference)
+
+			remaining -= take
+			verse += take
+			if verse > chapterVerses[chapter-1] {
+				chapter, verse = chapter+1, 1
+			}
+		}
+	}
+	return days, nil
+}
@@ -0,0 +1,208 @@
+// Package plans stores reading plans and the enrollments of users in them.
+package plans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Collections of the store
+const (
+	PlansCollection       = "reading_plans"
+	EnrollmentsCollection = "plan_enrollments"
+)
+
+var (
+	// ErrNotFound is returned for unknown plans and enrollments
+	ErrNotFound = errors.New("not found")
+	// ErrExists is returned when a plan ID is taken
+	ErrExists = errors.New("plan already exists")
+)
+
+// Day is one day of a plan; Readings are references for GET /{reference}
+type Day struct {
+	Day      int      `json:"day" bson:"day"`
+	Readings []string `json:"readings" bson:"readings"`
+}
+
+// Plan is an ordered list of daily readings
+type Plan struct {
+	ID          string    `json:"id" bson:"_id"`
+	Name        string    `json:"name" bson:"name"`
+	Description string    `json:"description,omitempty" bson:"description,omitempty"`
+	DayCount    int       `json:"day_count" bson:"day_count"`
+	Days        []Day     `json:"days,omitempty" bson:"days"`
+	CreatedBy   string    `json:"created_by" bson:"created_by"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
+}
+
+// Enrollment is a user following a plan from StartDate, a YYYY-MM-DD date
+// in TimeZone
+type Enrollment struct {
+	ID        string    `json:"-" bson:"_id"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	PlanID    string    `json:"plan_id" bson:"plan_id"`
+	StartDate string    `json:"start_date" bson:"start_date"`
+	TimeZone  string    `json:"time_zone" bson:"time_zone"`
+	Completed []int     `json:"completed" bson:"completed"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+func enrollmentID(userID, planID string) string {
+	return userID + ":" + planID
+}
+
+// Store keeps plans and enrollments in MongoDB
+type Store struct {
+	plans       *mongo.Collection
+	enrollments *mongo.Collection
+}
+
+// NewStore creates a store on db
+func NewStore(db *mongo.Database) *Store {
+	return &Store{
+		plans:       db.Collection(PlansCollection),
+		enrollments: db.Collection(EnrollmentsCollection),
+	}
+}
+
+// CreatePlan adds a plan
+func (s *Store) CreatePlan(ctx context.Context, plan *Plan) error {
+	plan.DayCount = len(plan.Days)
+	if _, err := s.plans.InsertOne(ctx, plan); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%w: %q", ErrExists, plan.ID)
+		}
+		return fmt.Errorf("failed to create plan: %w", err)
+	}
+	return nil
+}
+
+// GetPlan returns a plan with its days
+func (s *Store) GetPlan(ctx context.Context, id string) (*Plan, error) {
+	var plan Plan
+	if err := s.plans.FindOne(ctx, bson.M{"_id": id}).Decode(&plan); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("plan %q: %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to fetch plan: %w", err)
+	}
+	return &plan, nil
+}
+
+// ListPlans returns every plan without its days
+func (s *Store) ListPlans(ctx context.Context) ([]Plan, error) {
+	cursor, err := s.plans.Find(ctx, bson.M{}, options.Find().
+		SetProjection(bson.M{"days": 0}).
+		SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch plans: %w", err)
+	}
+	plans := []Plan{}
+	if err := cursor.All(ctx, &plans); err != nil {
+		return nil, fmt.Errorf("failed to decode plans: %w", err)
+	}
+	return plans, nil
+}
+
+// DeletePlan removes a plan and every enrollment in it
+func (s *Store) DeletePlan(ctx context.Context, id string) error {
+	result, err := s.plans.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return fmt.Errorf("failed to delete plan: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("plan %q: %w", id, ErrNotFound)
+	}
+	if _, err := s.enrollments.DeleteMany(ctx, bson.M{"plan_id": id}); err != nil {
+		return fmt.Errorf("failed to delete enrollments of plan %q: %w", id, err)
+	}
+	return nil
+}
+
+// Enroll starts or restarts a user's enrollment in a plan. Restarting
+// clears the completed days.
+func (s *Store) Enroll(ctx context.Context, userID, planID, startDate, timeZone string) (*Enrollment, error) {
+	now := time.Now().UTC()
+	enrollment := &Enrollment{
+		ID:        enrollmentID(userID, planID),
+		UserID:    userID,
+		PlanID:    planID,
+		StartDate: startDate,
+		TimeZone:  timeZone,
+		Completed: []int{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	_, err := s.enrollments.ReplaceOne(ctx, bson.M{"_id": enrollment.ID}, enrollment, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to enroll: %w", err)
+	}
+	return enrollment, nil
+}
+
+// GetEnrollment returns a user's enrollment in a plan
+func (s *Store) GetEnrollment(ctx context.Context, userID, planID string) (*Enrollment, error) {
+	var enrollment Enrollment
+	if err := s.enrollments.FindOne(ctx, bson.M{"_id": enrollmentID(userID, planID)}).Decode(&enrollment); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("enrollment in plan %q: %w", planID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to fetch enrollment: %w", err)
+	}
+	return &enrollment, nil
+}
+
+// ListEnrollments returns the enrollments of a user
+func (s *Store) ListEnrollments(ctx context.Context, userID string) ([]Enrollment, error) {
+	cursor, err := s.enrollments.Find(ctx, bson.M{"user_id": userID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch enrollments: %w", err)
+	}
+	enrollments := []Enrollment{}
+	if err := cursor.All(ctx, &enrollments); err != nil {
+		return nil, fmt.Errorf("failed to decode enrollments: %w", err)
+	}
+	return enrollments, nil
+}
+
+// Unenroll removes a user's enrollment in a plan
+func (s *Store) Unenroll(ctx context.Context, userID, planID string) error {
+	result, err := s.enrollments.DeleteOne(ctx, bson.M{"_id": enrollmentID(userID, planID)})
+	if err != nil {
+		return fmt.Errorf("failed to unenroll: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("enrollment in plan %q: %w", planID, ErrNotFound)
+	}
+	return nil
+}
+
+// SetCompleted marks a day of an enrollment complete or not complete and
+// returns the updated enrollment
+func (s *Store) SetCompleted(ctx context.Context, userID, planID string, day int, complete bool) (*Enrollment, error) {
+	update := bson.M{"$pull": bson.M{"completed": day}}
+	if complete {
+		update = bson.M{"$addToSet": bson.M{"completed": day}}
+	}
+	update["$set"] = bson.M{"updated_at": time.Now().UTC()}
+
+	var enrollment Enrollment
+	err := s.enrollments.FindOneAndUpdate(ctx, bson.M{"_id": enrollmentID(userID, planID)}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&enrollment)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("enrollment in plan %q: %w", planID, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update enrollment: %w", err)
+	}
+	return &enrollment, nil
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ParseProxies parses trusted proxy addresses, given as IPs or CIDR ranges
+func ParseProxies(values []string) ([]netip.Prefix, error) {
+	proxies := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		if prefix, err := netip.ParsePrefix(v); err == nil {
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is not an IP or CIDR range", v)
+		}
+		proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return proxies, nil
+}
+
+// RealIP replaces RemoteAddr with the client address a trusted proxy
+// forwarded in X-Forwarded-For or X-Real-IP. Forwarded headers from any
+// other peer are ignored, so clients cannot choose their own address.
+func RealIP(proxies []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if trusted(proxies, clientHost(r.RemoteAddr)) {
+				if ip := forwardedIP(proxies, r.Header); ip != "" {
+					r.RemoteAddr = ip
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedIP returns the nearest address in X-Forwarded-For that is not
+// a trusted proxy, or X-Real-IP when there is no X-Forwarded-For
+func forwardedIP(proxies []netip.Prefix, h http.Header) string {
+	var hops []string
+	for _, v := range h.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(v, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	if len(hops) == 0 {
+		if ip, err := netip.ParseAddr(strings.TrimSpace(h.Get("X-Real-IP"))); err == nil {
+			return ip.Unmap().String()
+		}
+		return ""
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			return ""
+		}
+		if i == 0 || !trusted(proxies, hops[i]) {
+			return ip.Unmap().String()
+		}
+	}
+	return ""
+}
+
+// trusted reports whether host is one of the proxies
+func trusted(proxies []netip.Prefix, host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range proxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientHost strips the port from a RemoteAddr, which is kept as is when it
+// has none
+func clientHost(remoteAddr string) string {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
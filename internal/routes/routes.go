@@ -1,12 +1,27 @@
 package routes
 
 import (
-	"log"
+	"context"
+	"log/slog"
+	"net/http"
 	"os"
 
-	"github.com/joho/godotenv"
+	"github.com/tkdnbb/bookofben-api/internal/annotations"
+	"github.com/tkdnbb/bookofben-api/internal/audit"
+	"github.com/tkdnbb/bookofben-api/internal/auth"
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/crossrefs"
 	"github.com/tkdnbb/bookofben-api/internal/database"
 	"github.com/tkdnbb/bookofben-api/internal/handlers"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/metrics"
+	"github.com/tkdnbb/bookofben-api/internal/migrate"
+	"github.com/tkdnbb/bookofben-api/internal/plans"
+	"github.com/tkdnbb/bookofben-api/internal/ratelimit"
+	"github.com/tkdnbb/bookofben-api/internal/services"
+	"github.com/tkdnbb/bookofben-api/internal/startup"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -14,62 +29,188 @@ import (
 )
 
 // SetupRoutes configures and returns the router
-func SetupRoutes() *chi.Mux {
-	// Load .env file
-	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: No .env file found or failed to load")
-	}
+func SetupRoutes(ctx context.Context, cfg *config.Config) *chi.Mux {
+	ctx, span := tracing.Start(ctx, "routes.SetupRoutes")
+	defer span.End()
 
-	// Get MongoDB connection string from environment
-	mongoConn := os.Getenv("MONGO_CONNECTION")
-	if mongoConn == "" {
-		log.Fatal("MONGO_CONNECTION not set in environment")
+	// MongoDB connection string is required to serve requests
+	if cfg.Database.URI == "" {
+		slog.Error("MONGO_CONNECTION not set in environment")
+		os.Exit(1)
 	}
 
-	// Initialize database connection
-	if err := database.InitMongoDB(mongoConn, "bible_api"); err != nil {
-		log.Fatal("Failed to initialize database:", err)
+	// Configure database connection; it is opened lazily by the first query
+	if err := database.Configure(cfg.Database); err != nil {
+		slog.Error("failed to initialize database", "error", err)
+		os.Exit(1)
 	}
 
-	// Initialize sample data
-	if err := database.InitializeData(); err != nil {
-		log.Printf("Warning: Failed to initialize data: %v", err)
+	// Migrations are normally applied by cmd/migrate, outside request-serving startup
+	if cfg.Seed.OnStartup {
+		done := startup.Track("migrate")
+		if _, err := migrate.New(database.GetDatabase()).Up(ctx, 0); err != nil {
+			slog.Warn("failed to apply migrations", "error", err)
+		}
+		done()
 	}
 
 	r := chi.NewRouter()
 
 	// Middleware
-	r.Use(middleware.Logger)
+	r.Use(middleware.RequestID)
+	proxies, err := ratelimit.ParseProxies(cfg.Server.TrustedProxies)
+	if err != nil {
+		slog.Warn("ignoring trusted proxies", "error", err)
+	}
+	r.Use(ratelimit.RealIP(proxies))
+	r.Use(tracing.Middleware)
+	r.Use(metrics.Middleware)
+	r.Use(logging.Middleware)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.RealIP)
+	if cfg.RateLimit.Enabled {
+		r.Use(ratelimit.New(cfg.RateLimit.RequestsPerSecond, cfg.RateLimit.Burst).Middleware)
+	}
 
 	// CORS configuration
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedOrigins:   cfg.CORS.AllowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", auth.APIKeyHeader},
 		ExposedHeaders:   []string{"Link"},
-		AllowCredentials: true,
-		MaxAge:           300,
+		AllowCredentials: cfg.CORS.AllowCredentials,
+		MaxAge:           cfg.CORS.MaxAge,
 	}))
 
 	// Initialize handlers
-	bibleHandler := handlers.NewBibleHandler()
+	bibleService := services.NewBibleService(cfg.Cache)
+	annotationService := services.NewAnnotationService(bibleService, annotations.NewStore(database.GetDatabase()))
+	crossrefService := services.NewCrossReferenceService(bibleService, crossrefs.NewStore(database.GetDatabase()))
+	bibleHandler := handlers.NewBibleHandler(bibleService, annotationService, crossrefService)
+	crossrefHandler := handlers.NewCrossReferenceHandler(crossrefService)
+	concordanceHandler := handlers.NewConcordanceHandler(services.NewConcordanceService(bibleService, concordance.NewStore(database.GetDatabase())))
+	annotationHandler := handlers.NewAnnotationHandler(annotationService)
+	votdHandler := handlers.NewVerseOfDayHandler(services.NewVerseOfDayService(bibleService, cfg.VerseOfDay))
+	healthHandler := handlers.NewHealthHandler(services.NewHealthService())
+	auditHandler := handlers.NewAuditHandler(audit.NewStore(database.GetDatabase()))
+	authStore := auth.NewStore(database.GetDatabase())
+	if cfg.Auth.Disabled {
+		slog.Warn("authentication is disabled, write endpoints are open to anyone")
+	}
+	requireEditor := auth.RequireRole(authStore, cfg.Auth.AdminToken, cfg.Auth.Disabled, auth.RoleEditor)
+	requireAdmin := auth.RequireRole(authStore, cfg.Auth.AdminToken, cfg.Auth.Disabled)
+	requireReader := auth.RequireRole(authStore, cfg.Auth.AdminToken, cfg.Auth.Disabled, auth.RoleReader, auth.RoleEditor)
+	// Per-user data needs a known caller even when authentication is disabled
+	requireUser := func(next http.Handler) http.Handler { return requireReader(auth.RequireUser(next)) }
+	planHandler := handlers.NewReadingPlanHandler(services.NewReadingPlanService(bibleService, plans.NewStore(database.GetDatabase())))
+
+	// Prometheus metrics
+	r.Handle("/metrics", metrics.Handler())
+
+	// Probes and build information; static routes take precedence over /{reference}
+	r.Get("/healthz", healthHandler.Liveness)
+	r.Get("/readyz", healthHandler.Readiness)
+	r.Get("/version", healthHandler.Version)
 
-	// Bible passage routes
-	r.Get("/{reference}", bibleHandler.GetBiblePassage)
+	// Bible passage routes; overlaying the caller's annotations requires a reader key
+	r.With(when(handlers.WantsAnnotations, requireUser)).Get("/{reference}", bibleHandler.GetBiblePassage)
 
 	// API routes
 	r.Route("/api", func(r chi.Router) {
 		r.Get("/translations", bibleHandler.GetTranslations)
 		r.Get("/books", bibleHandler.GetBooks)
-		r.Post("/verses", bibleHandler.AddVerse)    // 新增经文
+		r.Get("/books/{book}/outline", bibleHandler.GetBookOutline)
 		r.Get("/search", bibleHandler.SearchVerses) // 搜索经文
+		r.Get("/random", bibleHandler.GetRandomPassage)
+		r.Get("/parallel/{reference}", bibleHandler.GetParallel)
+		r.Get("/crossrefs/{reference}", crossrefHandler.List)
+		r.Get("/cache/stats", bibleHandler.GetCacheStats)
+		r.Route("/concordance", func(r chi.Router) {
+			r.Get("/frequencies", concordanceHandler.Frequencies)
+			r.Get("/words/{word}", concordanceHandler.Occurrences)
+			r.Get("/words/{word}/counts", concordanceHandler.Counts)
+		})
+
+		// Verse editing requires an editor API key or the admin token
+		r.Route("/verses", func(r chi.Router) {
+			r.Use(requireEditor)
+			r.Post("/", bibleHandler.AddVerse) // 新增经文
+			r.Post("/batch", bibleHandler.InsertVerses)
+			r.Put("/batch", bibleHandler.UpsertVerses)
+			r.Patch("/batch", bibleHandler.UpdateVerses)
+			r.Delete("/batch", bibleHandler.DeleteVerses)
+			r.Put("/{translation}/{book}/{chapter}/{verse}", bibleHandler.UpdateVerse)
+			r.Delete("/{translation}/{book}/{chapter}/{verse}", bibleHandler.DeleteVerse)
+			r.Get("/{translation}/{book}/{chapter}/{verse}/revisions", bibleHandler.VerseHistory)
+		})
+		r.Route("/revisions", func(r chi.Router) {
+			r.Use(requireEditor)
+			r.Get("/diff", bibleHandler.DiffRevisions)
+			r.Post("/{id}/revert", bibleHandler.RevertRevision)
+		})
+		r.With(requireAdmin).Get("/audit", auditHandler.List)
+
+		// Reading plans; progress belongs to the calling user
+		r.Route("/plans", func(r chi.Router) {
+			r.Get("/", planHandler.List)
+			r.With(requireEditor).Post("/", planHandler.Create)
+			r.Get("/{id}", planHandler.Get)
+			r.With(requireAdmin).Delete("/{id}", planHandler.Delete)
+			r.Group(func(r chi.Router) {
+				r.Use(requireUser)
+				r.Post("/{id}/enrollment", planHandler.Enroll)
+				r.Delete("/{id}/enrollment", planHandler.Unenroll)
+				r.Get("/{id}/progress", planHandler.Progress)
+				r.Get("/{id}/today", planHandler.Today)
+				r.Get("/{id}/days/{day}", planHandler.Day)
+				r.Put("/{id}/days/{day}/complete", planHandler.Complete)
+				r.Delete("/{id}/days/{day}/complete", planHandler.Uncomplete)
+			})
+		})
+		// Enrollments and the private bookmarks, highlights and notes of the calling user
+		r.Route("/me", func(r chi.Router) {
+			r.Use(requireUser)
+			r.Get("/plans", planHandler.Enrollments)
+			r.Get("/bookmarks", annotationHandler.ListBookmarks)
+			r.Post("/bookmarks", annotationHandler.CreateBookmark)
+			r.Get("/bookmarks/folders", annotationHandler.Folders)
+			r.Patch("/bookmarks/{id}", annotationHandler.UpdateBookmark)
+			r.Delete("/bookmarks/{id}", annotationHandler.DeleteBookmark)
+			r.Get("/highlights", annotationHandler.ListHighlights)
+			r.Post("/highlights", annotationHandler.CreateHighlight)
+			r.Patch("/highlights/{id}", annotationHandler.UpdateHighlight)
+			r.Delete("/highlights/{id}", annotationHandler.DeleteHighlight)
+			r.Get("/notes", annotationHandler.ListNotes)
+			r.Post("/notes", annotationHandler.CreateNote)
+			r.Patch("/notes/{id}", annotationHandler.UpdateNote)
+			r.Delete("/notes/{id}", annotationHandler.DeleteNote)
+		})
+
+		// Verse of the day; curating it is reserved for admins
+		r.Route("/votd", func(r chi.Router) {
+			r.Get("/", votdHandler.Get)
+			r.With(requireAdmin).Get("/curated", votdHandler.ListCurated)
+			r.With(requireAdmin).Put("/curated/{date}", votdHandler.SetCurated)
+			r.With(requireAdmin).Delete("/curated/{date}", votdHandler.DeleteCurated)
+		})
 	})
 
 	return r
 }
 
+// when applies middleware only to requests matching cond
+func when(cond func(*http.Request) bool, middleware func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		wrapped := middleware(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if cond(r) {
+				wrapped.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // CloseDatabase provides a way to close the database connection
 func CloseDatabase() error {
 	return database.Close()
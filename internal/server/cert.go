@@ -0,0 +1,88 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// checkInterval limits how often the certificate files are checked for changes
+const checkInterval = 30 * time.Second
+
+// CertReloader serves a TLS certificate that is reloaded when its files change
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+// NewCertReloader loads the key pair and returns a reloader for it
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the key pair from disk. The previous certificate is kept on error.
+func (r *CertReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	modTime := r.latestModTime()
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.modTime = modTime
+	r.lastCheck = time.Now()
+	r.mu.Unlock()
+
+	slog.Info("TLS certificate loaded", "cert_file", r.certFile)
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.maybeReload()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// maybeReload reloads the certificate if the files changed since the last load
+func (r *CertReloader) maybeReload() {
+	r.mu.Lock()
+	if time.Since(r.lastCheck) < checkInterval {
+		r.mu.Unlock()
+		return
+	}
+	r.lastCheck = time.Now()
+	known := r.modTime
+	r.mu.Unlock()
+
+	if r.latestModTime().After(known) {
+		if err := r.Reload(); err != nil {
+			slog.Error("failed to reload TLS certificate", "error", err)
+		}
+	}
+}
+
+func (r *CertReloader) latestModTime() time.Time {
+	var latest time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		if info, err := os.Stat(name); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/health"
+)
+
+// Server is the HTTP server of cmd/api
+type Server struct {
+	cfg  config.ServerConfig
+	http *http.Server
+	cert *CertReloader
+}
+
+// New creates a server for handler with the configured timeouts and, when
+// configured, a TLS certificate that can be reloaded while running
+func New(cfg config.ServerConfig, handler http.Handler) (*Server, error) {
+	s := &Server{
+		cfg: cfg,
+		http: &http.Server{
+			Addr:              cfg.Addr,
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+			ErrorLog:          slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn),
+		},
+	}
+
+	if cfg.TLS.Enabled() {
+		cert, err := NewCertReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		s.cert = cert
+		s.http.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: cert.GetCertificate,
+		}
+	}
+
+	return s, nil
+}
+
+// ListenAndServe serves until Shutdown is called.
+// It returns nil after a graceful shutdown.
+func (s *Server) ListenAndServe() error {
+	health.SetReady(true)
+
+	var err error
+	if s.cert != nil {
+		slog.Info("Bible API Server starting", "addr", s.cfg.Addr, "tls", true)
+		err = s.http.ListenAndServeTLS("", "")
+	} else {
+		slog.Info("Bible API Server starting", "addr", s.cfg.Addr, "tls", false)
+		err = s.http.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// ReloadCertificate re-reads the TLS certificate, if TLS is enabled
+func (s *Server) ReloadCertificate() error {
+	if s.cert == nil {
+		return nil
+	}
+	return s.cert.Reload()
+}
+
+// Shutdown marks the instance not ready, waits for the drain delay so load
+// balancers stop routing to it, then stops accepting connections and waits
+// for in-flight requests until the shutdown timeout expires
+func (s *Server) Shutdown(ctx context.Context) error {
+	health.SetReady(false)
+
+	if s.cfg.DrainDelay > 0 {
+		slog.Info("not ready, waiting before draining", "delay", s.cfg.DrainDelay.String())
+		select {
+		case <-time.After(s.cfg.DrainDelay):
+		case <-ctx.Done():
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := s.http.Shutdown(ctx); err != nil {
+		// Deadline reached: drop the remaining connections
+		s.http.Close()
+		return fmt.Errorf("in-flight requests did not finish within %s: %w", s.cfg.ShutdownTimeout, err)
+	}
+	slog.Info("in-flight requests drained", "duration", time.Since(start).String())
+	return nil
+}
@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/annotations"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Limits of annotation fields
+const (
+	MaxNoteLength = 10000
+	MaxTags       = 20
+	MaxLabel      = 200
+)
+
+// HighlightColors are the named highlight colors; #rrggbb is accepted as well
+var HighlightColors = []string{"yellow", "green", "blue", "pink", "purple", "orange"}
+
+// Annotation errors
+var (
+	ErrAnnotationNotFound = annotations.ErrNotFound
+	ErrInvalidAnnotation  = errors.New("invalid annotation")
+)
+
+var hexColor = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// AnnotationService manages the caller's bookmarks, highlights and notes
+type AnnotationService struct {
+	bible *BibleService
+	store *annotations.Store
+}
+
+// NewAnnotationService creates a new AnnotationService instance
+func NewAnnotationService(bible *BibleService, store *annotations.Store) *AnnotationService {
+	return &AnnotationService{bible: bible, store: store}
+}
+
+// resolve parses a reference into the range an annotation covers
+func (s *AnnotationService) resolve(reference string) (annotations.Range, error) {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return annotations.Range{}, fmt.Errorf("%w: reference is required", ErrInvalidAnnotation)
+	}
+	bookID, chapter, start, end, err := s.bible.parseReference(reference)
+	if err != nil {
+		return annotations.Range{}, fmt.Errorf("%w: %q: %v", ErrInvalidAnnotation, reference, err)
+	}
+	if chapter < 1 || start < 0 || end < start {
+		return annotations.Range{}, fmt.Errorf("%w: %q is not a valid range", ErrInvalidAnnotation, reference)
+	}
+	return annotations.Range{Reference: reference, BookID: bookID, Chapter: chapter, StartVerse: start, EndVerse: end}, nil
+}
+
+// Filter builds a filter from an optional reference
+func (s *AnnotationService) Filter(reference string) (annotations.Filter, error) {
+	if reference == "" {
+		return annotations.Filter{}, nil
+	}
+	r, err := s.resolve(reference)
+	if err != nil {
+		return annotations.Filter{}, err
+	}
+	return annotations.Filter{BookID: r.BookID, Chapter: r.Chapter, StartVerse: r.StartVerse, EndVerse: r.EndVerse}, nil
+}
+
+// Overlay attaches the caller's annotations overlapping a passage to it.
+// The passage must not be shared with other callers.
+func (s *AnnotationService) Overlay(ctx context.Context, passage *models.BibleResponse) error {
+	ctx, span := tracing.Start(ctx, "AnnotationService.Overlay")
+	defer span.End()
+
+	bookID, chapter, start, endChapter, end, err := s.bible.parseReferenceRange(passage.Reference)
+	if err != nil {
+		return fmt.Errorf("%w: %q: %v", ErrInvalidAnnotation, passage.Reference, err)
+	}
+	user := callerFromContext(ctx)
+	overlay := &annotations.Overlay{Bookmarks: []annotations.Bookmark{}, Highlights: []annotations.Highlight{}, Notes: []annotations.Note{}}
+	for _, p := range chapterPassages(bookID, chapter, start, endChapter, end) {
+		filter := annotations.Filter{BookID: p.BookID, Chapter: p.Chapter, StartVerse: p.StartVerse, EndVerse: p.EndVerse}
+		bookmarks, err := s.store.ListBookmarks(ctx, user, filter)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		highlights, err := s.store.ListHighlights(ctx, user, filter)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		notes, err := s.store.ListNotes(ctx, user, filter)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		overlay.Bookmarks = append(overlay.Bookmarks, bookmarks...)
+		overlay.Highlights = append(overlay.Highlights, highlights...)
+		overlay.Notes = append(overlay.Notes, notes...)
+	}
+	passage.Annotations = overlay
+	return nil
+}
+
+// CreateBookmark bookmarks a reference for the caller
+func (s *AnnotationService) CreateBookmark(ctx context.Context, req models.BookmarkRequest) (*annotations.Bookmark, error) {
+	r, err := s.resolve(req.Reference)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := bookmarkFields(req)
+	if err != nil {
+		return nil, err
+	}
+	bookmark := &annotations.Bookmark{UserID: callerFromContext(ctx), Range: r}
+	if folder, ok := fields["folder"].(string); ok {
+		bookmark.Folder = folder
+	}
+	if tags, ok := fields["tags"].([]string); ok {
+		bookmark.Tags = tags
+	}
+	if label, ok := fields["label"].(string); ok {
+		bookmark.Label = label
+	}
+	if err := s.store.CreateBookmark(ctx, bookmark); err != nil {
+		return nil, err
+	}
+	return bookmark, nil
+}
+
+// ListBookmarks returns the caller's bookmarks
+func (s *AnnotationService) ListBookmarks(ctx context.Context, filter annotations.Filter) ([]annotations.Bookmark, error) {
+	return s.store.ListBookmarks(ctx, callerFromContext(ctx), filter)
+}
+
+// Folders returns the caller's bookmark folders
+func (s *AnnotationService) Folders(ctx context.Context) ([]string, error) {
+	return s.store.Folders(ctx, callerFromContext(ctx))
+}
+
+// UpdateBookmark changes the given fields of one of the caller's bookmarks
+func (s *AnnotationService) UpdateBookmark(ctx context.Context, id string, req models.BookmarkRequest) (*annotations.Bookmark, error) {
+	objectID, err := annotationID(id)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := bookmarkFields(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setRange(fields, req.Reference); err != nil {
+		return nil, err
+	}
+	return s.store.UpdateBookmark(ctx, callerFromContext(ctx), objectID, fields)
+}
+
+// DeleteBookmark removes one of the caller's bookmarks
+func (s *AnnotationService) DeleteBookmark(ctx context.Context, id string) error {
+	objectID, err := annotationID(id)
+	if err != nil {
+		return err
+	}
+	return s.store.DeleteBookmark(ctx, callerFromContext(ctx), objectID)
+}
+
+// CreateHighlight highlights a reference for the caller
+func (s *AnnotationService) CreateHighlight(ctx context.Context, req models.HighlightRequest) (*annotations.Highlight, error) {
+	r, err := s.resolve(req.Reference)
+	if err != nil {
+		return nil, err
+	}
+	if req.Color == "" {
+		req.Color = HighlightColors[0]
+	}
+	color, err := highlightColor(req.Color)
+	if err != nil {
+		return nil, err
+	}
+	highlight := &annotations.Highlight{UserID: callerFromContext(ctx), Range: r, Color: color}
+	if err := s.store.CreateHighlight(ctx, highlight); err != nil {
+		return nil, err
+	}
+	return highlight, nil
+}
+
+// ListHighlights returns the caller's highlights
+func (s *AnnotationService) ListHighlights(ctx context.Context, filter annotations.Filter) ([]annotations.Highlight, error) {
+	return s.store.ListHighlights(ctx, callerFromContext(ctx), filter)
+}
+
+// UpdateHighlight changes the color or range of one of the caller's highlights
+func (s *AnnotationService) UpdateHighlight(ctx context.Context, id string, req models.HighlightRequest) (*annotations.Highlight, error) {
+	objectID, err := annotationID(id)
+	if err != nil {
+		return nil, err
+	}
+	fields := bson.M{}
+	if req.Color != "" {
+		if fields["color"], err = highlightColor(req.Color); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.setRange(fields, req.Reference); err != nil {
+		return nil, err
+	}
+	return s.store.UpdateHighlight(ctx, callerFromContext(ctx), objectID, fields)
+}
+
+// DeleteHighlight removes one of the caller's highlights
+func (s *AnnotationService) DeleteHighlight(ctx context.Context, id string) error {
+	objectID, err := annotationID(id)
+	if err != nil {
+		return err
+	}
+	return s.store.DeleteHighlight(ctx, callerFromContext(ctx), objectID)
+}
+
+// CreateNote attaches a note to a reference for the caller
+func (s *AnnotationService) CreateNote(ctx context.Context, req models.NoteRequest) (*annotations.Note, error) {
+	r, err := s.resolve(req.Reference)
+	if err != nil {
+		return nil, err
+	}
+	if req.Text == nil {
+		return nil, fmt.Errorf("%w: text is required", ErrInvalidAnnotation)
+	}
+	text, err := noteText(*req.Text)
+	if err != nil {
+		return nil, err
+	}
+	note := &annotations.Note{UserID: callerFromContext(ctx), Range: r, Text: text}
+	if err := s.store.CreateNote(ctx, note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+// ListNotes returns the caller's notes
+func (s *AnnotationService) ListNotes(ctx context.Context, filter annotations.Filter) ([]annotations.Note, error) {
+	return s.store.ListNotes(ctx, callerFromContext(ctx), filter)
+}
+
+// UpdateNote changes the text or reference of one of the caller's notes
+func (s *AnnotationService) UpdateNote(ctx context.Context, id string, req models.NoteRequest) (*annotations.Note, error) {
+	objectID, err := annotationID(id)
+	if err != nil {
+		return nil, err
+	}
+	fields := bson.M{}
+	if req.Text != nil {
+		if fields["text"], err = noteText(*req.Text); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.setRange(fields, req.Reference); err != nil {
+		return nil, err
+	}
+	return s.store.UpdateNote(ctx, callerFromContext(ctx), objectID, fields)
+}
+
+// DeleteNote removes one of the caller's notes
+func (s *AnnotationService) DeleteNote(ctx context.Context, id string) error {
+	objectID, err := annotationID(id)
+	if err != nil {
+		return err
+	}
+	return s.store.DeleteNote(ctx, callerFromContext(ctx), objectID)
+}
+
+// setRange adds the range of a new reference to update fields
+func (s *AnnotationService) setRange(fields bson.M, reference string) error {
+	if reference == "" {
+		return nil
+	}
+	r, err := s.resolve(reference)
+	if err != nil {
+		return err
+	}
+	fields["reference"], fields["book_id"], fields["chapter"] = r.Reference, r.BookID, r.Chapter
+	fields["start_verse"], fields["end_verse"] = r.StartVerse, r.EndVerse
+	return nil
+}
+
+func bookmarkFields(req models.BookmarkRequest) (bson.M, error) {
+	fields := bson.M{}
+	if req.Folder != nil {
+		fields["folder"] = strings.TrimSpace(*req.Folder)
+	}
+	if req.Label != nil {
+		label := strings.TrimSpace(*req.Label)
+		if len(label) > MaxLabel {
+			return nil, fmt.Errorf("%w: label is longer than %d bytes", ErrInvalidAnnotation, MaxLabel)
+		}
+		fields["label"] = label
+	}
+	if req.Tags != nil {
+		tags := []string{}
+		for _, tag := range *req.Tags {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag != "" && !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) > MaxTags {
+			return nil, fmt.Errorf("%w: at most %d tags are allowed", ErrInvalidAnnotation, MaxTags)
+		}
+		fields["tags"] = tags
+	}
+	return fields, nil
+}
+
+func highlightColor(color string) (string, error) {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if !slices.Contains(HighlightColors, color) && !hexColor.MatchString(color) {
+		return "", fmt.Errorf("%w: color must be #rrggbb or one of %s", ErrInvalidAnnotation, strings.Join(HighlightColors, ", "))
+	}
+	return color, nil
+}
+
+func noteText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("%w: text is required", ErrInvalidAnnotation)
+	}
+	if len(text) > MaxNoteLength {
+		return "", fmt.Errorf("%w: text is longer than %d bytes", ErrInvalidAnnotation, MaxNoteLength)
+	}
+	return text, nil
+}
+
+func annotationID(id string) (bson.ObjectID, error) {
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return bson.ObjectID{}, fmt.Errorf("annotation %q: %w", id, ErrAnnotationNotFound)
+	}
+	return objectID, nil
+}
@@ -1,59 +1,242 @@
 package services
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/tkdnbb/bookofben-api/internal/cache"
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+	"github.com/tkdnbb/bookofben-api/internal/config"
 	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/metrics"
 	"github.com/tkdnbb/bookofben-api/internal/models"
-	"go.mongodb.org/mongo-driver/v2/bson"
-	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/text/language"
+)
+
+// Reference parse errors
+var (
+	ErrInvalidReference = errors.New("invalid reference format")
+	ErrBookNotFound     = errors.New("book not found")
+)
+
+// restOfChapter ends a verse range at the last verse of its chapter
+const restOfChapter = math.MaxInt32
+
+// DefaultTranslation is preferred for passages requested without a translation
+const DefaultTranslation = "en"
+
+// MaxReferenceChapters limits the chapters one reference may span
+const MaxReferenceChapters = 10
+
+// Passage lookup errors
+var (
+	ErrTranslationNotFound = errors.New("translation not found")
+	ErrNoVerses            = errors.New("no verses found")
+	// ErrAttributionMissing is returned instead of the text of a translation
+	// whose license requires an attribution it does not have
+	ErrAttributionMissing = errors.New("translation license requires an attribution")
+	ErrInvalidLanguage    = errors.New("invalid language tag")
 )
 
 // BibleService handles business logic for Bible operations
 type BibleService struct {
-	repo *database.Repository
+	repo         *database.Repository
+	concordance  *concordance.Store
+	passageCache *cache.LRU[models.BibleResponse]
+	searchCache  *cache.LRU[[]models.Verse]
+}
+
+// CacheStats reports the hit/miss counters of the service caches
+type CacheStats struct {
+	Passages cache.Stats `json:"passages"`
+	Search   cache.Stats `json:"search"`
 }
 
 // NewBibleService creates a new BibleService instance
-func NewBibleService() *BibleService {
-	return &BibleService{
-		repo: database.NewRepository(), // 你需要确保这个方法存在
+func NewBibleService(cacheConfig config.CacheConfig) *BibleService {
+	s := &BibleService{
+		repo:         database.NewRepository(), // 你需要确保这个方法存在
+		concordance:  concordance.NewStore(database.GetDatabase()),
+		passageCache: cache.New[models.BibleResponse](cacheConfig.PassageSize, cacheConfig.PassageTTL),
+		searchCache:  cache.New[[]models.Verse](cacheConfig.SearchSize, cacheConfig.SearchTTL),
 	}
+	metrics.RegisterCache("passages", s.passageCache.Stats)
+	metrics.RegisterCache("search", s.searchCache.Stats)
+	return s
 }
 
-// GetPassage retrieves a Bible passage by reference and translation
-func (s *BibleService) GetPassage(reference, translation string) (*models.BibleResponse, error) {
-	// Default translation
-	if translation == "" {
-		translation = "en" // 或者你想要的默认翻译
+// passageCacheKey builds the cache key from the normalized reference and translation
+func passageCacheKey(translation, bookID string, chapter, startVerse, endVerse int) string {
+	return fmt.Sprintf("%s|%s.%d:%d-%d", translation, bookID, chapter, startVerse, endVerse)
+}
+
+// chapterTag groups cache entries belonging to one chapter
+func chapterTag(bookID string, chapter int) string {
+	return fmt.Sprintf("%s.%d", bookID, chapter)
+}
+
+// InvalidateChapter drops cached passages of the given chapter and all cached search results.
+// Every write path touching verses must call it.
+func (s *BibleService) InvalidateChapter(bookID string, chapter int) {
+	s.passageCache.InvalidateTag(chapterTag(bookID, chapter))
+	s.searchCache.Purge()
+}
+
+// CacheStats returns the current cache counters
+func (s *BibleService) CacheStats() CacheStats {
+	return CacheStats{
+		Passages: s.passageCache.Stats(),
+		Search:   s.searchCache.Stats(),
 	}
+}
+
+// GetPassage retrieves a Bible passage by reference and translation. The
+// reference is numbered in the default versification scheme.
+func (s *BibleService) GetPassage(ctx context.Context, reference, translation string) (*models.BibleResponse, error) {
+	return s.GetPassageIn(ctx, reference, translation, "")
+}
+
+// GetPassageIn retrieves a Bible passage whose reference is numbered in the
+// versification scheme given, mapped to the scheme of the translation. An
+// empty translation picks one that has the book.
+func (s *BibleService) GetPassageIn(ctx context.Context, reference, translation, scheme string) (*models.BibleResponse, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.GetPassage")
+	defer span.End()
+
+	scheme, err := versificationScheme(scheme)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("bible.reference", reference), attribute.String("bible.translation", translation),
+		attribute.String("bible.versification", scheme))
 
 	// Parse reference
-	bookID, chapter, startVerse, endVerse, err := s.parseReference(reference)
+	bookID, chapter, startVerse, endChapter, endVerse, err := s.parseReferenceRange(reference)
 	if err != nil {
+		metrics.ReferenceParseFailed(parseFailureReason(err))
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 
+	key := scheme + "|" + passageCacheKey(translation, bookID, chapter, startVerse, endVerse)
+	if endChapter != chapter {
+		key += fmt.Sprintf("|%d", endChapter)
+	}
+	if cached, ok := s.passageCache.Get(key); ok {
+		span.SetAttributes(attribute.Bool("cache.hit", true))
+		cached.Reference = reference
+		return &cached, nil
+	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
+
+	// Default translation: one that has the book
+	if translation == "" {
+		if translation, err = s.defaultTranslation(ctx, bookID); err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+	}
+
 	// Get translation info from database
-	trans, err := s.repo.GetTranslation(translation)
+	trans, err := s.translation(ctx, translation)
 	if err != nil {
-		return nil, fmt.Errorf("translation not found")
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	if endChapter != chapter {
+		if err := s.checkEndChapter(ctx, bookID, endChapter); err != nil {
+			return nil, err
+		}
 	}
 
-	// Get verses from database
-	verses, err := s.getVersesFromDB(bookID, chapter, startVerse, endVerse)
+	// Get verses from database, renumbered for the translation
+	passages := chapterPassages(bookID, chapter, startVerse, endChapter, endVerse)
+	verses, mapping, err := s.versifiedVerses(ctx, trans, passages, scheme)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 
 	if len(verses) == 0 {
-		return nil, fmt.Errorf("no verses found")
+		return nil, ErrNoVerses
 	}
 
 	// Build response
+	response := s.passageResponse(reference, trans, verses)
+	response.VersificationMapping = mapping
+
+	// Books missing from the books collection have no place in the canonical order
+	nav, err := s.navigation(ctx, trans, bookID, verses[0].Chapter)
+	switch {
+	case err == nil:
+		response.Navigation = nav
+	case !errors.Is(err, ErrBookNotFound):
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	// Tagged with every chapter the verses came from, so edits to any of them invalidate it
+	var tags []string
+	for _, v := range verses {
+		if tag := chapterTag(v.BookID, v.Chapter); !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	s.passageCache.Set(key, *response, tags...)
+	return response, nil
+}
+
+// defaultTranslation picks the translation of a request that names none:
+// DefaultTranslation when it has the book, otherwise the first translation
+// by ID that lists it in its books
+func (s *BibleService) defaultTranslation(ctx context.Context, bookID string) (string, error) {
+	translations, err := s.repo.GetAllTranslations(ctx)
+	if err != nil {
+		return "", err
+	}
+	slices.SortFunc(translations, func(a, b database.Translation) int {
+		switch {
+		case a.ID == DefaultTranslation:
+			return -1
+		case b.ID == DefaultTranslation:
+			return 1
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	for _, t := range translations {
+		if slices.Contains(t.Books, bookID) {
+			return t.ID, nil
+		}
+	}
+	return DefaultTranslation, nil
+}
+
+// translation returns a translation whose text may be served
+func (s *BibleService) translation(ctx context.Context, id string) (*database.Translation, error) {
+	trans, err := s.repo.GetTranslation(ctx, id)
+	if err != nil {
+		return nil, ErrTranslationNotFound
+	}
+	if trans.License.AttributionRequired && strings.TrimSpace(trans.Attribution) == "" {
+		return nil, fmt.Errorf("%w: %s", ErrAttributionMissing, trans.ID)
+	}
+	return trans, nil
+}
+
+// passageResponse builds the response for the verses of a translation
+func (s *BibleService) passageResponse(reference string, trans *database.Translation, verses []models.Verse) *models.BibleResponse {
 	response := &models.BibleResponse{
 		Reference:       reference,
 		Verses:          verses,
@@ -61,27 +244,63 @@ func (s *BibleService) GetPassage(reference, translation string) (*models.BibleR
 		TranslationID:   trans.ID,
 		TranslationName: trans.Name,
 		TranslationNote: trans.Note,
+		Language:        trans.Language,
+		Direction:       trans.Direction,
+		Copyright:       trans.Copyright,
+		Attribution:     trans.Attribution,
+		Versification:   cmp.Or(trans.Versification, versification.Default),
 	}
-
-	return response, nil
+	if trans.License.ID != "" {
+		license := toModelLicense(trans.License)
+		response.License = &license
+	}
+	return response
 }
 
+// parseReference parses a reference within one chapter
 func (s *BibleService) parseReference(reference string) (bookID string, chapter int, startVerse int, endVerse int, err error) {
+	bookID, chapter, startVerse, endChapter, endVerse, err := s.parseReferenceRange(reference)
+	if err != nil {
+		return "", 0, 0, 0, err
+	}
+	if endChapter != chapter {
+		return "", 0, 0, 0, fmt.Errorf("%w: %q spans chapters", ErrInvalidReference, reference)
+	}
+	return bookID, chapter, startVerse, endVerse, nil
+}
+
+// parseReferenceRange parses a reference that may span chapters, e.g. "Genesis 1:26-2:3"
+func (s *BibleService) parseReferenceRange(reference string) (bookID string, chapter, startVerse, endChapter, endVerse int, err error) {
 	// Book name mapping
 	bookMap := map[string]string{
 		"創世記":                              "GEN",
 		"創世紀":                              "GEN",
+		"出埃及記":                             "EXO",
+		"民數記":                              "NUM",
+		"申命記":                              "DEU",
+		"詩篇":                               "PSA",
+		"約珥書":                              "JOL",
+		"瑪拉基書":                             "MAL",
 		"馬太福音":                             "MAT",
 		"約翰福音":                             "JHN",
+		"約翰三書":                             "3JN",
 		"genesis":                          "GEN",
+		"exodus":                           "EXO",
+		"numbers":                          "NUM",
+		"deuteronomy":                      "DEU",
+		"psalm":                            "PSA",
+		"psalms":                           "PSA",
+		"joel":                             "JOL",
+		"malachi":                          "MAL",
 		"matthew":                          "MAT",
 		"john":                             "JHN",
+		"3 john":                           "3JN",
 		"the book of jachanan ben kathryn": "BEN",
 	}
 
 	parts := strings.Fields(reference)
 	if len(parts) < 2 {
-		return "", 0, 0, 0, fmt.Errorf("invalid reference format")
+		return "", 0, 0, 0, 0, ErrInvalidReference
 	}
 
 	// Join all parts except the last as the book name
@@ -96,126 +315,193 @@ func (s *BibleService) parseReference(reference string) (bookID string, chapter
 			}
 		}
 		if bookID == "" {
-			return "", 0, 0, 0, fmt.Errorf("book not found")
+			return "", 0, 0, 0, 0, ErrBookNotFound
 		}
 	}
 
-	chapterPart := parts[len(parts)-1]
-	if strings.Contains(chapterPart, ":") {
-		// Contains verse number (e.g., "3:16" or "1:1-9")
-		chapterVerse := strings.Split(chapterPart, ":")
-		chapter, _ = strconv.Atoi(chapterVerse[0])
-
-		versePart := chapterVerse[1]
-		if strings.Contains(versePart, "-") {
-			// Range of verses (e.g., "1-9")
-			verseRange := strings.Split(versePart, "-")
-			startVerse, _ = strconv.Atoi(verseRange[0])
-			endVerse, _ = strconv.Atoi(verseRange[1])
-		} else {
-			// Single verse
-			startVerse, _ = strconv.Atoi(versePart)
-			endVerse = startVerse
+	// "3" for a whole chapter, "3:16", "1:1-9" or "1:26-2:3" across chapters
+	start, end, isRange := strings.Cut(parts[len(parts)-1], "-")
+	if strings.Contains(end, "-") {
+		return "", 0, 0, 0, 0, ErrInvalidReference
+	}
+	chapterText, verseText, hasVerse := strings.Cut(start, ":")
+	if chapter, err = referenceNumber(chapterText); err != nil {
+		return "", 0, 0, 0, 0, err
+	}
+	endChapter = chapter
+	if !hasVerse {
+		if isRange {
+			return "", 0, 0, 0, 0, ErrInvalidReference
 		}
-	} else {
-		// Only chapter number (e.g., "1") - return entire chapter
-		chapter, _ = strconv.Atoi(chapterPart)
-		startVerse = 0 // 0 means all verses in the chapter
-		endVerse = 0
+		return bookID, chapter, 0, chapter, 0, nil // 0 means all verses in the chapter
+	}
+	if startVerse, err = referenceNumber(verseText); err != nil {
+		return "", 0, 0, 0, 0, err
 	}
+	endVerse = startVerse
 
-	return bookID, chapter, startVerse, endVerse, nil
+	if isRange {
+		if chapterText, verseText, hasVerse = strings.Cut(end, ":"); hasVerse {
+			if endChapter, err = referenceNumber(chapterText); err != nil {
+				return "", 0, 0, 0, 0, err
+			}
+			end = verseText
+		}
+		if endVerse, err = referenceNumber(end); err != nil {
+			return "", 0, 0, 0, 0, err
+		}
+	}
+	if endChapter < chapter || endChapter == chapter && endVerse < startVerse {
+		return "", 0, 0, 0, 0, ErrInvalidReference
+	}
+	if endChapter-chapter >= MaxReferenceChapters {
+		return "", 0, 0, 0, 0, fmt.Errorf("%w: a reference may span at most %d chapters", ErrInvalidReference, MaxReferenceChapters)
+	}
+
+	return bookID, chapter, startVerse, endChapter, endVerse, nil
 }
 
-func (s *BibleService) getVersesFromDB(bookID string, chapter int, startVerse int, endVerse int) ([]models.Verse, error) {
-	ctx := context.Background()
-	collection := database.GetDatabase().Collection("verses")
+// referenceNumber parses a chapter or verse number of a reference
+func referenceNumber(text string) (int, error) {
+	n, err := strconv.Atoi(text)
+	if err != nil || n < 1 {
+		return 0, ErrInvalidReference
+	}
+	return n, nil
+}
 
-	// Build filter
-	filter := bson.M{
-		"book_id": bookID,
-		"chapter": chapter,
+// checkEndChapter rejects references running past the last chapter of their book
+func (s *BibleService) checkEndChapter(ctx context.Context, bookID string, endChapter int) error {
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return ErrBookNotFound
 	}
+	if endChapter > book.Chapters {
+		return fmt.Errorf("%w: %s has %d chapters", ErrInvalidReference, book.Name, book.Chapters)
+	}
+	return nil
+}
 
-	// Add verse filter if specified
-	if startVerse > 0 {
-		if endVerse > 0 && endVerse != startVerse {
-			filter["verse"] = bson.M{"$gte": startVerse, "$lte": endVerse}
-		} else {
-			filter["verse"] = startVerse
-		}
+// chapterPassages splits a verse range into one passage per chapter
+func chapterPassages(bookID string, chapter, startVerse, endChapter, endVerse int) []versification.Passage {
+	if endChapter == chapter {
+		return []versification.Passage{{BookID: bookID, Chapter: chapter, StartVerse: startVerse, EndVerse: endVerse}}
+	}
+	passages := []versification.Passage{{BookID: bookID, Chapter: chapter, StartVerse: startVerse, EndVerse: restOfChapter}}
+	for c := chapter + 1; c < endChapter; c++ {
+		passages = append(passages, versification.Passage{BookID: bookID, Chapter: c})
 	}
+	return append(passages, versification.Passage{BookID: bookID, Chapter: endChapter, StartVerse: 1, EndVerse: endVerse})
+}
 
-	// Sort by verse number
-	opts := options.Find().SetSort(bson.D{{Key: "verse", Value: 1}})
+// parseFailureReason maps a parse error to a metrics label
+func parseFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidReference):
+		return "invalid_format"
+	case errors.Is(err, ErrBookNotFound):
+		return "unknown_book"
+	default:
+		return "other"
+	}
+}
 
-	cursor, err := collection.Find(ctx, filter, opts)
+func (s *BibleService) getVersesFromDB(ctx context.Context, translationID, bookID string, chapter int, startVerse int, endVerse int) ([]models.Verse, error) {
+	dbVerses, err := s.repo.GetVerseRange(ctx, translationID, bookID, chapter, startVerse, endVerse)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var dbVerses []database.Verse
-	if err = cursor.All(ctx, &dbVerses); err != nil {
+	// Convert to models.Verse
+	verses := make([]models.Verse, len(dbVerses))
+	for i, dbVerse := range dbVerses {
+		verses[i] = toModelVerse(dbVerse)
+	}
+
+	return verses, nil
+}
+
+// getVersesAcross fetches the verses from chapter:startVerse to endChapter:endVerse in one query
+func (s *BibleService) getVersesAcross(ctx context.Context, translationID, bookID string, chapter, startVerse, endChapter, endVerse int) ([]models.Verse, error) {
+	dbVerses, err := s.repo.GetVersesAcross(ctx, translationID, bookID, chapter, startVerse, endChapter, endVerse)
+	if err != nil {
 		return nil, err
 	}
 
-	// Convert to models.Verse
 	verses := make([]models.Verse, len(dbVerses))
 	for i, dbVerse := range dbVerses {
-		verses[i] = models.Verse{
-			BookID:   dbVerse.BookID,
-			BookName: dbVerse.BookName,
-			Chapter:  dbVerse.Chapter,
-			Verse:    dbVerse.Verse,
-			Text:     dbVerse.Text,
-		}
+		verses[i] = toModelVerse(dbVerse)
 	}
-
 	return verses, nil
 }
 
-// GetTranslations returns all available translations
-func (s *BibleService) GetTranslations() []models.Translation {
-	// 从数据库获取翻译信息
-	ctx := context.Background()
-	collection := database.GetDatabase().Collection("translations")
+// GetTranslations returns the available translations, or those whose
+// language is lang or a more specific form of it ("zh" includes "zh-Hant")
+func (s *BibleService) GetTranslations(ctx context.Context, lang string) ([]models.Translation, error) {
+	if lang != "" {
+		tag, err := parseLanguage(lang)
+		if err != nil {
+			return nil, err
+		}
+		lang = tag
+	}
 
-	cursor, err := collection.Find(ctx, bson.M{})
+	// 从数据库获取翻译信息
+	dbTranslations, err := s.repo.GetAllTranslations(ctx)
 	if err != nil {
-		return []models.Translation{}
+		return nil, err
 	}
-	defer cursor.Close(ctx)
-
-	var dbTranslations []database.Translation
-	cursor.All(ctx, &dbTranslations)
 
-	translations := make([]models.Translation, len(dbTranslations))
-	for i, dbTrans := range dbTranslations {
-		translations[i] = models.Translation{
-			ID:   dbTrans.ID,
-			Name: dbTrans.Name,
-			Note: dbTrans.Note,
+	translations := make([]models.Translation, 0, len(dbTranslations))
+	for _, dbTrans := range dbTranslations {
+		if lang != "" && !dbTrans.MatchesLanguage(lang) {
+			continue
 		}
+		translations = append(translations, toModelTranslation(dbTrans))
 	}
 
-	return translations
+	return translations, nil
 }
 
-// GetBooks returns all available books
-func (s *BibleService) GetBooks() []models.Book {
-	// 从数据库获取书籍信息
-	ctx := context.Background()
-	collection := database.GetDatabase().Collection("books")
+// parseLanguage returns the canonical form of a BCP 47 tag
+func parseLanguage(tag string) (string, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidLanguage, tag)
+	}
+	return parsed.String(), nil
+}
+
+func toModelTranslation(t database.Translation) models.Translation {
+	return models.Translation{
+		ID:            t.ID,
+		Name:          t.Name,
+		Note:          t.Note,
+		Language:      t.Language,
+		Script:        t.Script,
+		Direction:     t.Direction,
+		Copyright:     t.Copyright,
+		License:       toModelLicense(t.License),
+		Attribution:   t.Attribution,
+		Publisher:     t.Publisher,
+		Year:          t.Year,
+		Versification: t.Versification,
+		Books:         t.Books,
+	}
+}
 
-	cursor, err := collection.Find(ctx, bson.M{})
+func toModelLicense(l database.License) models.License {
+	return models.License(l)
+}
+
+// GetBooks returns all available books in canonical order
+func (s *BibleService) GetBooks(ctx context.Context) []models.Book {
+	// 从数据库获取书籍信息
+	dbBooks, err := s.repo.GetAllBooks(ctx)
 	if err != nil {
 		return []models.Book{}
 	}
-	defer cursor.Close(ctx)
-
-	var dbBooks []database.Book
-	cursor.All(ctx, &dbBooks)
+	sortBooks(dbBooks)
 
 	books := make([]models.Book, len(dbBooks))
 	for i, dbBook := range dbBooks {
@@ -237,35 +523,44 @@ func (s *BibleService) buildText(verses []models.Verse) string {
 	return strings.Join(textParts, " ") // 用空格连接而不是直接连接
 }
 
-// AddVerse adds a new verse to the database
-func (s *BibleService) AddVerse(verse models.Verse) error {
-	dbVerse := database.Verse{
-		BookID:   verse.BookID,
-		BookName: verse.BookName,
-		Chapter:  verse.Chapter,
-		Verse:    verse.Verse,
-		Text:     verse.Text,
+// AddVerse validates and inserts a single verse
+func (s *BibleService) AddVerse(ctx context.Context, verse models.Verse) error {
+	result, err := s.EditVerses(ctx, OpInsert, []models.Verse{verse}, "")
+	if err != nil {
+		return err
+	}
+	if item := result.Items[0]; item.Status == StatusError {
+		return &VerseError{Item: item}
 	}
-	return s.repo.InsertVerse(dbVerse)
+	return nil
 }
 
 // SearchVerses searches for verses containing the query string
-func (s *BibleService) SearchVerses(query string) ([]models.Verse, error) {
-	dbVerses, err := s.repo.SearchVerses(query)
+func (s *BibleService) SearchVerses(ctx context.Context, query string) ([]models.Verse, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.SearchVerses", trace.WithAttributes(attribute.String("search.query", query)))
+	defer span.End()
+
+	start := time.Now()
+
+	// The query is used as a regular expression, so it is cached verbatim
+	key := query
+	if cached, ok := s.searchCache.Get(key); ok {
+		metrics.ObserveSearch(true, start)
+		return cached, nil
+	}
+
+	dbVerses, err := s.repo.SearchVerses(ctx, query)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 
 	verses := make([]models.Verse, len(dbVerses))
 	for i, dbVerse := range dbVerses {
-		verses[i] = models.Verse{
-			BookID:   dbVerse.BookID,
-			BookName: dbVerse.BookName,
-			Chapter:  dbVerse.Chapter,
-			Verse:    dbVerse.Verse,
-			Text:     dbVerse.Text,
-		}
+		verses[i] = toModelVerse(dbVerse)
 	}
 
+	s.searchCache.Set(key, verses)
+	metrics.ObserveSearch(false, start)
 	return verses, nil
 }
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+)
+
+func TestParseReferenceRange(t *testing.T) {
+	type parsed struct {
+		bookID               string
+		chapter, startVerse  int
+		endChapter, endVerse int
+	}
+	tests := []struct {
+		name      string
+		reference string
+		want      parsed
+		err       error
+	}{
+		{
+			name:      "a whole chapter",
+			reference: "Genesis 1",
+			want:      parsed{bookID: "GEN", chapter: 1, endChapter: 1},
+		},
+		{
+			name:      "a single verse",
+			reference: "John 3:16",
+			want:      parsed{bookID: "JHN", chapter: 3, startVerse: 16, endChapter: 3, endVerse: 16},
+		},
+		{
+			name:      "a range within a chapter",
+			reference: "Genesis 1:1-9",
+			want:      parsed{bookID: "GEN", chapter: 1, startVerse: 1, endChapter: 1, endVerse: 9},
+		},
+		{
+			name:      "a range across chapters",
+			reference: "Genesis 1:26-2:3",
+			want:      parsed{bookID: "GEN", chapter: 1, startVerse: 26, endChapter: 2, endVerse: 3},
+		},
+		{
+			name:      "a range naming its own chapter at the end",
+			reference: "Genesis 1:1-1:5",
+			want:      parsed{bookID: "GEN", chapter: 1, startVerse: 1, endChapter: 1, endVerse: 5},
+		},
+		{
+			name:      "a book name of several words",
+			reference: "3 John 1:14",
+			want:      parsed{bookID: "3JN", chapter: 1, startVerse: 14, endChapter: 1, endVerse: 14},
+		},
+		{
+			name:      "a Chinese book name",
+			reference: "約翰福音 3:16",
+			want:      parsed{bookID: "JHN", chapter: 3, startVerse: 16, endChapter: 3, endVerse: 16},
+		},
+		{
+			name:      "an unknown book",
+			reference: "Hezekiah 1:1",
+			err:       ErrBookNotFound,
+		},
+		{
+			name:      "no chapter",
+			reference: "Genesis",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a third component at the end",
+			reference: "Genesis 1:1-2:3:4",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a third component at the start",
+			reference: "Genesis 1:2:3",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "two ranges",
+			reference: "Genesis 1:1-2-3",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a chapter range without verses",
+			reference: "Genesis 1-3",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a range ending before it starts",
+			reference: "Genesis 1:5-3",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a range ending in an earlier chapter",
+			reference: "Genesis 3:1-2:4",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "verse zero",
+			reference: "Genesis 1:0",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a verse that is not a number",
+			reference: "Genesis 1:a",
+			err:       ErrInvalidReference,
+		},
+		{
+			name:      "a range over too many chapters",
+			reference: "Genesis 1:1-1000000000:1",
+			err:       ErrInvalidReference,
+		},
+	}
+
+	s := &BibleService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookID, chapter, startVerse, endChapter, endVerse, err := s.parseReferenceRange(tt.reference)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("parseReferenceRange(%q) error = %v, want %v", tt.reference, err, tt.err)
+			}
+			if got := (parsed{bookID, chapter, startVerse, endChapter, endVerse}); got != tt.want {
+				t.Errorf("parseReferenceRange(%q) = %+v, want %+v", tt.reference, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapVerse(t *testing.T) {
+	tests := []struct {
+		name           string
+		bookID         string
+		chapter, verse int
+		end            bool
+		wantChapter    int
+		wantVerse      int
+		wantMapped     bool
+	}{
+		{name: "an unmapped verse", bookID: "GEN", chapter: 1, verse: 5, wantChapter: 1, wantVerse: 5},
+		{name: "Malachi 4 starts in Hebrew chapter 3", bookID: "MAL", chapter: 4, verse: 1, wantChapter: 3, wantVerse: 19, wantMapped: true},
+		{name: "Malachi 4 ends in Hebrew chapter 3", bookID: "MAL", chapter: 4, verse: 6, end: true, wantChapter: 3, wantVerse: 24, wantMapped: true},
+		{name: "a Psalm title starts the first verse", bookID: "PSA", chapter: 51, verse: 1, wantChapter: 51, wantVerse: 1, wantMapped: true},
+		{name: "the first verse ends after the title", bookID: "PSA", chapter: 51, verse: 1, end: true, wantChapter: 51, wantVerse: 3, wantMapped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chapter, verse, mapped, err := mapVerse(tt.bookID, tt.chapter, tt.verse, versification.KJV, versification.Hebrew, tt.end)
+			if err != nil {
+				t.Fatalf("mapVerse() error = %v", err)
+			}
+			if chapter != tt.wantChapter || verse != tt.wantVerse || mapped != tt.wantMapped {
+				t.Errorf("mapVerse() = %d:%d mapped %v, want %d:%d mapped %v", chapter, verse, mapped, tt.wantChapter, tt.wantVerse, tt.wantMapped)
+			}
+		})
+	}
+}
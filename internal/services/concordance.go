@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/concordance"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Concordance limits per request
+const (
+	DefaultOccurrences = 50
+	MaxOccurrences     = 500
+	DefaultFrequencies = 50
+	MaxFrequencies     = 1000
+)
+
+// ErrInvalidWord is returned for concordance lookups that are not one word
+var ErrInvalidWord = errors.New("give a single word")
+
+// ConcordanceOptions select the occurrences of a word
+type ConcordanceOptions struct {
+	Translation string // "en" if empty
+	Lemma       bool   // match every word sharing the word's lemma
+	Book        string // all books if empty
+	Offset      int64
+	Limit       int64 // DefaultOccurrences if 0, at most MaxOccurrences
+}
+
+// FrequencyOptions select a word frequency list
+type FrequencyOptions struct {
+	Translation      string // "en" if empty
+	Book             string // the whole translation if empty
+	Lemma            bool   // count lemmas rather than words
+	IncludeStopwords bool
+	Limit            int64 // DefaultFrequencies if 0, at most MaxFrequencies
+}
+
+// ConcordanceService looks up words in the concordance index
+type ConcordanceService struct {
+	bible *BibleService
+	store *concordance.Store
+}
+
+// NewConcordanceService creates a new ConcordanceService instance
+func NewConcordanceService(bible *BibleService, store *concordance.Store) *ConcordanceService {
+	return &ConcordanceService{bible: bible, store: store}
+}
+
+// query resolves a word lookup to an index query
+func (s *ConcordanceService) query(ctx context.Context, word string, opts ConcordanceOptions) (concordance.Query, error) {
+	words := concordance.Words(word)
+	if len(words) != 1 {
+		return concordance.Query{}, fmt.Errorf("%w, not %q", ErrInvalidWord, word)
+	}
+	if opts.Translation == "" {
+		opts.Translation = "en"
+	}
+	if _, err := s.bible.repo.GetTranslation(ctx, opts.Translation); err != nil {
+		return concordance.Query{}, ErrTranslationNotFound
+	}
+
+	q := concordance.Query{TranslationID: opts.Translation, Word: words[0], BookID: strings.ToUpper(opts.Book)}
+	if opts.Lemma {
+		q.Lemma = concordance.Lemma(words[0])
+	}
+	return q, nil
+}
+
+// Occurrences returns a page of the verses a word occurs in, in canonical
+// order. A verse where several forms of a lemma occur is listed per form.
+func (s *ConcordanceService) Occurrences(ctx context.Context, word string, opts ConcordanceOptions) (*models.ConcordanceOccurrences, error) {
+	ctx, span := tracing.Start(ctx, "ConcordanceService.Occurrences")
+	defer span.End()
+	span.SetAttributes(attribute.String("concordance.word", word), attribute.Bool("concordance.lemma", opts.Lemma))
+
+	q, err := s.query(ctx, word, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultOccurrences
+	}
+	q.Offset, q.Limit = max(opts.Offset, 0), min(opts.Limit, MaxOccurrences)
+
+	entries, total, err := s.store.Occurrences(ctx, q)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	names := s.bible.bookNames(ctx)
+	result := &models.ConcordanceOccurrences{
+		Word:          q.Word,
+		Lemma:         q.Lemma,
+		TranslationID: q.TranslationID,
+		Total:         total,
+		Offset:        q.Offset,
+		Limit:         q.Limit,
+		Occurrences:   make([]models.ConcordanceOccurrence, 0, len(entries)),
+	}
+	if next := q.Offset + int64(len(entries)); next < total {
+		result.NextOffset = &next
+	}
+	for _, e := range entries {
+		result.Occurrences = append(result.Occurrences, models.ConcordanceOccurrence{
+			Reference: rangeReference(bookName(names, e.BookID), e.Chapter, e.Verse, e.Chapter, e.Verse),
+			BookID:    e.BookID,
+			Chapter:   e.Chapter,
+			Verse:     e.Verse,
+			Word:      e.Word,
+			Count:     e.Count,
+		})
+	}
+	return result, nil
+}
+
+// Counts returns how often a word occurs per book and chapter
+func (s *ConcordanceService) Counts(ctx context.Context, word string, opts ConcordanceOptions) (*models.ConcordanceCounts, error) {
+	ctx, span := tracing.Start(ctx, "ConcordanceService.Counts")
+	defer span.End()
+	span.SetAttributes(attribute.String("concordance.word", word), attribute.Bool("concordance.lemma", opts.Lemma))
+
+	q, err := s.query(ctx, word, opts)
+	if err != nil {
+		return nil, err
+	}
+	chapters, err := s.store.Counts(ctx, q)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	names := s.bible.bookNames(ctx)
+	result := &models.ConcordanceCounts{Word: q.Word, Lemma: q.Lemma, TranslationID: q.TranslationID, Books: []models.BookCount{}}
+	for _, c := range chapters {
+		n := len(result.Books)
+		if n == 0 || result.Books[n-1].BookID != c.BookID {
+			result.Books = append(result.Books, models.BookCount{BookID: c.BookID, BookName: bookName(names, c.BookID)})
+			n++
+		}
+		book := &result.Books[n-1]
+		book.Chapters = append(book.Chapters, models.ChapterCount{
+			Chapter:     c.Chapter,
+			Reference:   fmt.Sprintf("%s %d", book.BookName, c.Chapter),
+			Occurrences: c.Occurrences,
+			Verses:      c.Verses,
+		})
+		book.Occurrences += c.Occurrences
+		book.Verses += c.Verses
+		result.Occurrences += c.Occurrences
+		result.Verses += c.Verses
+	}
+	return result, nil
+}
+
+// Frequencies returns the most frequent words of a translation or book,
+// leaving out stopwords unless asked to include them
+func (s *ConcordanceService) Frequencies(ctx context.Context, opts FrequencyOptions) (*models.WordFrequencies, error) {
+	ctx, span := tracing.Start(ctx, "ConcordanceService.Frequencies")
+	defer span.End()
+
+	if opts.Translation == "" {
+		opts.Translation = "en"
+	}
+	if _, err := s.bible.repo.GetTranslation(ctx, opts.Translation); err != nil {
+		return nil, ErrTranslationNotFound
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultFrequencies
+	}
+	q := concordance.FrequencyQuery{
+		TranslationID: opts.Translation,
+		BookID:        strings.ToUpper(opts.Book),
+		Lemma:         opts.Lemma,
+		Limit:         min(opts.Limit, MaxFrequencies),
+	}
+	if !opts.IncludeStopwords {
+		for _, word := range concordance.Stopwords() {
+			if opts.Lemma {
+				word = concordance.Lemma(word)
+			}
+			q.Exclude = append(q.Exclude, word)
+		}
+	}
+
+	frequencies, err := s.store.Frequencies(ctx, q)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	result := &models.WordFrequencies{
+		TranslationID:     q.TranslationID,
+		BookID:            q.BookID,
+		Lemma:             q.Lemma,
+		StopwordsExcluded: !opts.IncludeStopwords,
+		Words:             make([]models.WordFrequency, 0, len(frequencies)),
+	}
+	for _, f := range frequencies {
+		result.Words = append(result.Words, models.WordFrequency{Word: f.Word, Occurrences: f.Occurrences, Verses: f.Verses})
+	}
+	return result, nil
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/crossrefs"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+)
+
+// Cross-reference limits per request
+const (
+	DefaultCrossReferences = 100
+	MaxCrossReferences     = 1000
+)
+
+// ErrInvalidCrossReferenceType is returned for unknown type filters
+var ErrInvalidCrossReferenceType = errors.New("unknown cross-reference type")
+
+// CrossReferenceOptions filter the cross-references of a passage
+type CrossReferenceOptions struct {
+	Type      string
+	Dataset   string
+	MinWeight *int
+	Limit     int64 // DefaultCrossReferences if 0, at most MaxCrossReferences
+}
+
+// CrossReferenceService looks up the cross-references of passages
+type CrossReferenceService struct {
+	bible *BibleService
+	store *crossrefs.Store
+}
+
+// NewCrossReferenceService creates a new CrossReferenceService instance
+func NewCrossReferenceService(bible *BibleService, store *crossrefs.Store) *CrossReferenceService {
+	return &CrossReferenceService{bible: bible, store: store}
+}
+
+// ForPassage returns the cross-references from the verses of a reference
+func (s *CrossReferenceService) ForPassage(ctx context.Context, reference string, opts CrossReferenceOptions) (*models.PassageCrossReferences, error) {
+	ctx, span := tracing.Start(ctx, "CrossReferenceService.ForPassage")
+	defer span.End()
+
+	bookID, chapter, startVerse, endChapter, endVerse, err := s.bible.parseReferenceRange(reference)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Type != "" && !crossrefs.ValidType(opts.Type) {
+		return nil, fmt.Errorf("%w %q: use one of %s", ErrInvalidCrossReferenceType, opts.Type, strings.Join(crossrefs.Types, ", "))
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultCrossReferences
+	}
+
+	// Chapter by chapter, so the limit keeps the earliest source verses
+	limit := min(opts.Limit, MaxCrossReferences)
+	var refs []crossrefs.CrossReference
+	for _, p := range chapterPassages(bookID, chapter, startVerse, endChapter, endVerse) {
+		found, err := s.store.List(ctx, crossrefs.Query{
+			BookID:     p.BookID,
+			Chapter:    p.Chapter,
+			StartVerse: p.StartVerse,
+			EndVerse:   p.EndVerse,
+			Type:       opts.Type,
+			Dataset:    opts.Dataset,
+			MinWeight:  opts.MinWeight,
+			Limit:      limit - int64(len(refs)),
+		})
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		if refs = append(refs, found...); int64(len(refs)) >= limit {
+			break
+		}
+	}
+
+	names := s.bible.bookNames(ctx)
+	result := &models.PassageCrossReferences{Reference: reference, CrossReferences: make([]models.CrossReference, 0, len(refs))}
+	for _, ref := range refs {
+		t := ref.Target
+		result.CrossReferences = append(result.CrossReferences, models.CrossReference{
+			Source:     rangeReference(bookName(names, ref.SourceBookID), ref.SourceChapter, ref.SourceVerse, ref.SourceChapter, ref.SourceVerse),
+			Reference:  rangeReference(bookName(names, t.BookID), t.Chapter, t.Verse, t.EndChapter, t.EndVerse),
+			BookID:     t.BookID,
+			Chapter:    t.Chapter,
+			Verse:      t.Verse,
+			EndChapter: t.EndChapter,
+			EndVerse:   t.EndVerse,
+			Type:       ref.Type,
+			Weight:     ref.Weight,
+			Dataset:    ref.Dataset,
+		})
+	}
+	return result, nil
+}
+
+// Embed attaches the cross-references of a passage to it. The passage must
+// not be shared with other callers.
+func (s *CrossReferenceService) Embed(ctx context.Context, passage *models.BibleResponse, opts CrossReferenceOptions) error {
+	refs, err := s.ForPassage(ctx, passage.Reference, opts)
+	if err != nil {
+		return err
+	}
+	passage.CrossReferences = refs.CrossReferences
+	return nil
+}
+
+// bookNames maps book IDs to the names in the books collection
+func (s *BibleService) bookNames(ctx context.Context) map[string]string {
+	names := map[string]string{}
+	for _, book := range s.GetBooks(ctx) {
+		names[book.ID] = book.Name
+	}
+	return names
+}
+
+// bookName returns the name of a book, or its ID when it has none
+func bookName(names map[string]string, id string) string {
+	if name := names[id]; name != "" {
+		return name
+	}
+	return id
+}
+
+// rangeReference formats a verse range as "Name 1:2", "Name 1:2-5" or "Name 1:2-3:4"
+func rangeReference(name string, chapter, verse, endChapter, endVerse int) string {
+	switch {
+	case endChapter != chapter:
+		return fmt.Sprintf("%s %d:%d-%d:%d", name, chapter, verse, endChapter, endVerse)
+	case endVerse != verse:
+		return fmt.Sprintf("%s %d:%d-%d", name, chapter, verse, endVerse)
+	default:
+		return fmt.Sprintf("%s %d:%d", name, chapter, verse)
+	}
+}
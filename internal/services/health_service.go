@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/data"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/health"
+	"github.com/tkdnbb/bookofben-api/internal/migrate"
+	"github.com/tkdnbb/bookofben-api/internal/startup"
+	"github.com/tkdnbb/bookofben-api/internal/version"
+)
+
+// HealthService reports the readiness and version of the instance
+type HealthService struct {
+	repo    *database.Repository
+	checker *health.Checker
+}
+
+// VersionInfo describes the running build and the data it serves
+type VersionInfo struct {
+	version.Info
+	CorpusRevision string         `json:"corpus_revision"`
+	CorpusVerses   int            `json:"corpus_verses"`
+	Translations   []string       `json:"translations"`
+	Startup        startup.Report `json:"startup"`
+	Error          string         `json:"error,omitempty"`
+}
+
+// NewHealthService creates a new HealthService instance
+func NewHealthService() *HealthService {
+	s := &HealthService{repo: database.NewRepository()}
+	s.checker = health.NewChecker(
+		health.Check{Name: "mongodb", Critical: true, Run: database.Ping},
+		health.Check{Name: "corpus", Critical: true, Run: checkCorpus},
+		health.Check{Name: "seed", Run: s.checkSeed},
+		health.Check{Name: "migrations", Run: checkMigrations},
+	)
+	return s
+}
+
+// AddCheck registers an additional readiness check
+func (s *HealthService) AddCheck(check health.Check) {
+	s.checker.Add(check)
+}
+
+// Readiness runs every readiness check
+func (s *HealthService) Readiness(ctx context.Context) health.Report {
+	return s.checker.Run(ctx)
+}
+
+// Version returns the build, corpus and translation information
+func (s *HealthService) Version(ctx context.Context) VersionInfo {
+	info := VersionInfo{
+		Info:           version.Get(),
+		CorpusRevision: data.Revision(),
+		CorpusVerses:   data.TotalVerses(),
+		Translations:   []string{},
+		Startup:        startup.Snapshot(),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	translations, err := s.repo.GetAllTranslations(ctx)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	for _, t := range translations {
+		info.Translations = append(info.Translations, t.ID)
+	}
+	return info
+}
+
+// checkMigrations reports migrations that are not applied yet
+func checkMigrations(ctx context.Context) error {
+	pending, err := migrate.New(database.GetDatabase()).Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%d pending migrations, first %d %s; run cmd/migrate up", len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
+
+func checkCorpus(context.Context) error {
+	if !data.Loaded() {
+		return errors.New("embedded corpus is empty; run go generate ./internal/data")
+	}
+	return nil
+}
+
+// checkSeed compares the stored Book of Ben verses with the embedded corpus
+func (s *HealthService) checkSeed(ctx context.Context) error {
+	count, err := s.repo.CountBookVerses(ctx, "BEN")
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("database is not seeded; run cmd/migrate up")
+	}
+	if want := int64(data.TotalVerses()); count < want {
+		return fmt.Errorf("database has %d of %d corpus verses", count, want)
+	}
+	return nil
+}
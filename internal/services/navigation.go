@@ -0,0 +1,133 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+)
+
+// canonicalOrder is the USFM book order of the Protestant canon
+var canonicalOrder = database.CanonicalOrder
+
+// sortBooks orders books canonically
+func sortBooks(books []database.Book) {
+	sort.SliceStable(books, func(i, j int) bool {
+		a, aCanonical := database.CanonicalIndex(books[i].ID)
+		b, bCanonical := database.CanonicalIndex(books[j].ID)
+		switch {
+		case aCanonical && bCanonical:
+			return a < b
+		case aCanonical != bCanonical:
+			return aCanonical
+		default:
+			return books[i].ID < books[j].ID
+		}
+	})
+}
+
+// chapterReference builds a reference that parseReference accepts
+func chapterReference(book database.Book, chapter int) string {
+	return fmt.Sprintf("%s %d", book.Name, chapter)
+}
+
+// chapterLink links a chapter numbered in the versification scheme given
+func chapterLink(book database.Book, chapter int, scheme string) *models.PassageLink {
+	return &models.PassageLink{
+		Reference:     chapterReference(book, chapter),
+		BookID:        book.ID,
+		BookName:      book.Name,
+		Chapter:       chapter,
+		Versification: scheme,
+	}
+}
+
+// navigation links the chapter of a passage to the previous and next
+// chapters, crossing into the adjacent books in canonical order. The chapter
+// is numbered in the scheme of the translation, and so are the links.
+func (s *BibleService) navigation(ctx context.Context, trans *database.Translation, bookID string, chapter int) (*models.Navigation, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.navigation")
+	defer span.End()
+
+	books, err := s.repo.GetAllBooks(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	sortBooks(books)
+
+	position := -1
+	for i, book := range books {
+		if book.ID == bookID {
+			position = i
+			break
+		}
+	}
+	if position < 0 {
+		return nil, ErrBookNotFound
+	}
+	book := books[position]
+
+	verses, err := s.repo.CountChapterVerses(ctx, trans.ID, bookID, chapter)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	scheme := cmp.Or(trans.Versification, versification.Default)
+	nav := &models.Navigation{ChapterVerses: int(verses), BookChapters: book.Chapters}
+	switch {
+	case chapter > 1:
+		nav.Previous = chapterLink(book, chapter-1, scheme)
+	case position > 0:
+		previous := books[position-1]
+		nav.Previous = chapterLink(previous, previous.Chapters, scheme)
+	}
+	switch {
+	case chapter < book.Chapters:
+		nav.Next = chapterLink(book, chapter+1, scheme)
+	case position < len(books)-1:
+		nav.Next = chapterLink(books[position+1], 1, scheme)
+	}
+	return nav, nil
+}
+
+// GetBookOutline returns the verse count of every chapter of a book in a translation
+func (s *BibleService) GetBookOutline(ctx context.Context, bookID, translationID string) (*models.BookOutline, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.GetBookOutline")
+	defer span.End()
+
+	if translationID == "" {
+		translationID = "en"
+	}
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, ErrBookNotFound
+	}
+	counts, err := s.repo.ChapterVerseCounts(ctx, translationID, bookID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	outline := &models.BookOutline{
+		BookID:        book.ID,
+		BookName:      book.Name,
+		TranslationID: translationID,
+		Chapters:      make([]models.ChapterOutline, 0, book.Chapters),
+	}
+	for chapter := 1; chapter <= book.Chapters; chapter++ {
+		outline.Chapters = append(outline.Chapters, models.ChapterOutline{
+			Chapter:   chapter,
+			Verses:    counts[chapter],
+			Reference: chapterReference(*book, chapter),
+		})
+		outline.TotalVerses += counts[chapter]
+	}
+	return outline, nil
+}
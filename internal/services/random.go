@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Testaments of the canon
+const (
+	TestamentOld = "ot"
+	TestamentNew = "nt"
+)
+
+// MaxRandomVerses is the longest random passage
+const MaxRandomVerses = 50
+
+// Random passage errors
+var (
+	ErrInvalidTestament = errors.New("testament must be ot or nt")
+	ErrInvalidCount     = fmt.Errorf("count must be between 1 and %d", MaxRandomVerses)
+	ErrNoMatchingVerses = errors.New("no verses match the filters")
+)
+
+// RandomOptions restricts a random passage; zero fields match everything
+type RandomOptions struct {
+	Translation string
+	Books       []string
+	Testament   string
+	ChapterFrom int
+	ChapterTo   int
+	// Count is the number of consecutive verses, 1 if zero
+	Count int
+	// Seed makes the pick reproducible for the same filters and data
+	Seed string
+}
+
+// testamentBooks lists the canonical book IDs of a testament
+func testamentBooks(testament string) []string {
+	malachi := slices.Index(canonicalOrder, "MAL")
+	if testament == TestamentOld {
+		return canonicalOrder[:malachi+1]
+	}
+	return canonicalOrder[malachi+1:]
+}
+
+// RandomPassage picks a verse uniformly among the verses matching opts and
+// returns the passage of opts.Count verses starting there. Passages stay
+// within one chapter and start earlier when the chapter ends too soon.
+// Without a seed the verse is picked by $sample.
+func (s *BibleService) RandomPassage(ctx context.Context, opts RandomOptions) (*models.RandomPassage, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.RandomPassage")
+	defer span.End()
+
+	if opts.Translation == "" {
+		opts.Translation = "en"
+	}
+	if opts.Count == 0 {
+		opts.Count = 1
+	}
+	if opts.Count < 1 || opts.Count > MaxRandomVerses {
+		return nil, ErrInvalidCount
+	}
+
+	filter := database.VerseFilter{
+		TranslationID: opts.Translation,
+		BookIDs:       opts.Books,
+		ChapterFrom:   opts.ChapterFrom,
+		ChapterTo:     opts.ChapterTo,
+	}
+	switch opts.Testament {
+	case "":
+	case TestamentOld, TestamentNew:
+		books := testamentBooks(opts.Testament)
+		if len(opts.Books) > 0 {
+			books = slices.DeleteFunc(slices.Clone(opts.Books), func(id string) bool { return !slices.Contains(books, id) })
+			if len(books) == 0 {
+				return nil, ErrNoMatchingVerses
+			}
+		}
+		filter.BookIDs = books
+	default:
+		return nil, ErrInvalidTestament
+	}
+	span.SetAttributes(attribute.Bool("random.seeded", opts.Seed != ""), attribute.Int("random.count", opts.Count))
+
+	var start *database.Verse
+	var err error
+	if opts.Seed == "" {
+		start, err = s.repo.SampleVerse(ctx, filter)
+	} else {
+		start, err = s.seededVerse(ctx, filter, opts.Seed)
+	}
+	if errors.Is(err, database.ErrVerseNotFound) {
+		return nil, ErrNoMatchingVerses
+	}
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	first, last := start.Verse, start.Verse+opts.Count-1
+	if opts.Count > 1 {
+		verses, err := s.repo.CountChapterVerses(ctx, opts.Translation, start.BookID, start.Chapter)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		if last > int(verses) {
+			last = int(verses)
+			first = max(1, last-opts.Count+1)
+		}
+	}
+
+	book, err := s.repo.GetBook(ctx, start.BookID)
+	if err != nil {
+		return nil, ErrBookNotFound
+	}
+	reference := fmt.Sprintf("%s %d:%d", book.Name, start.Chapter, first)
+	if last > first {
+		reference = fmt.Sprintf("%s-%d", reference, last)
+	}
+
+	// The verse is numbered in the scheme of its translation
+	trans, err := s.translation(ctx, opts.Translation)
+	if err != nil {
+		return nil, err
+	}
+	passage, err := s.GetPassageIn(ctx, reference, trans.ID, trans.Versification)
+	if err != nil {
+		return nil, err
+	}
+	return &models.RandomPassage{BibleResponse: passage, Seed: opts.Seed}, nil
+}
+
+// seededVerse picks the verse whose position among the matching verses is
+// derived from the seed
+func (s *BibleService) seededVerse(ctx context.Context, filter database.VerseFilter, seed string) (*database.Verse, error) {
+	count, err := s.repo.CountVerses(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, database.ErrVerseNotFound
+	}
+	sum := sha256.Sum256([]byte(seed))
+	return s.repo.NthVerse(ctx, filter, int64(binary.BigEndian.Uint64(sum[:8])%uint64(count)))
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/corpus"
+	"github.com/tkdnbb/bookofben-api/internal/data"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/plans"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+)
+
+// Statuses of a PlanProgress
+const (
+	PlanNotStarted = "not_started"
+	PlanOnTrack    = "on_track"
+	PlanBehind     = "behind"
+	PlanAhead      = "ahead"
+	PlanFinished   = "finished"
+)
+
+// Reading plan errors
+var (
+	ErrPlanNotFound     = plans.ErrNotFound
+	ErrPlanExists       = plans.ErrExists
+	ErrInvalidPlan      = errors.New("invalid plan")
+	ErrInvalidPlanDay   = errors.New("day is not part of the plan")
+	ErrInvalidGenerator = plans.ErrInvalidGenerator
+)
+
+// ReadingPlanService manages reading plans and the progress of enrolled users
+type ReadingPlanService struct {
+	bible *BibleService
+	store *plans.Store
+}
+
+// NewReadingPlanService creates a new ReadingPlanService instance
+func NewReadingPlanService(bible *BibleService, store *plans.Store) *ReadingPlanService {
+	return &ReadingPlanService{bible: bible, store: store}
+}
+
+// CreatePlan stores a plan given as days or built by a generator. Every
+// reading must be a reference GET /{reference} accepts.
+func (s *ReadingPlanService) CreatePlan(ctx context.Context, req models.PlanRequest) (*plans.Plan, error) {
+	ctx, span := tracing.Start(ctx, "ReadingPlanService.CreatePlan")
+	defer span.End()
+
+	if req.ID == "" || req.Name == "" {
+		return nil, fmt.Errorf("%w: id and name are required", ErrInvalidPlan)
+	}
+	if (req.Generator == nil) == (len(req.Days) == 0) {
+		return nil, fmt.Errorf("%w: give either days or a generator", ErrInvalidPlan)
+	}
+
+	var days []plans.Day
+	var err error
+	if req.Generator != nil {
+		days, err = s.generate(ctx, *req.Generator)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for i, day := range req.Days {
+			if day.Day != 0 && day.Day != i+1 {
+				return nil, fmt.Errorf("%w: days must be numbered 1 to %d in order", ErrInvalidPlan, len(req.Days))
+			}
+			if len(day.Readings) == 0 {
+				return nil, fmt.Errorf("%w: day %d has no readings", ErrInvalidPlan, i+1)
+			}
+			days = append(days, plans.Day{Day: i + 1, Readings: day.Readings})
+		}
+	}
+
+	for _, day := range days {
+		for _, reading := range day.Readings {
+			if _, _, _, _, _, err := s.bible.parseReferenceRange(reading); err != nil {
+				return nil, fmt.Errorf("%w: day %d: %q: %v", ErrInvalidPlan, day.Day, reading, err)
+			}
+		}
+	}
+
+	plan := &plans.Plan{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		Days:        days,
+		CreatedBy:   callerFromContext(ctx),
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := s.store.CreatePlan(ctx, plan); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (s *ReadingPlanService) generate(ctx context.Context, gen models.PlanGenerator) ([]plans.Day, error) {
+	switch gen.Type {
+	case plans.GeneratorChapters:
+		books := make([]plans.Book, 0, len(gen.Books))
+		for _, id := range gen.Books {
+			book, err := s.bible.repo.GetBook(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("%w: unknown book %q", ErrInvalidGenerator, id)
+			}
+			books = append(books, plans.Book{Name: book.Name, Chapters: book.Chapters})
+		}
+		return plans.ChaptersPerDay(books, gen.PerDay)
+	case plans.GeneratorCorpus:
+		chapterVerses := make([]int, data.GetTotalChapters())
+		for i := range chapterVerses {
+			chapterVerses[i] = len(data.GetChapterVerses(i + 1))
+		}
+		return plans.EvenVerses(corpus.BookName, chapterVerses, gen.Days)
+	default:
+		return nil, fmt.Errorf("%w: type must be %s or %s", ErrInvalidGenerator, plans.GeneratorChapters, plans.GeneratorCorpus)
+	}
+}
+
+// ListPlans returns every plan without its days
+func (s *ReadingPlanService) ListPlans(ctx context.Context) ([]plans.Plan, error) {
+	return s.store.ListPlans(ctx)
+}
+
+// GetPlan returns a plan with its days
+func (s *ReadingPlanService) GetPlan(ctx context.Context, id string) (*plans.Plan, error) {
+	return s.store.GetPlan(ctx, id)
+}
+
+// DeletePlan removes a plan and its enrollments
+func (s *ReadingPlanService) DeletePlan(ctx context.Context, id string) error {
+	return s.store.DeletePlan(ctx, id)
+}
+
+// Enroll enrolls the caller in a plan. Enrolling again restarts the plan.
+func (s *ReadingPlanService) Enroll(ctx context.Context, planID string, req models.EnrollRequest) (*plans.Enrollment, error) {
+	if _, err := s.store.GetPlan(ctx, planID); err != nil {
+		return nil, err
+	}
+	if req.TimeZone == "" {
+		req.TimeZone = "UTC"
+	}
+	location, err := time.LoadLocation(req.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrInvalidTimeZone, req.TimeZone)
+	}
+	if req.StartDate == "" {
+		req.StartDate = Today(location)
+	} else if _, err := time.Parse(DateLayout, req.StartDate); err != nil {
+		return nil, ErrInvalidDate
+	}
+	return s.store.Enroll(ctx, callerFromContext(ctx), planID, req.StartDate, req.TimeZone)
+}
+
+// Unenroll removes the caller's enrollment in a plan
+func (s *ReadingPlanService) Unenroll(ctx context.Context, planID string) error {
+	return s.store.Unenroll(ctx, callerFromContext(ctx), planID)
+}
+
+// Enrollments returns the plans the caller is enrolled in
+func (s *ReadingPlanService) Enrollments(ctx context.Context) ([]plans.Enrollment, error) {
+	return s.store.ListEnrollments(ctx, callerFromContext(ctx))
+}
+
+// SetCompleted marks a day of the caller's plan complete or not complete
+func (s *ReadingPlanService) SetCompleted(ctx context.Context, planID string, day int, complete bool) (*models.PlanProgress, error) {
+	plan, err := s.store.GetPlan(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if day < 1 || day > plan.DayCount {
+		return nil, ErrInvalidPlanDay
+	}
+	enrollment, err := s.store.SetCompleted(ctx, callerFromContext(ctx), planID, day, complete)
+	if err != nil {
+		return nil, err
+	}
+	return progress(plan, enrollment, time.Now())
+}
+
+// Progress reports which days of the caller's plan are behind or ahead
+func (s *ReadingPlanService) Progress(ctx context.Context, planID string) (*models.PlanProgress, error) {
+	plan, enrollment, err := s.enrollment(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	return progress(plan, enrollment, time.Now())
+}
+
+// Readings resolves the readings of a day of the caller's plan into
+// passages. Day 0 means the day scheduled for today.
+func (s *ReadingPlanService) Readings(ctx context.Context, planID string, day int, translation string) (*models.PlanReadings, error) {
+	ctx, span := tracing.Start(ctx, "ReadingPlanService.Readings")
+	defer span.End()
+
+	plan, enrollment, err := s.enrollment(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if day == 0 {
+		p, err := progress(plan, enrollment, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		day = p.CurrentDay
+	}
+	if day < 1 || day > len(plan.Days) {
+		return nil, ErrInvalidPlanDay
+	}
+
+	start, _ := time.Parse(DateLayout, enrollment.StartDate)
+	readings := &models.PlanReadings{
+		PlanID:    plan.ID,
+		Day:       day,
+		Date:      start.AddDate(0, 0, day-1).Format(DateLayout),
+		Completed: slices.Contains(enrollment.Completed, day),
+		Passages:  []models.BibleResponse{},
+	}
+	for _, reference := range plan.Days[day-1].Readings {
+		passage, err := s.bible.GetPassage(ctx, reference, translation)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, fmt.Errorf("%s: %w", reference, err)
+		}
+		readings.Passages = append(readings.Passages, *passage)
+	}
+	return readings, nil
+}
+
+func (s *ReadingPlanService) enrollment(ctx context.Context, planID string) (*plans.Plan, *plans.Enrollment, error) {
+	plan, err := s.store.GetPlan(ctx, planID)
+	if err != nil {
+		return nil, nil, err
+	}
+	enrollment, err := s.store.GetEnrollment(ctx, callerFromContext(ctx), planID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, enrollment, nil
+}
+
+// progress compares the completed days of an enrollment with the day
+// scheduled for now in the enrollment's time zone
+func progress(plan *plans.Plan, enrollment *plans.Enrollment, now time.Time) (*models.PlanProgress, error) {
+	location, err := time.LoadLocation(enrollment.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	today := now.In(location).Format(DateLayout)
+
+	// Both dates are parsed in UTC so daylight saving time cannot skew the day count
+	start, err := time.Parse(DateLayout, enrollment.StartDate)
+	if err != nil {
+		return nil, ErrInvalidDate
+	}
+	todayDate, _ := time.Parse(DateLayout, today)
+	current := int(todayDate.Sub(start).Hours()/24) + 1
+
+	p := &models.PlanProgress{
+		PlanID:     plan.ID,
+		StartDate:  enrollment.StartDate,
+		TimeZone:   location.String(),
+		Today:      today,
+		CurrentDay: max(current, 0),
+		TotalDays:  plan.DayCount,
+		Completed:  slices.Sorted(slices.Values(enrollment.Completed)),
+		Behind:     []int{},
+	}
+	if p.Completed == nil {
+		p.Completed = []int{}
+	}
+	for day := 1; day < current && day <= plan.DayCount; day++ {
+		if !slices.Contains(p.Completed, day) {
+			p.Behind = append(p.Behind, day)
+		}
+	}
+	for _, day := range p.Completed {
+		if day > max(current, 0) {
+			p.Ahead++
+		}
+	}
+
+	switch {
+	case len(p.Completed) >= plan.DayCount:
+		p.Status = PlanFinished
+	case current < 1:
+		p.Status = PlanNotStarted
+	case len(p.Behind) > 0:
+		p.Status = PlanBehind
+	case p.Ahead > 0:
+		p.Status = PlanAhead
+	default:
+		p.Status = PlanOnTrack
+	}
+	return p, nil
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Revision errors
+var (
+	ErrRevisionNotFound = database.ErrRevisionNotFound
+	ErrRevisionMismatch = errors.New("revisions belong to different verses")
+)
+
+// MaxHistory is the largest number of revisions returned for a verse
+const MaxHistory = 500
+
+// Operations of a DiffSegment
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// VerseHistory returns the revisions of a verse, newest first
+func (s *BibleService) VerseHistory(ctx context.Context, translationID, bookID string, chapter, verse, limit int) ([]models.Revision, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.VerseHistory")
+	defer span.End()
+
+	if limit <= 0 || limit > MaxHistory {
+		limit = MaxHistory
+	}
+	dbRevisions, err := s.repo.ListRevisions(ctx, translationID, bookID, chapter, verse, int64(limit))
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	revisions := make([]models.Revision, len(dbRevisions))
+	for i, revision := range dbRevisions {
+		revisions[i] = toModelRevision(revision)
+	}
+	return revisions, nil
+}
+
+// DiffRevisions compares the text of a verse after revision fromID with its
+// text after revision toID, or with its current text when toID is empty
+func (s *BibleService) DiffRevisions(ctx context.Context, fromID, toID string) (*models.RevisionDiff, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.DiffRevisions")
+	defer span.End()
+
+	from, err := s.getRevision(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	diff := &models.RevisionDiff{From: toModelRevision(*from), FromText: from.NewText}
+
+	if toID != "" {
+		to, err := s.getRevision(ctx, toID)
+		if err != nil {
+			return nil, err
+		}
+		if to.TranslationID != from.TranslationID || to.BookID != from.BookID || to.Chapter != from.Chapter || to.Verse != from.Verse {
+			return nil, ErrRevisionMismatch
+		}
+		revision := toModelRevision(*to)
+		diff.To, diff.ToText = &revision, to.NewText
+	} else {
+		current, err := s.repo.GetVerse(ctx, from.TranslationID, from.BookID, from.Chapter, from.Verse)
+		switch {
+		case err == nil:
+			diff.ToText = current.Text
+		case !errors.Is(err, database.ErrVerseNotFound):
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+	}
+
+	diff.Segments = diffWords(diff.FromText, diff.ToText)
+	return diff, nil
+}
+
+// RevertVerse restores a verse to its text and footnotes after the given
+// revision. If that revision deleted the verse, the verse is deleted again.
+func (s *BibleService) RevertVerse(ctx context.Context, revisionID, reason string) (*models.VerseBatchItem, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.RevertVerse")
+	defer span.End()
+
+	revision, err := s.getRevision(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	verse := models.Verse{
+		TranslationID: revision.TranslationID,
+		BookID:        revision.BookID,
+		Chapter:       revision.Chapter,
+		Verse:         revision.Verse,
+		Text:          revision.NewText,
+		Footnotes:     toModelFootnotes(revision.NewFootnotes),
+	}
+	op := OpUpsert
+	if revision.NewText == "" {
+		op = OpDelete
+	}
+	note := "revert to revision " + revisionID
+	if reason != "" {
+		note += ": " + reason
+	}
+
+	result, err := s.EditVerses(ctx, op, []models.Verse{verse}, note)
+	if err != nil {
+		return nil, err
+	}
+	if item := result.Items[0]; item.Status == StatusError {
+		return nil, &VerseError{Item: item}
+	}
+	return &result.Items[0], nil
+}
+
+func (s *BibleService) getRevision(ctx context.Context, id string) (*database.Revision, error) {
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrRevisionNotFound, id)
+	}
+	return s.repo.GetRevision(ctx, objectID)
+}
+
+func toModelRevision(r database.Revision) models.Revision {
+	return models.Revision{
+		ID:            r.ID.Hex(),
+		TranslationID: r.TranslationID,
+		BookID:        r.BookID,
+		Chapter:       r.Chapter,
+		Verse:         r.Verse,
+		Op:            r.Op,
+		OldText:       r.OldText,
+		NewText:       r.NewText,
+		OldFootnotes:  toModelFootnotes(r.OldFootnotes),
+		NewFootnotes:  toModelFootnotes(r.NewFootnotes),
+		Editor:        r.Editor,
+		Reason:        r.Reason,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+// diffWords returns the word-level difference from a to b, computed from the
+// longest common subsequence of their words
+func diffWords(a, b string) []models.DiffSegment {
+	from, to := strings.Fields(a), strings.Fields(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of from[i:] and to[j:]
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	segments := []models.DiffSegment{}
+	add := func(op, word string) {
+		if n := len(segments); n > 0 && segments[n-1].Op == op {
+			segments[n-1].Text += " " + word
+			return
+		}
+		segments = append(segments, models.DiffSegment{Op: op, Text: word})
+	}
+
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			add(DiffEqual, from[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			add(DiffDelete, from[i])
+			i++
+		default:
+			add(DiffInsert, to[j])
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		add(DiffDelete, from[i])
+	}
+	for ; j < len(to); j++ {
+		add(DiffInsert, to[j])
+	}
+	return segments
+}
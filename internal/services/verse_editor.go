@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tkdnbb/bookofben-api/internal/auth"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Verse editing operations
+const (
+	OpInsert = "insert"
+	OpUpsert = "upsert"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// Statuses of a VerseBatchItem
+const (
+	StatusCreated = "created"
+	StatusUpdated = "updated"
+	StatusDeleted = "deleted"
+	StatusError   = "error"
+)
+
+// MaxBatchSize is the largest number of verses accepted in one batch
+const MaxBatchSize = 1000
+
+// Verse editing errors
+var (
+	ErrBatchTooLarge  = fmt.Errorf("a batch may contain at most %d verses", MaxBatchSize)
+	ErrUnknownOp      = errors.New("unknown operation")
+	ErrDuplicateVerse = database.ErrDuplicateVerse
+	ErrVerseNotFound  = database.ErrVerseNotFound
+)
+
+// VerseError is the failure of a single verse edit
+type VerseError struct {
+	Item models.VerseBatchItem
+}
+
+func (e *VerseError) Error() string {
+	return e.Item.Error
+}
+
+// registry holds the books and translations verses are validated against
+type registry struct {
+	books        map[string]database.Book
+	translations map[string]bool
+}
+
+func (s *BibleService) loadRegistry(ctx context.Context) (*registry, error) {
+	books, err := s.repo.GetAllBooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := s.repo.GetAllTranslations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reg := &registry{books: make(map[string]database.Book, len(books)), translations: make(map[string]bool, len(translations))}
+	for _, book := range books {
+		reg.books[book.ID] = book
+	}
+	for _, translation := range translations {
+		reg.translations[translation.ID] = true
+	}
+	return reg, nil
+}
+
+// validate checks a verse against the registry. Text is only required when
+// the operation writes it.
+func (reg *registry) validate(v *models.Verse, op string) error {
+	var problems []string
+
+	book, ok := reg.books[v.BookID]
+	switch {
+	case v.BookID == "":
+		problems = append(problems, "book_id is required")
+	case !ok:
+		problems = append(problems, fmt.Sprintf("unknown book %q", v.BookID))
+	case v.Chapter < 1 || v.Chapter > book.Chapters:
+		problems = append(problems, fmt.Sprintf("chapter must be between 1 and %d", book.Chapters))
+	}
+	if v.TranslationID == "" {
+		problems = append(problems, "translation_id is required")
+	} else if !reg.translations[v.TranslationID] {
+		problems = append(problems, fmt.Sprintf("unknown translation %q", v.TranslationID))
+	}
+	if v.Verse < 1 {
+		problems = append(problems, "verse must be positive")
+	}
+	if op != OpDelete && strings.TrimSpace(v.Text) == "" {
+		problems = append(problems, "text is required")
+	}
+	problems = append(problems, footnoteProblems(v)...)
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	if v.BookName == "" && op != OpUpdate {
+		v.BookName = book.Name
+	}
+	return nil
+}
+
+// EditVerses applies op to every verse and reports the outcome of each one.
+// Invalid verses are reported and skipped; the others are still applied.
+// Every change is recorded as a revision by the caller in ctx with reason.
+// The returned error is only set when the batch as a whole failed.
+func (s *BibleService) EditVerses(ctx context.Context, op string, verses []models.Verse, reason string) (*models.VerseBatchResult, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.EditVerses")
+	defer span.End()
+	span.SetAttributes(attribute.String("verses.op", op), attribute.Int("verses.count", len(verses)))
+
+	switch op {
+	case OpInsert, OpUpsert, OpUpdate, OpDelete:
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownOp, op)
+	}
+	if len(verses) > MaxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+
+	reg, err := s.loadRegistry(ctx)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	result := &models.VerseBatchResult{Items: make([]models.VerseBatchItem, len(verses))}
+	seen := make(map[string]int, len(verses))
+	var valid []int
+	for i := range verses {
+		v := &verses[i]
+		item := &result.Items[i]
+		*item = models.VerseBatchItem{Index: i, BookID: v.BookID, TranslationID: v.TranslationID, Chapter: v.Chapter, Verse: v.Verse}
+
+		if err := reg.validate(v, op); err != nil {
+			item.Status, item.Error = StatusError, err.Error()
+			continue
+		}
+		key := passageCacheKey(v.TranslationID, v.BookID, v.Chapter, v.Verse, v.Verse)
+		if first, dup := seen[key]; dup {
+			item.Status, item.Error = StatusError, fmt.Sprintf("same verse as item %d", first)
+			continue
+		}
+		seen[key] = i
+		valid = append(valid, i)
+	}
+
+	touched := map[string]bool{}
+	var revisions []database.Revision
+	var indexed, removed []database.Verse
+	editor := callerFromContext(ctx)
+	// apply records the outcome of verse i, which replaced previous (nil if
+	// the verse is new)
+	apply := func(i int, status string, previous *database.Verse, err error) {
+		item := &result.Items[i]
+		if err != nil {
+			item.Status, item.Error = StatusError, err.Error()
+			return
+		}
+		item.Status = status
+		v := verses[i]
+		var after *database.Verse
+		if status == StatusDeleted {
+			removed = append(removed, toDatabaseVerse(v))
+		} else {
+			written := toDatabaseVerse(v)
+			if op == OpUpdate && v.Footnotes == nil && previous != nil {
+				// An update without footnotes keeps the stored ones
+				written.Footnotes = previous.Footnotes
+			}
+			after = &written
+			indexed = append(indexed, written)
+		}
+		if revision, changed := database.NewRevision(previous, after, editor, reason); changed {
+			revisions = append(revisions, revision)
+		}
+		if key := chapterTag(v.BookID, v.Chapter); !touched[key] {
+			touched[key] = true
+			s.InvalidateChapter(v.BookID, v.Chapter)
+		}
+	}
+
+	switch op {
+	case OpInsert:
+		docs := make([]database.Verse, len(valid))
+		for j, i := range valid {
+			docs[j] = toDatabaseVerse(verses[i])
+		}
+		errs, err := s.repo.InsertVerses(ctx, docs)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		for j, i := range valid {
+			apply(i, StatusCreated, nil, errs[j])
+		}
+	case OpUpsert:
+		for _, i := range valid {
+			previous, err := s.repo.UpsertVerse(ctx, toDatabaseVerse(verses[i]))
+			status := StatusCreated
+			if previous != nil {
+				status = StatusUpdated
+			}
+			apply(i, status, previous, err)
+		}
+	case OpUpdate:
+		for _, i := range valid {
+			v := verses[i]
+			fields := bson.M{"text": v.Text}
+			if v.BookName != "" {
+				fields["book_name"] = v.BookName
+			}
+			if v.Footnotes != nil {
+				fields["footnotes"] = toDatabaseFootnotes(v.Footnotes)
+			} else if err := s.storedFootnotesFit(ctx, v); err != nil {
+				apply(i, StatusUpdated, nil, err)
+				continue
+			}
+			previous, err := s.repo.UpdateVerse(ctx, v.TranslationID, v.BookID, v.Chapter, v.Verse, fields)
+			apply(i, StatusUpdated, previous, err)
+		}
+	case OpDelete:
+		for _, i := range valid {
+			v := verses[i]
+			previous, err := s.repo.DeleteVerse(ctx, v.TranslationID, v.BookID, v.Chapter, v.Verse)
+			apply(i, StatusDeleted, previous, err)
+		}
+	}
+
+	// The verses are already written, so a failure to record their history
+	// is logged rather than failing the batch
+	if err := s.repo.InsertRevisions(ctx, revisions); err != nil {
+		tracing.RecordError(span, err)
+		logging.FromContext(ctx).Error("failed to record verse revisions", "count", len(revisions), "error", err)
+	}
+	// Likewise for the concordance, which admin concordance rebuild repairs
+	if err := s.concordance.IndexVerses(ctx, indexed); err != nil {
+		tracing.RecordError(span, err)
+		logging.FromContext(ctx).Error("failed to index verses", "count", len(indexed), "error", err)
+	}
+	if err := s.concordance.RemoveVerses(ctx, removed); err != nil {
+		tracing.RecordError(span, err)
+		logging.FromContext(ctx).Error("failed to remove verses from the concordance", "count", len(removed), "error", err)
+	}
+
+	for _, item := range result.Items {
+		if item.Status == StatusError {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+	}
+	span.SetAttributes(attribute.Int("verses.failed", result.Failed))
+	return result, nil
+}
+
+// callerFromContext names the authenticated caller
+func callerFromContext(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.UserID
+	}
+	return "anonymous"
+}
+
+// storedFootnotesFit checks that the stored footnotes of v, which an update
+// without footnotes keeps, are still anchored within its new text
+func (s *BibleService) storedFootnotesFit(ctx context.Context, v models.Verse) error {
+	stored, err := s.repo.GetVerse(ctx, v.TranslationID, v.BookID, v.Chapter, v.Verse)
+	if err != nil {
+		return err
+	}
+	v.Footnotes = toModelFootnotes(stored.Footnotes)
+	if problems := footnoteProblems(&v); len(problems) > 0 {
+		return fmt.Errorf("the stored footnotes do not fit the new text, send footnotes with it: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// footnoteProblems checks that footnotes have a known type and text and are
+// anchored within the verse in order
+func footnoteProblems(v *models.Verse) []string {
+	var problems []string
+	length := utf8.RuneCountInString(v.Text)
+	for i, f := range v.Footnotes {
+		if !slices.Contains(database.FootnoteTypes, f.Type) {
+			problems = append(problems, fmt.Sprintf("footnote %d: type must be one of %s", i, strings.Join(database.FootnoteTypes, ", ")))
+		}
+		if strings.TrimSpace(f.Text) == "" {
+			problems = append(problems, fmt.Sprintf("footnote %d: text is required", i))
+		}
+		if f.Offset < 0 || f.Offset > length {
+			problems = append(problems, fmt.Sprintf("footnote %d: offset must be between 0 and %d", i, length))
+		} else if i > 0 && f.Offset < v.Footnotes[i-1].Offset {
+			problems = append(problems, fmt.Sprintf("footnote %d: footnotes must be ordered by offset", i))
+		}
+	}
+	return problems
+}
+
+func toDatabaseVerse(v models.Verse) database.Verse {
+	return database.Verse{
+		BookID:        v.BookID,
+		TranslationID: v.TranslationID,
+		BookName:      v.BookName,
+		Chapter:       v.Chapter,
+		Verse:         v.Verse,
+		Text:          v.Text,
+		Footnotes:     toDatabaseFootnotes(v.Footnotes),
+	}
+}
+
+func toModelVerse(v database.Verse) models.Verse {
+	verse := models.Verse{
+		BookID:        v.BookID,
+		TranslationID: v.TranslationID,
+		BookName:      v.BookName,
+		Chapter:       v.Chapter,
+		Verse:         v.Verse,
+		Text:          v.Text,
+	}
+	verse.Footnotes = toModelFootnotes(v.Footnotes)
+	return verse
+}
+
+func toModelFootnotes(footnotes []database.Footnote) []models.Footnote {
+	if footnotes == nil {
+		return nil
+	}
+	converted := make([]models.Footnote, len(footnotes))
+	for i, f := range footnotes {
+		converted[i] = models.Footnote(f)
+	}
+	return converted
+}
+
+func toDatabaseFootnotes(footnotes []models.Footnote) []database.Footnote {
+	if footnotes == nil {
+		return nil
+	}
+	converted := make([]database.Footnote, len(footnotes))
+	for i, f := range footnotes {
+		converted[i] = database.Footnote(f)
+	}
+	return converted
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/tkdnbb/bookofben-api/internal/config"
+	"github.com/tkdnbb/bookofben-api/internal/corpus"
+	"github.com/tkdnbb/bookofben-api/internal/data"
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/logging"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DateLayout formats the dates of the verse of the day
+const DateLayout = "2006-01-02"
+
+// MaxVerseOfDayRange is the largest number of days one request may cover.
+// Every day resolves a passage, so it is kept to about a month.
+const MaxVerseOfDayRange = 31
+
+// Sources of a VerseOfDay
+const (
+	SourceCurated   = "curated"
+	SourceGenerated = "generated"
+)
+
+// Verse of the day errors
+var (
+	ErrInvalidDate        = errors.New("dates must be formatted YYYY-MM-DD")
+	ErrInvalidTimeZone    = errors.New("unknown time zone")
+	ErrRangeTooLarge      = fmt.Errorf("a date range may cover at most %d days", MaxVerseOfDayRange)
+	ErrDailyVerseNotFound = database.ErrDailyVerseNotFound
+)
+
+// VerseOfDayService picks the verse of each day: the curated one when an
+// admin chose it, otherwise one derived from the date and the seed
+type VerseOfDayService struct {
+	bible    *BibleService
+	repo     *database.Repository
+	seed     string
+	location *time.Location
+}
+
+// NewVerseOfDayService creates a new VerseOfDayService instance
+func NewVerseOfDayService(bible *BibleService, cfg config.VerseOfDayConfig) *VerseOfDayService {
+	location, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	return &VerseOfDayService{
+		bible:    bible,
+		repo:     bible.repo,
+		seed:     cfg.Seed,
+		location: location,
+	}
+}
+
+// Location resolves an IANA time zone name; empty means the configured default
+func (s *VerseOfDayService) Location(name string) (*time.Location, error) {
+	if name == "" {
+		return s.location, nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrInvalidTimeZone, name)
+	}
+	return location, nil
+}
+
+// Today returns the current date in location
+func Today(location *time.Location) string {
+	return time.Now().In(location).Format(DateLayout)
+}
+
+// GetVersesOfDay returns the verse of every date from one date to another,
+// inclusive. Dates are YYYY-MM-DD calendar dates in location.
+func (s *VerseOfDayService) GetVersesOfDay(ctx context.Context, from, to, translation string, location *time.Location) ([]models.VerseOfDay, error) {
+	ctx, span := tracing.Start(ctx, "VerseOfDayService.GetVersesOfDay")
+	defer span.End()
+	span.SetAttributes(attribute.String("votd.from", from), attribute.String("votd.to", to))
+
+	// Both dates are parsed in UTC so daylight saving time cannot skew the day count
+	start, err := time.Parse(DateLayout, from)
+	if err != nil {
+		return nil, ErrInvalidDate
+	}
+	end, err := time.Parse(DateLayout, to)
+	if err != nil || end.Before(start) {
+		return nil, ErrInvalidDate
+	}
+	if days := int(end.Sub(start).Hours()/24) + 1; days > MaxVerseOfDayRange {
+		return nil, ErrRangeTooLarge
+	}
+
+	curated, err := s.repo.ListDailyVerses(ctx, from, to)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	byDate := make(map[string]database.DailyVerse, len(curated))
+	for _, verse := range curated {
+		byDate[verse.Date] = verse
+	}
+
+	var days []models.VerseOfDay
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(DateLayout)
+		votd, err := s.verseOfDay(ctx, date, byDate, translation)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		votd.TimeZone = location.String()
+		days = append(days, *votd)
+	}
+	return days, nil
+}
+
+func (s *VerseOfDayService) verseOfDay(ctx context.Context, date string, curated map[string]database.DailyVerse, translation string) (*models.VerseOfDay, error) {
+	if verse, ok := curated[date]; ok {
+		passage, err := s.bible.GetPassage(ctx, verse.Reference, translation)
+		if err == nil {
+			return &models.VerseOfDay{Date: date, Source: SourceCurated, Note: verse.Note, Passage: passage}, nil
+		}
+		// A curated verse that no longer resolves falls back to the generated one
+		logging.FromContext(ctx).Warn("curated verse of the day does not resolve", "date", date, "reference", verse.Reference, "error", err)
+	}
+
+	passage, err := s.generatedPassage(ctx, date, translation)
+	if err != nil {
+		return nil, err
+	}
+	return &models.VerseOfDay{Date: date, Source: SourceGenerated, Passage: passage}, nil
+}
+
+// generatedPassage derives the verse of date from the seed. It comes from the
+// embedded corpus, or for translations without the Book of Ben from the
+// verses stored for the translation.
+func (s *VerseOfDayService) generatedPassage(ctx context.Context, date, translation string) (*models.BibleResponse, error) {
+	if translation == "" {
+		return s.bible.GetPassage(ctx, s.generatedReference(date), translation)
+	}
+	trans, err := s.bible.translation(ctx, translation)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(trans.Books, corpus.BookID) {
+		return s.bible.GetPassage(ctx, s.generatedReference(date), translation)
+	}
+
+	verse, err := s.bible.seededVerse(ctx, database.VerseFilter{TranslationID: trans.ID}, s.seed+"|"+date)
+	if errors.Is(err, database.ErrVerseNotFound) {
+		return nil, ErrNoVerses
+	}
+	if err != nil {
+		return nil, err
+	}
+	book, err := s.repo.GetBook(ctx, verse.BookID)
+	if err != nil {
+		return nil, ErrBookNotFound
+	}
+	// Stored verses are numbered in the scheme of their translation
+	reference := rangeReference(book.Name, verse.Chapter, verse.Verse, verse.Chapter, verse.Verse)
+	return s.bible.GetPassageIn(ctx, reference, trans.ID, trans.Versification)
+}
+
+// generatedReference derives a verse of the embedded corpus from the date and
+// the seed. The same date and seed always give the same verse.
+func (s *VerseOfDayService) generatedReference(date string) string {
+	sum := sha256.Sum256([]byte(s.seed + "|" + date))
+	index := int(binary.BigEndian.Uint64(sum[:8]) % uint64(data.TotalVerses()))
+	return corpusReference(index)
+}
+
+// corpusReference returns the reference of the index-th verse of the corpus,
+// counting from 0
+func corpusReference(index int) string {
+	for chapter := 1; chapter <= data.GetTotalChapters(); chapter++ {
+		verses := len(data.GetChapterVerses(chapter))
+		if index < verses {
+			return fmt.Sprintf("%s %d:%d", corpus.BookName, chapter, index+1)
+		}
+		index -= verses
+	}
+	return fmt.Sprintf("%s 1:1", corpus.BookName)
+}
+
+// ListCurated returns the curated verses from one date to another, inclusive
+func (s *VerseOfDayService) ListCurated(ctx context.Context, from, to string) ([]database.DailyVerse, error) {
+	if _, err := time.Parse(DateLayout, from); err != nil {
+		return nil, ErrInvalidDate
+	}
+	if _, err := time.Parse(DateLayout, to); err != nil {
+		return nil, ErrInvalidDate
+	}
+	return s.repo.ListDailyVerses(ctx, from, to)
+}
+
+// SetCurated makes reference the verse of date on behalf of the caller in
+// ctx. The reference must resolve like a GET /{reference} request in at
+// least one translation; the others fall back to the generated verse.
+func (s *VerseOfDayService) SetCurated(ctx context.Context, date, reference, note string) (*database.DailyVerse, error) {
+	if _, err := time.Parse(DateLayout, date); err != nil {
+		return nil, ErrInvalidDate
+	}
+	if err := s.resolves(ctx, reference); err != nil {
+		return nil, err
+	}
+
+	verse := database.DailyVerse{
+		Date:      date,
+		Reference: reference,
+		Note:      note,
+		Editor:    callerFromContext(ctx),
+		UpdatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.SaveDailyVerse(ctx, verse); err != nil {
+		return nil, err
+	}
+	return &verse, nil
+}
+
+// resolves checks that reference has verses in some translation
+func (s *VerseOfDayService) resolves(ctx context.Context, reference string) error {
+	translations, err := s.bible.repo.GetAllTranslations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, trans := range translations {
+		_, err := s.bible.GetPassage(ctx, reference, trans.ID)
+		if err == nil || !errors.Is(err, ErrNoVerses) && !errors.Is(err, ErrAttributionMissing) {
+			return err
+		}
+	}
+	return ErrNoVerses
+}
+
+// DeleteCurated removes the curated verse of date
+func (s *VerseOfDayService) DeleteCurated(ctx context.Context, date string) error {
+	return s.repo.DeleteDailyVerse(ctx, date)
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tkdnbb/bookofben-api/internal/database"
+	"github.com/tkdnbb/bookofben-api/internal/models"
+	"github.com/tkdnbb/bookofben-api/internal/tracing"
+	"github.com/tkdnbb/bookofben-api/internal/versification"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MaxParallelTranslations limits the translations of a parallel view
+const MaxParallelTranslations = 10
+
+// Versification errors
+var (
+	ErrUnknownVersification = errors.New("unknown versification scheme")
+	ErrInvalidParallel      = errors.New("invalid parallel request")
+)
+
+// versificationScheme returns the scheme of a request, the default if empty
+func versificationScheme(scheme string) (string, error) {
+	scheme = cmp.Or(strings.ToLower(strings.TrimSpace(scheme)), versification.Default)
+	if !versification.Known(scheme) {
+		return "", fmt.Errorf("%w %q: use one of %s", ErrUnknownVersification, scheme, strings.Join(versification.Names(), ", "))
+	}
+	return scheme, nil
+}
+
+// versifiedVerses maps the passages of a reference, one per chapter, from
+// scheme to the scheme of trans and fetches their verses in trans. The
+// mapping is reported when it renumbered any verse.
+func (s *BibleService) versifiedVerses(ctx context.Context, trans *database.Translation, passages []versification.Passage, scheme string) ([]models.Verse, *models.VersificationMapping, error) {
+	target := cmp.Or(trans.Versification, versification.Default)
+	var verses []models.Verse
+	var mapped bool
+	var err error
+	if len(passages) == 1 {
+		verses, mapped, err = s.passageVerses(ctx, trans.ID, passages[0], scheme, target)
+	} else {
+		verses, mapped, err = s.spanVerses(ctx, trans.ID, passages[0], passages[len(passages)-1], scheme, target)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("translation %s: %w", trans.ID, err)
+	}
+	if !mapped || len(verses) == 0 {
+		return verses, nil, nil
+	}
+	return verses, &models.VersificationMapping{From: scheme, To: target, Reference: versesReference(verses)}, nil
+}
+
+// passageVerses fetches a passage within one chapter, mapped segment by segment
+func (s *BibleService) passageVerses(ctx context.Context, translationID string, passage versification.Passage, scheme, target string) ([]models.Verse, bool, error) {
+	segments, mapped, err := versification.Map(passage, scheme, target)
+	if err != nil {
+		return nil, false, err
+	}
+	verses := []models.Verse{}
+	for _, seg := range segments {
+		found, err := s.getVersesFromDB(ctx, translationID, seg.BookID, seg.Chapter, seg.StartVerse, seg.EndVerse)
+		if err != nil {
+			return nil, false, err
+		}
+		verses = append(verses, found...)
+	}
+	return verses, mapped, nil
+}
+
+// spanVerses fetches a reference across chapters in one query, from the
+// mapped start of its first passage to the mapped end of its last
+func (s *BibleService) spanVerses(ctx context.Context, translationID string, first, last versification.Passage, scheme, target string) ([]models.Verse, bool, error) {
+	chapter, startVerse, startMapped, err := mapVerse(first.BookID, first.Chapter, first.StartVerse, scheme, target, false)
+	if err != nil {
+		return nil, false, err
+	}
+	endChapter, endVerse, endMapped, err := mapVerse(last.BookID, last.Chapter, last.EndVerse, scheme, target, true)
+	if err != nil {
+		return nil, false, err
+	}
+	verses, err := s.getVersesAcross(ctx, translationID, first.BookID, chapter, startVerse, endChapter, endVerse)
+	return verses, startMapped || endMapped, err
+}
+
+// mapVerse maps one verse from scheme to target and returns where its
+// mapping starts, or ends when end is set. A verse without a counterpart
+// keeps its number.
+func mapVerse(bookID string, chapter, verse int, scheme, target string, end bool) (int, int, bool, error) {
+	segments, mapped, err := versification.Map(versification.Passage{BookID: bookID, Chapter: chapter, StartVerse: verse, EndVerse: verse}, scheme, target)
+	if err != nil || len(segments) == 0 {
+		return chapter, verse, false, err
+	}
+	if !end {
+		seg := segments[0]
+		return seg.Chapter, max(seg.StartVerse, 1), mapped, nil
+	}
+	seg := segments[len(segments)-1]
+	if seg.StartVerse == 0 {
+		return seg.Chapter, restOfChapter, mapped, nil
+	}
+	return seg.Chapter, seg.EndVerse, mapped, nil
+}
+
+// GetParallel returns a passage in several translations side by side. The
+// reference is numbered in scheme and mapped to the scheme of each
+// translation. Translations without the passage have no verses; at least
+// one must have it.
+func (s *BibleService) GetParallel(ctx context.Context, reference string, translations []string, scheme string) (*models.ParallelResponse, error) {
+	ctx, span := tracing.Start(ctx, "BibleService.GetParallel")
+	defer span.End()
+	span.SetAttributes(attribute.String("bible.reference", reference), attribute.StringSlice("bible.translations", translations))
+
+	if len(translations) == 0 || len(translations) > MaxParallelTranslations {
+		return nil, fmt.Errorf("%w: give 1 to %d translations", ErrInvalidParallel, MaxParallelTranslations)
+	}
+	scheme, err := versificationScheme(scheme)
+	if err != nil {
+		return nil, err
+	}
+	bookID, chapter, startVerse, endChapter, endVerse, err := s.parseReferenceRange(reference)
+	if err != nil {
+		return nil, err
+	}
+	if endChapter != chapter {
+		if err := s.checkEndChapter(ctx, bookID, endChapter); err != nil {
+			return nil, err
+		}
+	}
+	passages := chapterPassages(bookID, chapter, startVerse, endChapter, endVerse)
+
+	result := &models.ParallelResponse{Reference: reference, Versification: scheme, Passages: make([]models.BibleResponse, 0, len(translations))}
+	found := false
+	for _, id := range translations {
+		trans, err := s.translation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		verses, mapping, err := s.versifiedVerses(ctx, trans, passages, scheme)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		response := s.passageResponse(reference, trans, verses)
+		response.VersificationMapping = mapping
+		result.Passages = append(result.Passages, *response)
+		found = found || len(verses) > 0
+	}
+	if !found {
+		return nil, ErrNoVerses
+	}
+	return result, nil
+}
+
+// versesReference names verses in reference form, e.g. "Genesis 31:55; 32:1-32"
+func versesReference(verses []models.Verse) string {
+	var parts []string
+	for i := 0; i < len(verses); {
+		j := i
+		for j+1 < len(verses) && verses[j+1].Chapter == verses[i].Chapter && verses[j+1].Verse == verses[j].Verse+1 {
+			j++
+		}
+		ref := rangeReference(verses[i].BookName, verses[i].Chapter, verses[i].Verse, verses[j].Chapter, verses[j].Verse)
+		if i > 0 {
+			ref = strings.TrimPrefix(ref, verses[i].BookName+" ")
+		}
+		parts = append(parts, ref)
+		i = j + 1
+	}
+	return strings.Join(parts, "; ")
+}
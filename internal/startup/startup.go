@@ -0,0 +1,107 @@
+package startup
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// processStart approximates when the process started
+var processStart = time.Now()
+
+// Phase is one timed step of startup
+type Phase struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Report lists the startup phases in the order they finished
+type Report struct {
+	Phases []Phase       `json:"phases"`
+	Total  time.Duration `json:"total"`
+	Since  time.Duration `json:"since_process_start"`
+	Done   bool          `json:"done"`
+}
+
+var (
+	mu       sync.Mutex
+	began    time.Time
+	phases   []Phase
+	finished time.Time
+	observer func(name string, d time.Duration)
+)
+
+// Begin starts a new report, discarding the previous one
+func Begin() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	began = time.Now()
+	phases = nil
+	finished = time.Time{}
+}
+
+// Track times a phase; call the returned function when it ends.
+// Typical use: defer startup.Track("seed")()
+func Track(name string) func() {
+	start := time.Now()
+	return func() {
+		d := time.Since(start)
+
+		mu.Lock()
+		phases = append(phases, Phase{Name: name, Duration: d})
+		obs := observer
+		mu.Unlock()
+
+		if obs != nil {
+			obs(name, d)
+		}
+	}
+}
+
+// Finish marks startup as complete and logs the report
+func Finish(logger *slog.Logger) Report {
+	mu.Lock()
+	finished = time.Now()
+	mu.Unlock()
+
+	report := Snapshot()
+	attrs := make([]any, 0, len(report.Phases)+2)
+	for _, phase := range report.Phases {
+		attrs = append(attrs, slog.Float64(phase.Name+"_ms", millis(phase.Duration)))
+	}
+	attrs = append(attrs,
+		slog.Float64("total_ms", millis(report.Total)),
+		slog.Float64("since_process_start_ms", millis(report.Since)),
+	)
+	logger.Info("startup timing", attrs...)
+	return report
+}
+
+// Snapshot returns the current report
+func Snapshot() Report {
+	mu.Lock()
+	defer mu.Unlock()
+
+	end := finished
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return Report{
+		Phases: append([]Phase(nil), phases...),
+		Total:  end.Sub(began),
+		Since:  end.Sub(processStart),
+		Done:   !finished.IsZero(),
+	}
+}
+
+// SetObserver registers a callback invoked for every finished phase, e.g. to export metrics
+func SetObserver(fn func(name string, d time.Duration)) {
+	mu.Lock()
+	defer mu.Unlock()
+	observer = fn
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MongoMonitor returns a driver command monitor that records a client span per MongoDB command
+func MongoMonitor() *event.CommandMonitor {
+	var spans sync.Map // request ID -> trace.Span
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			attrs := []trace.SpanStartOption{
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.DBSystemNameMongoDB,
+					semconv.DBNamespace(evt.DatabaseName),
+					semconv.DBOperationName(evt.CommandName),
+				),
+			}
+			_, span := Start(ctx, "mongodb."+evt.CommandName, attrs...)
+			if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+				span.SetAttributes(semconv.DBCollectionName(collection))
+			}
+			spans.Store(evt.RequestID, span)
+		},
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			if span, ok := spans.LoadAndDelete(evt.RequestID); ok {
+				span.(trace.Span).End()
+			}
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			if span, ok := spans.LoadAndDelete(evt.RequestID); ok {
+				RecordError(span.(trace.Span), evt.Failure)
+				span.(trace.Span).End()
+			}
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package versification
+
+// hebrewTitles are the Psalms whose title is numbered as verses in the
+// Masoretic text, by the number of verses it takes. The first KJV verse maps
+// to the title and the first verse after it.
+var hebrewTitles = map[int]int{
+	3: 1, 4: 1, 5: 1, 6: 1, 7: 1, 8: 1, 9: 1, 12: 1, 18: 1, 19: 1, 20: 1, 21: 1, 22: 1,
+	30: 1, 31: 1, 34: 1, 36: 1, 38: 1, 39: 1, 40: 1, 41: 1, 42: 1, 44: 1, 45: 1, 46: 1,
+	47: 1, 48: 1, 49: 1, 51: 2, 52: 2, 53: 1, 54: 2, 55: 1, 56: 1, 57: 1, 58: 1, 59: 1,
+	60: 2, 61: 1, 62: 1, 63: 1, 64: 1, 65: 1, 67: 1, 68: 1, 69: 1, 70: 1, 75: 1, 76: 1,
+	77: 1, 80: 1, 81: 1, 83: 1, 84: 1, 85: 1, 88: 1, 89: 1, 92: 1, 102: 1, 108: 1,
+	140: 1, 142: 1,
+}
+
+// hebrewRules map KJV to Masoretic numbering
+var hebrewRules = append([]rule{
+	{"GEN", span{31, 1, 54}, span{31, 1, 54}},
+	{"GEN", span{31, 55, 55}, span{32, 1, 1}},
+	{"GEN", span{32, 1, open}, span{32, 2, open}},
+	{"EXO", span{7, 1, 25}, span{7, 1, 25}},
+	{"EXO", span{8, 1, 4}, span{7, 26, 29}},
+	{"EXO", span{8, 5, open}, span{8, 1, open}},
+	{"NUM", span{16, 1, 35}, span{16, 1, 35}},
+	{"NUM", span{16, 36, 50}, span{17, 1, 15}},
+	{"NUM", span{17, 1, open}, span{17, 16, open}},
+	{"DEU", span{12, 1, 31}, span{12, 1, 31}},
+	{"DEU", span{12, 32, 32}, span{13, 1, 1}},
+	{"DEU", span{13, 1, open}, span{13, 2, open}},
+	{"JOL", span{2, 1, 27}, span{2, 1, 27}},
+	{"JOL", span{2, 28, 32}, span{3, 1, 5}},
+	{"JOL", span{3, 1, open}, span{4, 1, open}},
+	{"MAL", span{3, 1, 18}, span{3, 1, 18}},
+	{"MAL", span{4, 1, open}, span{3, 19, open}},
+}, psalmTitleRules(hebrewTitles)...)
+
+// psalmTitleRules map the Psalms with numbered titles
+func psalmTitleRules(titles map[int]int) []rule {
+	rules := make([]rule, 0, 2*len(titles))
+	for psalm, verses := range titles {
+		rules = append(rules,
+			rule{"PSA", span{psalm, 1, 1}, span{psalm, 1, 1 + verses}},
+			rule{"PSA", span{psalm, 2, open}, span{psalm, 2 + verses, open}},
+		)
+	}
+	return rules
+}
+
+// cuvRules map KJV to Chinese Union Version numbering, which splits the
+// closing greeting of 3 John into its own verse
+var cuvRules = []rule{
+	{"3JN", span{1, 1, 13}, span{1, 1, 13}},
+	{"3JN", span{1, 14, 14}, span{1, 14, 15}},
+}
@@ -0,0 +1,198 @@
+// Package versification maps references between the verse numbering schemes
+// of different Bible traditions.
+package versification
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+)
+
+// Schemes
+const (
+	// KJV is the English Protestant numbering, the default and the scheme
+	// every mapping table is written against
+	KJV = "kjv"
+	// Hebrew is the Masoretic numbering: Psalm titles are verses, Malachi
+	// ends at 3:24 and Joel has four chapters
+	Hebrew = "hebrew"
+	// CUV is the numbering of the Chinese Union Version
+	CUV = "cuv"
+)
+
+// Default is the scheme of references and translations that name none
+const Default = KJV
+
+// ErrUnknownScheme is returned for scheme names without a mapping table
+var ErrUnknownScheme = errors.New("unknown versification scheme")
+
+// open marks a span that runs to the end of its chapter
+const open = 0
+
+// span is a verse range of one chapter; End is open to run to the end
+type span struct {
+	Chapter, Start, End int
+}
+
+func (s span) len() int {
+	return s.End - s.Start
+}
+
+// rule says that KJV in the KJV scheme is Other in the scheme of its table.
+// Spans of equal length map verse by verse, as do two open spans; otherwise
+// any verse of one side maps to the whole other side. Once a chapter appears
+// in a table its rules cover the chapter: verses outside them do not exist.
+type rule struct {
+	BookID     string
+	KJV, Other span
+}
+
+// schemes are the mapping tables, keyed by scheme name
+var schemes = map[string][]rule{
+	KJV:    nil,
+	Hebrew: hebrewRules,
+	CUV:    cuvRules,
+}
+
+// Known reports whether name is a scheme with a mapping table
+func Known(name string) bool {
+	_, ok := schemes[name]
+	return ok
+}
+
+// Names lists the known schemes
+func Names() []string {
+	return slices.Sorted(maps.Keys(schemes))
+}
+
+// Passage is a whole chapter, or a verse range of one chapter when
+// StartVerse > 0
+type Passage struct {
+	BookID     string
+	Chapter    int
+	StartVerse int
+	EndVerse   int
+}
+
+// Map renumbers p from one scheme to another, going through KJV. Empty
+// scheme names are Default. The result is in book order and may span
+// chapters; it is empty when p does not exist in the source scheme. mapped
+// reports whether any verse was renumbered.
+func Map(p Passage, from, to string) (result []Passage, mapped bool, err error) {
+	if from == "" {
+		from = Default
+	}
+	if to == "" {
+		to = Default
+	}
+	for _, name := range []string{from, to} {
+		if !Known(name) {
+			return nil, false, fmt.Errorf("%w %q", ErrUnknownScheme, name)
+		}
+	}
+	if from == to {
+		return []Passage{p}, false, nil
+	}
+
+	result = []Passage{p}
+	for _, step := range []struct {
+		rules   []rule
+		reverse bool
+	}{{schemes[from], true}, {schemes[to], false}} {
+		var next []Passage
+		for _, q := range result {
+			out, changed := apply(step.rules, step.reverse, q)
+			next = append(next, out...)
+			mapped = mapped || changed
+		}
+		result = merge(next)
+	}
+	// A whole Psalm is the whole Psalm in either scheme
+	if len(result) == 1 && result[0] == p {
+		mapped = false
+	}
+	return result, mapped, nil
+}
+
+// apply maps p through rules, from KJV to the table's scheme or the reverse
+func apply(rules []rule, reverse bool, p Passage) ([]Passage, bool) {
+	var matched [][2]span
+	for _, r := range rules {
+		src, dst := r.KJV, r.Other
+		if reverse {
+			src, dst = dst, src
+		}
+		if r.BookID == p.BookID && src.Chapter == p.Chapter {
+			matched = append(matched, [2]span{closed(src), closed(dst)})
+		}
+	}
+	if matched == nil {
+		return []Passage{p}, false
+	}
+
+	start, end := p.StartVerse, p.EndVerse
+	if start == 0 {
+		start, end = 1, wholeChapter
+	}
+	var out []Passage
+	mapped := false
+	for _, m := range matched {
+		src, dst := m[0], m[1]
+		lo, hi := max(src.Start, start), min(src.End, end)
+		if lo > hi {
+			continue
+		}
+		if src.len() == dst.len() {
+			dst.Start, dst.End = dst.Start+lo-src.Start, dst.Start+hi-src.Start
+		}
+		out = append(out, Passage{BookID: p.BookID, Chapter: dst.Chapter, StartVerse: dst.Start, EndVerse: dst.End})
+		mapped = mapped || src != dst
+	}
+	return out, mapped
+}
+
+// lastVerse is past the end of every chapter. Open spans are lastVerse
+// long and whole chapters run to wholeChapter, beyond any open span.
+const (
+	lastVerse    = 1 << 10
+	wholeChapter = 4 * lastVerse
+)
+
+// closed gives an open span an end, keeping open spans the same length
+func closed(s span) span {
+	if s.End == open {
+		s.End = lastVerse + s.Start
+	}
+	return s
+}
+
+// merge sorts passages and joins those that overlap or touch. A range
+// from verse 1 to past lastVerse is the whole chapter again.
+func merge(passages []Passage) []Passage {
+	sort.Slice(passages, func(i, j int) bool {
+		a, b := passages[i], passages[j]
+		if a.Chapter != b.Chapter {
+			return a.Chapter < b.Chapter
+		}
+		return a.StartVerse < b.StartVerse
+	})
+	var out []Passage
+	for _, p := range passages {
+		if p.StartVerse == 0 {
+			p.StartVerse, p.EndVerse = 1, wholeChapter
+		}
+		if n := len(out); n > 0 && out[n-1].Chapter == p.Chapter && p.StartVerse <= out[n-1].EndVerse+1 {
+			out[n-1].EndVerse = max(out[n-1].EndVerse, p.EndVerse)
+			continue
+		}
+		out = append(out, p)
+	}
+	for i := range out {
+		if out[i].StartVerse == 1 && out[i].EndVerse >= lastVerse {
+			out[i].StartVerse, out[i].EndVerse = 0, 0
+		}
+	}
+	return out
+}
@@ -0,0 +1,188 @@
+package versification
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		passage  Passage
+		from, to string
+		want     []Passage
+		mapped   bool
+	}{
+		{
+			name:    "Malachi 4 is the end of Hebrew chapter 3",
+			passage: Passage{BookID: "MAL", Chapter: 4},
+			from:    KJV,
+			to:      Hebrew,
+			want:    []Passage{{BookID: "MAL", Chapter: 3, StartVerse: 19, EndVerse: 19 + lastVerse}},
+			mapped:  true,
+		},
+		{
+			name:    "the end of Hebrew Malachi 3 is the whole of chapter 4",
+			passage: Passage{BookID: "MAL", Chapter: 3, StartVerse: 19, EndVerse: 19 + lastVerse},
+			from:    Hebrew,
+			to:      KJV,
+			want:    []Passage{{BookID: "MAL", Chapter: 4}},
+			mapped:  true,
+		},
+		{
+			name:    "Hebrew Malachi 3 is KJV 3 and 4",
+			passage: Passage{BookID: "MAL", Chapter: 3},
+			from:    Hebrew,
+			to:      KJV,
+			want: []Passage{
+				{BookID: "MAL", Chapter: 3, StartVerse: 1, EndVerse: 18},
+				{BookID: "MAL", Chapter: 4},
+			},
+			mapped: true,
+		},
+		{
+			name:    "a single verse into the remainder of the chapter",
+			passage: Passage{BookID: "MAL", Chapter: 4, StartVerse: 6, EndVerse: 6},
+			from:    KJV,
+			to:      Hebrew,
+			want:    []Passage{{BookID: "MAL", Chapter: 3, StartVerse: 24, EndVerse: 24}},
+			mapped:  true,
+		},
+		{
+			name:    "Psalm 51:1 takes in the two-verse title",
+			passage: Passage{BookID: "PSA", Chapter: 51, StartVerse: 1, EndVerse: 1},
+			from:    KJV,
+			to:      Hebrew,
+			want:    []Passage{{BookID: "PSA", Chapter: 51, StartVerse: 1, EndVerse: 3}},
+			mapped:  true,
+		},
+		{
+			name:    "Psalm 51 after the title is shifted by two",
+			passage: Passage{BookID: "PSA", Chapter: 51, StartVerse: 10, EndVerse: 12},
+			from:    KJV,
+			to:      Hebrew,
+			want:    []Passage{{BookID: "PSA", Chapter: 51, StartVerse: 12, EndVerse: 14}},
+			mapped:  true,
+		},
+		{
+			name:    "a verse of the Psalm 51 title is KJV verse 1",
+			passage: Passage{BookID: "PSA", Chapter: 51, StartVerse: 2, EndVerse: 2},
+			from:    Hebrew,
+			to:      KJV,
+			want:    []Passage{{BookID: "PSA", Chapter: 51, StartVerse: 1, EndVerse: 1}},
+			mapped:  true,
+		},
+		{
+			name:    "a whole Psalm with a title is the whole Psalm",
+			passage: Passage{BookID: "PSA", Chapter: 51},
+			from:    KJV,
+			to:      Hebrew,
+			want:    []Passage{{BookID: "PSA", Chapter: 51}},
+		},
+		{
+			name:    "a Psalm without a title is unchanged",
+			passage: Passage{BookID: "PSA", Chapter: 23, StartVerse: 1, EndVerse: 6},
+			from:    KJV,
+			to:      Hebrew,
+			want:    []Passage{{BookID: "PSA", Chapter: 23, StartVerse: 1, EndVerse: 6}},
+		},
+		{
+			name:    "3 John 14 is split in two",
+			passage: Passage{BookID: "3JN", Chapter: 1, StartVerse: 14, EndVerse: 14},
+			from:    KJV,
+			to:      CUV,
+			want:    []Passage{{BookID: "3JN", Chapter: 1, StartVerse: 14, EndVerse: 15}},
+			mapped:  true,
+		},
+		{
+			name:    "either half of 3 John 14 is the KJV verse",
+			passage: Passage{BookID: "3JN", Chapter: 1, StartVerse: 15, EndVerse: 15},
+			from:    CUV,
+			to:      KJV,
+			want:    []Passage{{BookID: "3JN", Chapter: 1, StartVerse: 14, EndVerse: 14}},
+			mapped:  true,
+		},
+		{
+			name:    "3 John before the split is unchanged",
+			passage: Passage{BookID: "3JN", Chapter: 1, StartVerse: 1, EndVerse: 13},
+			from:    CUV,
+			to:      KJV,
+			want:    []Passage{{BookID: "3JN", Chapter: 1, StartVerse: 1, EndVerse: 13}},
+		},
+		{
+			name:    "verses beyond a mapped chapter do not exist",
+			passage: Passage{BookID: "3JN", Chapter: 1, StartVerse: 16, EndVerse: 16},
+			from:    KJV,
+			to:      CUV,
+		},
+		{
+			name:    "a range across a rule boundary splits at the chapter",
+			passage: Passage{BookID: "GEN", Chapter: 31, StartVerse: 50, EndVerse: 55},
+			from:    KJV,
+			to:      Hebrew,
+			want: []Passage{
+				{BookID: "GEN", Chapter: 31, StartVerse: 50, EndVerse: 54},
+				{BookID: "GEN", Chapter: 32, StartVerse: 1, EndVerse: 1},
+			},
+			mapped: true,
+		},
+		{
+			name:    "a range across a rule boundary into the previous chapter",
+			passage: Passage{BookID: "EXO", Chapter: 8, StartVerse: 1, EndVerse: 10},
+			from:    KJV,
+			to:      Hebrew,
+			want: []Passage{
+				{BookID: "EXO", Chapter: 7, StartVerse: 26, EndVerse: 29},
+				{BookID: "EXO", Chapter: 8, StartVerse: 1, EndVerse: 6},
+			},
+			mapped: true,
+		},
+		{
+			name:    "the same boundary in reverse",
+			passage: Passage{BookID: "EXO", Chapter: 7, StartVerse: 20, EndVerse: 29},
+			from:    Hebrew,
+			to:      KJV,
+			want: []Passage{
+				{BookID: "EXO", Chapter: 7, StartVerse: 20, EndVerse: 25},
+				{BookID: "EXO", Chapter: 8, StartVerse: 1, EndVerse: 4},
+			},
+			mapped: true,
+		},
+		{
+			name:    "Hebrew to CUV goes through KJV",
+			passage: Passage{BookID: "MAL", Chapter: 3, StartVerse: 19, EndVerse: 19},
+			from:    Hebrew,
+			to:      CUV,
+			want:    []Passage{{BookID: "MAL", Chapter: 4, StartVerse: 1, EndVerse: 1}},
+			mapped:  true,
+		},
+		{
+			name:    "empty schemes are the default",
+			passage: Passage{BookID: "MAL", Chapter: 4, StartVerse: 1, EndVerse: 1},
+			want:    []Passage{{BookID: "MAL", Chapter: 4, StartVerse: 1, EndVerse: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, mapped, err := Map(tt.passage, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Map() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Map() = %+v, want %+v", got, tt.want)
+			}
+			if mapped != tt.mapped {
+				t.Errorf("Map() mapped = %v, want %v", mapped, tt.mapped)
+			}
+		})
+	}
+}
+
+func TestMapUnknownScheme(t *testing.T) {
+	_, _, err := Map(Passage{BookID: "GEN", Chapter: 1}, KJV, "lxx")
+	if !errors.Is(err, ErrUnknownScheme) {
+		t.Errorf("Map() error = %v, want %v", err, ErrUnknownScheme)
+	}
+}